@@ -0,0 +1 @@
+terraform import apollographql_check_configuration.api api
@@ -0,0 +1,21 @@
+resource "apollographql_check_configuration" "api" {
+  graph_id           = apollographql_graph.api.id
+  time_range_seconds = 1209600
+
+  operation_count_threshold            = 5
+  operation_count_threshold_percentage = 0.01
+
+  included_variants = ["production", "staging"]
+
+  excluded_clients = [
+    {
+      name = "internal-tools"
+    },
+    {
+      name    = "ios"
+      version = "1.0.0"
+    },
+  ]
+
+  downgrade_static_checks = true
+}
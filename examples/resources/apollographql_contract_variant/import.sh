@@ -0,0 +1 @@
+terraform import apollographql_contract_variant.public api:public
@@ -0,0 +1,8 @@
+resource "apollographql_contract_variant" "public" {
+  graph_id       = apollographql_graph.api.id
+  name           = "public"
+  source_variant = "current"
+
+  include_tags           = ["public"]
+  hide_unreachable_types = true
+}
@@ -3,4 +3,7 @@ resource "apollographql_graph" "api" {
   title = "API"
 
   organization_id = "example"
+
+  hidden_from_uninvited_non_admin_members = true
+  readme_file                             = "${path.module}/README.md"
 }
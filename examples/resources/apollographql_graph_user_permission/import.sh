@@ -0,0 +1 @@
+terraform import apollographql_graph_user_permission.jane api:jane
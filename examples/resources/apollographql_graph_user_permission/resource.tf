@@ -0,0 +1,5 @@
+resource "apollographql_graph_user_permission" "jane" {
+  graph_id   = "api"
+  user_id    = "jane"
+  permission = "CONTRIBUTOR"
+}
@@ -2,3 +2,12 @@ resource "apollographql_key" "api" {
   name     = "deploy"
   graph_id = apollographql_graph.api.id
 }
+
+resource "apollographql_key" "router" {
+  name     = "router"
+  graph_id = apollographql_graph.api.id
+
+  # Changing this creates a new key and keeps the current one for 72 hours.
+  rotation_id           = "2024-Q1"
+  rotation_grace_period = "72h"
+}
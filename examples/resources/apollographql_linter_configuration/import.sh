@@ -0,0 +1 @@
+terraform import apollographql_linter_configuration.api api
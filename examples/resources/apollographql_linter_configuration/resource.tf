@@ -0,0 +1,11 @@
+resource "apollographql_linter_configuration" "api" {
+  graph_id = apollographql_graph.api.id
+
+  rules = {
+    FIELD_NAMES_SHOULD_BE_CAMEL_CASE    = "ERROR"
+    DEPRECATED_DIRECTIVE_MISSING_REASON = "WARNING"
+  }
+
+  allowed_tag_names = ["public", "internal"]
+  ignore_deprecated = true
+}
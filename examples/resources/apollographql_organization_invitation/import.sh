@@ -0,0 +1 @@
+terraform import apollographql_organization_invitation.jane example:jane@example.com
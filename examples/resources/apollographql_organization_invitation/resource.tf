@@ -0,0 +1,5 @@
+resource "apollographql_organization_invitation" "jane" {
+  organization_id = "example"
+  email           = "jane@example.com"
+  role            = "CONTRIBUTOR"
+}
@@ -0,0 +1 @@
+terraform import apollographql_organization_invite_link.engineering example
@@ -0,0 +1,8 @@
+resource "apollographql_organization_invite_link" "engineering" {
+  organization_id = "example"
+  role            = "CONTRIBUTOR"
+
+  rotation_trigger = {
+    quarter = "2024-Q1"
+  }
+}
@@ -0,0 +1 @@
+terraform import apollographql_organization_member.jane example:jane
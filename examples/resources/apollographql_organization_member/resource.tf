@@ -0,0 +1,5 @@
+resource "apollographql_organization_member" "jane" {
+  organization_id = "example"
+  user_id         = "jane"
+  role            = "GRAPH_ADMIN"
+}
@@ -0,0 +1,14 @@
+resource "apollographql_pagerduty_channel" "on_call" {
+  graph_id    = apollographql_graph.api.id
+  name        = "On-call"
+  routing_key = var.pagerduty_routing_key
+}
+
+# Set up the same channel for every graph
+resource "apollographql_pagerduty_channel" "all" {
+  for_each = apollographql_graph.all
+
+  graph_id    = each.value.id
+  name        = "On-call"
+  routing_key = var.pagerduty_routing_key
+}
@@ -0,0 +1,5 @@
+resource "apollographql_persisted_query_list" "web" {
+  graph_id    = apollographql_graph.api.id
+  name        = "Web"
+  description = "Operations used by the web app"
+}
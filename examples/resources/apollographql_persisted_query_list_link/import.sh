@@ -0,0 +1 @@
+terraform import apollographql_persisted_query_list_link.current api:current
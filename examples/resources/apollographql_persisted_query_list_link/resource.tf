@@ -0,0 +1,5 @@
+resource "apollographql_persisted_query_list_link" "current" {
+  graph_id                = apollographql_graph.api.id
+  variant                 = "current"
+  persisted_query_list_id = apollographql_persisted_query_list.web.id
+}
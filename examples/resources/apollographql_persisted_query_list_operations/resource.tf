@@ -0,0 +1,5 @@
+resource "apollographql_persisted_query_list_operations" "web" {
+  graph_id                = apollographql_graph.api.id
+  persisted_query_list_id = apollographql_persisted_query_list.web.id
+  manifest                = "${path.module}/persisted-query-manifest.json"
+}
@@ -0,0 +1,20 @@
+resource "apollographql_query_trigger" "latency" {
+  graph_id            = apollographql_variant.production.graph_id
+  variant             = apollographql_variant.production.name
+  metric              = "REQUEST_SERVICE_TIME"
+  comparison_operator = "GREATER_THAN"
+  threshold           = 500
+  percentile          = 95
+  window              = "FIVE_MINUTES"
+  channel_ids         = [apollographql_pagerduty_channel.on_call.id]
+}
+
+resource "apollographql_query_trigger" "errors" {
+  graph_id            = apollographql_variant.production.graph_id
+  variant             = apollographql_variant.production.name
+  metric              = "ERROR_PERCENTAGE"
+  comparison_operator = "GREATER_THAN"
+  threshold           = 1
+  window              = "FIVE_MINUTES"
+  channel_ids         = [apollographql_pagerduty_channel.on_call.id]
+}
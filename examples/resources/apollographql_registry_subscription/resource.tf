@@ -0,0 +1,5 @@
+resource "apollographql_registry_subscription" "production" {
+  graph_id   = apollographql_variant.production.graph_id
+  variant    = apollographql_variant.production.name
+  channel_id = apollographql_slack_channel.schema_changes.id
+}
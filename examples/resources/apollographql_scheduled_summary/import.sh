@@ -0,0 +1 @@
+terraform import apollographql_scheduled_summary.production api:a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d
@@ -0,0 +1,6 @@
+resource "apollographql_scheduled_summary" "production" {
+  graph_id   = apollographql_variant.production.graph_id
+  variant    = apollographql_variant.production.name
+  channel_id = apollographql_slack_channel.team.id
+  timezone   = "America/New_York"
+}
@@ -0,0 +1,14 @@
+resource "apollographql_slack_channel" "schema_changes" {
+  graph_id = apollographql_graph.api.id
+  name     = "#schema-changes"
+  url      = var.slack_webhook_url
+}
+
+# Set up the same channel for every graph
+resource "apollographql_slack_channel" "all" {
+  for_each = apollographql_graph.all
+
+  graph_id = each.value.id
+  name     = "#schema-changes"
+  url      = var.slack_webhook_url
+}
@@ -0,0 +1 @@
+terraform import apollographql_subgraph.todos api:current:todos
@@ -0,0 +1,8 @@
+resource "apollographql_subgraph" "todos" {
+  graph_id = apollographql_graph.api.id
+  variant  = "current"
+  name     = "todos"
+  url      = "https://todos.example.com/graphql"
+  revision = "1"
+  schema   = file("${path.module}/todos.graphql")
+}
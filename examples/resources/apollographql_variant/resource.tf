@@ -2,3 +2,26 @@ resource "apollographql_variant" "api" {
   name     = "latest"
   graph_id = apollographql_graph.api.id
 }
+
+resource "apollographql_variant" "production" {
+  name             = "production"
+  graph_id         = apollographql_graph.api.id
+  url              = "https://api.example.com/graphql"
+  subscription_url = "wss://api.example.com/graphql"
+  is_protected     = true
+  preflight_script = file("${path.module}/explorer/preflight.js")
+  shared_headers   = jsonencode({ Authorization = "Bearer {{token}}" })
+  readme           = file("${path.module}/README.md")
+
+  links = [
+    {
+      title = "Grafana"
+      type  = "OTHER"
+      url   = "https://grafana.example.com/d/api"
+    },
+    {
+      type = "REPOSITORY"
+      url  = "https://github.com/example/api"
+    },
+  ]
+}
@@ -0,0 +1 @@
+terraform import apollographql_variant_check_configuration.staging api:staging
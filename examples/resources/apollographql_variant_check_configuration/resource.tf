@@ -0,0 +1,13 @@
+resource "apollographql_variant_check_configuration" "staging" {
+  graph_id = apollographql_variant.staging.graph_id
+  variant  = apollographql_variant.staging.name
+
+  time_range_seconds = 86400
+  included_variants  = ["staging"]
+
+  excluded_clients = [
+    {
+      name = "load-tests"
+    },
+  ]
+}
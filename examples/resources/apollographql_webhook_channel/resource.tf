@@ -0,0 +1,6 @@
+resource "apollographql_webhook_channel" "schema_changes" {
+  graph_id     = apollographql_graph.api.id
+  name         = "Schema Changes"
+  url          = "https://hooks.example.com/apollo"
+  secret_token = var.webhook_secret_token
+}
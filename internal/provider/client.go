@@ -1,6 +1,75 @@
 package provider
 
-import "net/http"
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+// apiClient classifies the errors returned by the wrapped client so that
+// resources can tell an expired token apart from a server outage.
+type apiClient struct {
+	wrapped graphql.Client
+}
+
+func (c *apiClient) MakeRequest(ctx context.Context, req *graphql.Request, resp *graphql.Response) error {
+	meta := &responseMeta{}
+
+	err := c.wrapped.MakeRequest(context.WithValue(ctx, responseMetaKey{}, meta), req, resp)
+
+	if err == nil {
+		return nil
+	}
+
+	return newAPIError(req.OpName, meta, err)
+}
+
+// requestIDHeaders are the response headers which may carry the identifier
+// Apollo support needs to trace a request.
+var requestIDHeaders = []string{"X-Request-Id", "X-Cloud-Trace-Context"}
+
+type responseMetaKey struct{}
+
+// responseMeta records the parts of the final HTTP response which genqlient
+// doesn't return to its caller.
+type responseMeta struct {
+	status    int
+	requestID string
+}
+
+type metaTransport struct {
+	wrapped http.RoundTripper
+}
+
+func (t *metaTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.wrapped.RoundTrip(req)
+
+	if meta, ok := req.Context().Value(responseMetaKey{}).(*responseMeta); ok && resp != nil {
+		meta.status = resp.StatusCode
+
+		for _, header := range requestIDHeaders {
+			if value := resp.Header.Get(header); value != "" {
+				meta.requestID = value
+				break
+			}
+		}
+	}
+
+	return resp, err
+}
 
 type authedTransport struct {
 	token   string
@@ -12,3 +81,296 @@ func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 	return t.wrapped.RoundTrip(req)
 }
+
+// limitTransport is shared by all resources so that the token bucket and the
+// cap on in-flight requests apply to the whole Terraform run.
+type limitTransport struct {
+	limiter   *rate.Limiter
+	semaphore chan struct{}
+	wrapped   http.RoundTripper
+}
+
+func newLimitTransport(requestsPerSecond int, maxConcurrent int, wrapped http.RoundTripper) *limitTransport {
+	return &limitTransport{
+		limiter:   rate.NewLimiter(rate.Limit(requestsPerSecond), requestsPerSecond),
+		semaphore: make(chan struct{}, maxConcurrent),
+		wrapped:   wrapped,
+	}
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.semaphore <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		<-t.semaphore
+		return nil, err
+	}
+
+	resp, err := t.wrapped.RoundTrip(req)
+
+	if err != nil {
+		<-t.semaphore
+		return nil, err
+	}
+
+	// The request stays in flight until its body has been consumed.
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: func() { <-t.semaphore }}
+
+	return resp, nil
+}
+
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+
+	b.once.Do(b.release)
+
+	return err
+}
+
+// idempotentMutations classifies every mutation by whether it can safely be
+// sent again when the first attempt may or may not have reached the API.
+// Mutations which set values and publishes can, and so can deletes, since
+// every resource treats a missing object as deleted. Mutations which create
+// something, including upserts without an id, or send an email can't.
+var idempotentMutations = map[string]bool{
+	"addVariantLink":               false,
+	"createKey":                    false,
+	"createOrganizationInvitation": false,
+	"createOrganizationInviteLink": false,
+	"createPersistedQueryList":     false,
+	"createService":                false,
+	"createVariant":                false,
+	"deleteChannel":                true,
+	"deleteKey":                    true,
+	"deleteOrganizationInvitation": true,
+	"deleteOrganizationInviteLink": true,
+	"deleteOrganizationMember":     true,
+	"deletePersistedQueryList":     true,
+	"deleteQueryTrigger":           true,
+	"deleteRegistrySubscription":   true,
+	"deleteScheduledSummary":       true,
+	"deleteService":                true,
+	"deleteSubgraph":               true,
+	"deleteVariant":                true,
+	"linkPersistedQueryList":       true,
+	"publishOperations":            true,
+	"publishSubgraph":              true,
+	"removeVariantLink":            true,
+	"resendOrganizationInvitation": false,
+	"transferService":              false,
+	"unlinkPersistedQueryList":     true,
+	"updateCheckConfiguration":     true,
+	"updateGraphUserPermission":    true,
+	"updateKey":                    true,
+	"updateLinterConfiguration":    true,
+	"updateOrganizationMember":     true,
+	"updatePersistedQueryList":     true,
+	"updateServiceDescription":     true,
+	"updateServiceHiddenFromUninvitedNonAdminAccountMembers": true,
+	"updateServiceReadme":                                  true,
+	"updateServiceTitle":                                   true,
+	"updateVariantCheckConfigurationDowngradeChecks":       true,
+	"updateVariantCheckConfigurationEnableOperationsCheck": true,
+	"updateVariantCheckConfigurationExcludedClients":       true,
+	"updateVariantCheckConfigurationExcludedOperations":    true,
+	"updateVariantCheckConfigurationIncludedVariants":      true,
+	"updateVariantCheckConfigurationTimeRange":             true,
+	"updateVariantIsProtected":                             true,
+	"updateVariantIsPublic":                                true,
+	"updateVariantIsPubliclyListed":                        true,
+	"updateVariantPostflightScript":                        true,
+	"updateVariantPreflightScript":                         true,
+	"updateVariantReadme":                                  true,
+	"updateVariantSendCookies":                             true,
+	"updateVariantSharedHeaders":                           true,
+	"updateVariantSubscriptionURL":                         true,
+	"updateVariantURL":                                     true,
+	"upsertContractVariant":                                true,
+	"upsertPagerDutyChannel":                               false,
+	"upsertQueryTrigger":                                   false,
+	"upsertRegistrySubscription":                           false,
+	"upsertScheduledSummary":                               false,
+	"upsertSlackChannel":                                   false,
+	"upsertWebhookChannel":                                 false,
+}
+
+type retryTransport struct {
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+	wrapped    http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readBody(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	idempotent := isIdempotent(body)
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req.Clone(req.Context())
+
+		if body != nil {
+			attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := t.wrapped.RoundTrip(attemptReq)
+
+		if attempt >= t.maxRetries || !shouldRetry(req.Context(), resp, err, idempotent) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		tflog.Debug(req.Context(), "retrying Apollo GraphQL request", map[string]interface{}{
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+			"status":  responseStatus(resp),
+			"error":   errorString(err),
+		})
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns how long to wait before the next attempt. The server's
+// Retry-After header wins when present, up to waitMax, otherwise the wait
+// grows exponentially from waitMin up to waitMax with jitter.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > t.waitMax {
+				return t.waitMax
+			}
+
+			return wait
+		}
+	}
+
+	wait := float64(t.waitMin) * math.Pow(2, float64(attempt))
+
+	if wait > float64(t.waitMax) {
+		wait = float64(t.waitMax)
+	}
+
+	// Equal jitter, half of the window plus a random share of the other
+	// half, so that parallel requests which failed together don't retry
+	// together.
+	return time.Duration(wait/2 + rand.Float64()*wait/2)
+}
+
+func shouldRetry(ctx context.Context, resp *http.Response, err error, idempotent bool) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return idempotent && !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		// Rate limited requests were rejected before being processed.
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent
+	}
+
+	return false
+}
+
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+
+		if wait < 0 {
+			wait = 0
+		}
+
+		return wait, true
+	}
+
+	return 0, false
+}
+
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	defer req.Body.Close()
+
+	return io.ReadAll(req.Body)
+}
+
+// isIdempotent reports whether the GraphQL operation in the request body can
+// be replayed without side effects.
+func isIdempotent(body []byte) bool {
+	var operation struct {
+		Query         string `json:"query"`
+		OperationName string `json:"operationName"`
+	}
+
+	if len(body) == 0 {
+		return true
+	}
+
+	if err := json.Unmarshal(body, &operation); err != nil {
+		return false
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(operation.Query), "mutation") {
+		return idempotentMutations[operation.OperationName]
+	}
+
+	return true
+}
+
+func responseStatus(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+
+	return resp.StatusCode
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestRetryTransport(t *testing.T) {
+	cases := []struct {
+		name     string
+		body     string
+		status   int
+		attempts int
+	}{
+		{"query", `{"query":"query getService { service { id } }","operationName":"getService"}`, http.StatusBadGateway, 3},
+		{"idempotent mutation", `{"query":"mutation updateServiceTitle { service { id } }","operationName":"updateServiceTitle"}`, http.StatusServiceUnavailable, 3},
+		{"unsafe mutation", `{"query":"mutation createKey { service { id } }","operationName":"createKey"}`, http.StatusBadGateway, 1},
+		{"create mutation", `{"query":"mutation createVariant { service { id } }","operationName":"createVariant"}`, http.StatusBadGateway, 1},
+		{"rate limited mutation", `{"query":"mutation createKey { service { id } }","operationName":"createKey"}`, http.StatusTooManyRequests, 3},
+		{"client error", `{"query":"query getService { service { id } }","operationName":"getService"}`, http.StatusBadRequest, 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			attempts := 0
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(c.status)
+			}))
+
+			defer server.Close()
+
+			client := http.Client{
+				Transport: &retryTransport{
+					maxRetries: 2,
+					waitMin:    time.Millisecond,
+					waitMax:    time.Millisecond,
+					wrapped:    http.DefaultTransport,
+				},
+			}
+
+			resp, err := client.Post(server.URL, "application/json", strings.NewReader(c.body))
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp.Body.Close()
+
+			if resp.StatusCode != c.status {
+				t.Errorf("expected status %d, got %d", c.status, resp.StatusCode)
+			}
+
+			if attempts != c.attempts {
+				t.Errorf("expected %d attempts, got %d", c.attempts, attempts)
+			}
+		})
+	}
+}
+
+func TestIdempotentMutations(t *testing.T) {
+	operations := testOperations(t)
+
+	for name, operation := range operations {
+		if _, ok := idempotentMutations[name]; operation == ast.Mutation && !ok {
+			t.Errorf("mutation %q is not classified as idempotent or not", name)
+		}
+	}
+
+	for name := range idempotentMutations {
+		if operations[name] != ast.Mutation {
+			t.Errorf("idempotency classified for unknown mutation %q", name)
+		}
+	}
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	transport := &retryTransport{waitMin: time.Second, waitMax: 10 * time.Second}
+
+	cases := []struct {
+		name       string
+		retryAfter string
+		wait       time.Duration
+	}{
+		{"within maximum", "5", 5 * time.Second},
+		{"above maximum", "3600", 10 * time.Second},
+		{"date above maximum", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), 10 * time.Second},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{"Retry-After": []string{c.retryAfter}}}
+
+			if wait := transport.backoff(0, resp); wait != c.wait {
+				t.Errorf("expected wait %s, got %s", c.wait, wait)
+			}
+		})
+	}
+}
+
+func TestLimitTransportConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+
+		for {
+			observed := atomic.LoadInt32(&maxInFlight)
+
+			if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+	}))
+
+	defer server.Close()
+
+	client := http.Client{
+		Transport: newLimitTransport(1000, 2, http.DefaultTransport),
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			resp, err := client.Get(server.URL)
+
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			resp.Body.Close()
+		}()
+	}
+
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
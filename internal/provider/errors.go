@@ -0,0 +1,317 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// errNotFound is returned when the object being read no longer exists.
+var errNotFound = errors.New("not found")
+
+type errorKind int
+
+const (
+	errorKindUnknown errorKind = iota
+	errorKindAuthentication
+	errorKindPermissionDenied
+	errorKindNotFound
+	errorKindInvalidInput
+	errorKindRateLimited
+	errorKindServer
+)
+
+// apiError is a failed Platform API request, classified from the GraphQL
+// error codes and the HTTP status of the response.
+type apiError struct {
+	kind       errorKind
+	message    string
+	path       string
+	permission string
+	requestID  string
+	err        error
+}
+
+func (e *apiError) Error() string {
+	var b strings.Builder
+
+	b.WriteString(e.message)
+
+	if e.path != "" {
+		fmt.Fprintf(&b, " (field: %s)", e.path)
+	}
+
+	if e.permission != "" {
+		fmt.Fprintf(&b, " (requires %s permission)", e.permission)
+	}
+
+	if e.requestID != "" {
+		fmt.Fprintf(&b, " (request ID: %s)", e.requestID)
+	}
+
+	return b.String()
+}
+
+func (e *apiError) Unwrap() error {
+	return e.err
+}
+
+func (e *apiError) Is(target error) bool {
+	return target == errNotFound && e.kind == errorKindNotFound
+}
+
+// requiredPermissions maps every operation to the least permission which
+// allows it, so that a permission error can name the missing role.
+var requiredPermissions = map[string]string{
+	"addVariantLink":                  "GRAPH_ADMIN",
+	"createKey":                       "GRAPH_ADMIN",
+	"createOrganizationInvitation":    "ORG_ADMIN",
+	"createOrganizationInviteLink":    "ORG_ADMIN",
+	"createPersistedQueryList":        "GRAPH_ADMIN",
+	"createService":                   "GRAPH_ADMIN",
+	"createVariant":                   "GRAPH_ADMIN",
+	"deleteChannel":                   "GRAPH_ADMIN",
+	"deleteKey":                       "GRAPH_ADMIN",
+	"deleteOrganizationInvitation":    "ORG_ADMIN",
+	"deleteOrganizationInviteLink":    "ORG_ADMIN",
+	"deleteOrganizationMember":        "ORG_ADMIN",
+	"deletePersistedQueryList":        "GRAPH_ADMIN",
+	"deleteQueryTrigger":              "GRAPH_ADMIN",
+	"deleteRegistrySubscription":      "GRAPH_ADMIN",
+	"deleteScheduledSummary":          "GRAPH_ADMIN",
+	"deleteService":                   "GRAPH_ADMIN",
+	"deleteSubgraph":                  "CONTRIBUTOR",
+	"deleteVariant":                   "GRAPH_ADMIN",
+	"getCheckConfiguration":           "OBSERVER",
+	"getContractVariant":              "OBSERVER",
+	"getGraphUserPermissions":         "GRAPH_ADMIN",
+	"getLinterConfiguration":          "OBSERVER",
+	"getOrganizationInvitations":      "ORG_ADMIN",
+	"getOrganizationInviteLinks":      "ORG_ADMIN",
+	"getOrganizationMembers":          "ORG_ADMIN",
+	"getPagerDutyChannel":             "OBSERVER",
+	"getPersistedQueryList":           "OBSERVER",
+	"getPersistedQueryListOperations": "OBSERVER",
+	"getQueryTriggers":                "OBSERVER",
+	"getRegistrySubscriptions":        "OBSERVER",
+	"getScheduledSummaries":           "OBSERVER",
+	"getService":                      "OBSERVER",
+	"getSlackChannel":                 "OBSERVER",
+	"getSubgraph":                     "OBSERVER",
+	"getVariant":                      "OBSERVER",
+	"getVariantCheckConfiguration":    "OBSERVER",
+	"getVariantPersistedQueryList":    "OBSERVER",
+	"getWebhookChannel":               "OBSERVER",
+	"linkPersistedQueryList":          "GRAPH_ADMIN",
+	"listKeys":                        "GRAPH_ADMIN",
+	"publishOperations":               "GRAPH_ADMIN",
+	"publishSubgraph":                 "CONTRIBUTOR",
+	"removeVariantLink":               "GRAPH_ADMIN",
+	"resendOrganizationInvitation":    "ORG_ADMIN",
+	"transferService":                 "ORG_ADMIN",
+	"unlinkPersistedQueryList":        "GRAPH_ADMIN",
+	"updateCheckConfiguration":        "GRAPH_ADMIN",
+	"updateGraphUserPermission":       "GRAPH_ADMIN",
+	"updateKey":                       "GRAPH_ADMIN",
+	"updateLinterConfiguration":       "GRAPH_ADMIN",
+	"updateOrganizationMember":        "ORG_ADMIN",
+	"updatePersistedQueryList":        "GRAPH_ADMIN",
+	"updateServiceDescription":        "GRAPH_ADMIN",
+	"updateServiceHiddenFromUninvitedNonAdminAccountMembers": "GRAPH_ADMIN",
+	"updateServiceReadme":                                  "GRAPH_ADMIN",
+	"updateServiceTitle":                                   "GRAPH_ADMIN",
+	"updateVariantCheckConfigurationDowngradeChecks":       "GRAPH_ADMIN",
+	"updateVariantCheckConfigurationEnableOperationsCheck": "GRAPH_ADMIN",
+	"updateVariantCheckConfigurationExcludedClients":       "GRAPH_ADMIN",
+	"updateVariantCheckConfigurationExcludedOperations":    "GRAPH_ADMIN",
+	"updateVariantCheckConfigurationIncludedVariants":      "GRAPH_ADMIN",
+	"updateVariantCheckConfigurationTimeRange":             "GRAPH_ADMIN",
+	"updateVariantIsProtected":                             "GRAPH_ADMIN",
+	"updateVariantIsPublic":                                "GRAPH_ADMIN",
+	"updateVariantIsPubliclyListed":                        "GRAPH_ADMIN",
+	"updateVariantPostflightScript":                        "GRAPH_ADMIN",
+	"updateVariantPreflightScript":                         "GRAPH_ADMIN",
+	"updateVariantReadme":                                  "GRAPH_ADMIN",
+	"updateVariantSendCookies":                             "GRAPH_ADMIN",
+	"updateVariantSharedHeaders":                           "GRAPH_ADMIN",
+	"updateVariantSubscriptionURL":                         "GRAPH_ADMIN",
+	"updateVariantURL":                                     "GRAPH_ADMIN",
+	"upsertContractVariant":                                "GRAPH_ADMIN",
+	"upsertPagerDutyChannel":                               "GRAPH_ADMIN",
+	"upsertQueryTrigger":                                   "GRAPH_ADMIN",
+	"upsertRegistrySubscription":                           "GRAPH_ADMIN",
+	"upsertScheduledSummary":                               "GRAPH_ADMIN",
+	"upsertSlackChannel":                                   "GRAPH_ADMIN",
+	"upsertWebhookChannel":                                 "GRAPH_ADMIN",
+}
+
+func newAPIError(operationName string, meta *responseMeta, err error) *apiError {
+	e := &apiError{
+		kind:      kindFromStatus(meta.status),
+		message:   err.Error(),
+		requestID: meta.requestID,
+		err:       err,
+	}
+
+	var list gqlerror.List
+
+	if errors.As(err, &list) && len(list) > 0 {
+		// The first error with a code we recognise decides the kind.
+		first := list[0]
+		messages := make([]string, 0, len(list))
+
+		for _, item := range list {
+			messages = append(messages, item.Message)
+		}
+
+		for _, item := range list {
+			if kind := kindFromCode(errorCode(item)); kind != errorKindUnknown {
+				e.kind = kind
+				first = item
+				break
+			}
+		}
+
+		e.message = strings.Join(messages, "; ")
+		e.path = first.Path.String()
+
+		if e.path == "" {
+			e.path, _ = first.Extensions["argumentName"].(string)
+		}
+
+		if e.requestID == "" {
+			e.requestID, _ = first.Extensions["requestId"].(string)
+		}
+	}
+
+	if e.kind != errorKindInvalidInput {
+		e.path = ""
+	}
+
+	if e.kind == errorKindPermissionDenied {
+		e.permission = requiredPermissions[operationName]
+	}
+
+	return e
+}
+
+// resultError converts the error member of a mutation's result union, which
+// the API returns alongside a successful response, into an apiError. It
+// returns nil when the result isn't an error.
+func resultError(operationName string, result interface{ GetTypename() string }) error {
+	if result == nil {
+		return fmt.Errorf("%s returned no result", operationName)
+	}
+
+	e, ok := result.(interface{ GetMessage() string })
+
+	if !ok {
+		return nil
+	}
+
+	err := &apiError{
+		kind:    kindFromTypename(result.GetTypename()),
+		message: e.GetMessage(),
+	}
+
+	if err.kind == errorKindPermissionDenied {
+		err.permission = requiredPermissions[operationName]
+	}
+
+	return err
+}
+
+func kindFromTypename(typename string) errorKind {
+	switch typename {
+	case "PermissionError":
+		return errorKindPermissionDenied
+	case "NotFoundError", "ListNotFoundError":
+		return errorKindNotFound
+	case "ValidationError", "InvalidInputError", "CannotModifyOperationBodyError":
+		return errorKindInvalidInput
+	}
+
+	return errorKindUnknown
+}
+
+func kindFromStatus(status int) errorKind {
+	switch {
+	case status == http.StatusUnauthorized:
+		return errorKindAuthentication
+	case status == http.StatusForbidden:
+		return errorKindPermissionDenied
+	case status == http.StatusBadRequest:
+		return errorKindInvalidInput
+	case status == http.StatusTooManyRequests:
+		return errorKindRateLimited
+	case status >= http.StatusInternalServerError:
+		return errorKindServer
+	}
+
+	return errorKindUnknown
+}
+
+func kindFromCode(code string) errorKind {
+	switch code {
+	case "UNAUTHENTICATED":
+		return errorKindAuthentication
+	case "FORBIDDEN":
+		return errorKindPermissionDenied
+	case "NOT_FOUND":
+		return errorKindNotFound
+	case "BAD_USER_INPUT", "GRAPHQL_VALIDATION_FAILED", "GRAPHQL_PARSE_FAILED":
+		return errorKindInvalidInput
+	case "RATE_LIMITED":
+		return errorKindRateLimited
+	case "INTERNAL_SERVER_ERROR":
+		return errorKindServer
+	}
+
+	return errorKindUnknown
+}
+
+func errorCode(err *gqlerror.Error) string {
+	if err == nil || err.Extensions == nil {
+		return ""
+	}
+
+	code, _ := err.Extensions["code"].(string)
+
+	return code
+}
+
+// isNotFound reports whether the error means the object is gone, as opposed
+// to the token not being allowed to see it or the request failing.
+func isNotFound(err error) bool {
+	return errors.Is(err, errNotFound)
+}
+
+// errorSummary returns the diagnostic summary for an error returned by the
+// client, so that an expired token doesn't look like a server outage.
+func errorSummary(err error) string {
+	var e *apiError
+
+	if !errors.As(err, &e) {
+		return "Client Error"
+	}
+
+	switch e.kind {
+	case errorKindAuthentication:
+		return "Authentication Error"
+	case errorKindPermissionDenied:
+		return "Permission Denied"
+	case errorKindNotFound:
+		return "Not Found"
+	case errorKindInvalidInput:
+		return "Invalid Input"
+	case errorKindRateLimited:
+		return "Rate Limited"
+	case errorKindServer:
+		return "Server Error"
+	}
+
+	return "Client Error"
+}
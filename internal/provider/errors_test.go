@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+func TestNewAPIError(t *testing.T) {
+	cases := []struct {
+		name          string
+		operationName string
+		meta          responseMeta
+		err           error
+		summary       string
+		message       string
+	}{
+		{
+			name:    "expired token",
+			meta:    responseMeta{status: http.StatusUnauthorized, requestID: "abc"},
+			err:     errors.New("returned error 401 Unauthorized: "),
+			summary: "Authentication Error",
+			message: "returned error 401 Unauthorized:  (request ID: abc)",
+		},
+		{
+			name:          "forbidden",
+			operationName: "createKey",
+			meta:          responseMeta{status: http.StatusOK},
+			err:           gqlerror.List{{Message: "no access", Extensions: map[string]interface{}{"code": "FORBIDDEN"}}},
+			summary:       "Permission Denied",
+			message:       "no access (requires GRAPH_ADMIN permission)",
+		},
+		{
+			name:          "forbidden organization mutation",
+			operationName: "updateOrganizationMember",
+			meta:          responseMeta{status: http.StatusForbidden},
+			err:           errors.New("returned error 403 Forbidden: "),
+			summary:       "Permission Denied",
+			message:       "returned error 403 Forbidden:  (requires ORG_ADMIN permission)",
+		},
+		{
+			name:    "invalid input",
+			meta:    responseMeta{status: http.StatusOK},
+			err:     gqlerror.List{{Message: "bad title", Path: ast.Path{ast.PathName("service"), ast.PathName("updateTitle")}, Extensions: map[string]interface{}{"code": "BAD_USER_INPUT"}}},
+			summary: "Invalid Input",
+			message: "bad title (field: service.updateTitle)",
+		},
+		{
+			name:    "not found",
+			meta:    responseMeta{status: http.StatusOK},
+			err:     gqlerror.List{{Message: "no graph", Extensions: map[string]interface{}{"code": "NOT_FOUND"}}},
+			summary: "Not Found",
+			message: "no graph",
+		},
+		{
+			name:    "rate limited",
+			meta:    responseMeta{status: http.StatusTooManyRequests},
+			err:     errors.New("returned error 429 Too Many Requests: "),
+			summary: "Rate Limited",
+			message: "returned error 429 Too Many Requests: ",
+		},
+		{
+			name:    "server error",
+			meta:    responseMeta{status: http.StatusBadGateway},
+			err:     errors.New("returned error 502 Bad Gateway: "),
+			summary: "Server Error",
+			message: "returned error 502 Bad Gateway: ",
+		},
+		{
+			name:    "network error",
+			err:     errors.New("connection reset by peer"),
+			summary: "Client Error",
+			message: "connection reset by peer",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := newAPIError(c.operationName, &c.meta, c.err)
+
+			if summary := errorSummary(err); summary != c.summary {
+				t.Errorf("expected summary %q, got %q", c.summary, summary)
+			}
+
+			if err.Error() != c.message {
+				t.Errorf("expected message %q, got %q", c.message, err.Error())
+			}
+
+			if isNotFound(err) != (c.summary == "Not Found") {
+				t.Errorf("unexpected isNotFound result for %q", c.name)
+			}
+		})
+	}
+}
+
+func TestRequiredPermissions(t *testing.T) {
+	roles := map[string]bool{}
+
+	for _, role := range organizationRoles {
+		roles[role] = true
+	}
+
+	operations := testOperations(t)
+
+	for name := range operations {
+		permission, ok := requiredPermissions[name]
+
+		if !ok {
+			t.Errorf("missing required permission for operation %q", name)
+			continue
+		}
+
+		if !roles[permission] {
+			t.Errorf("unknown permission %q for operation %q", permission, name)
+		}
+	}
+
+	for name := range requiredPermissions {
+		if _, ok := operations[name]; !ok {
+			t.Errorf("required permission for unknown operation %q", name)
+		}
+	}
+}
+
+// testOperations returns the type of every operation in the GraphQL
+// documents, keyed by operation name.
+func testOperations(t *testing.T) map[string]ast.Operation {
+	files, err := filepath.Glob("*.graphql")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operations := map[string]ast.Operation{}
+
+	for _, file := range files {
+		input, err := os.ReadFile(file)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		document, gqlErr := parser.ParseQuery(&ast.Source{Name: file, Input: string(input)})
+
+		if gqlErr != nil {
+			t.Fatal(gqlErr)
+		}
+
+		for _, operation := range document.Operations {
+			operations[operation.Name] = operation.Operation
+		}
+	}
+
+	return operations
+}
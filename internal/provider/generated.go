@@ -5,448 +5,949 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 )
 
-// Key includes the GraphQL fields of GraphApiKey requested by the fragment Key.
+// CheckConfiguration includes the GraphQL fields of CheckConfiguration requested by the fragment CheckConfiguration.
 // The GraphQL type's documentation follows.
 //
-// Represents a graph API key, which has permissions scoped to a
-// user role for a single Apollo graph.
-type Key struct {
-	// The API key's ID.
-	Id string `json:"id"`
-	// The API key's name, for distinguishing it from other keys.
-	KeyName string `json:"keyName"`
-	// The permission level assigned to the API key upon creation.
-	Role string `json:"role"`
-	// The value of the API key. **This is a secret credential!**
-	Token string `json:"token"`
+// Graph-level configuration of checks.
+type CheckConfiguration struct {
+	// Only check operations from the last <timeRangeSeconds> seconds.
+	// The default is 7 days (604,800 seconds).
+	TimeRangeSeconds int64 `json:"timeRangeSeconds"`
+	// Minimum number of requests within the window for an operation to be considered.
+	OperationCountThreshold int `json:"operationCountThreshold"`
+	// Number of requests within the window for an operation to be considered, relative to
+	// total request count. Expected values are between 0 and 0.05 (minimum 5% of
+	// total request volume)
+	OperationCountThresholdPercentage float64 `json:"operationCountThresholdPercentage"`
+	// Default configuration to include operations on the base variant.
+	IncludeBaseVariant bool `json:"includeBaseVariant"`
+	// Variant overrides for validation
+	IncludedVariants []string `json:"includedVariants"`
+	// Clients to ignore during validation
+	ExcludedClients []CheckConfigurationExcludedClientsClientFilter `json:"excludedClients"`
+	// Operations to ignore during validation
+	ExcludedOperations []CheckConfigurationExcludedOperationsExcludedOperation `json:"excludedOperations"`
+	// During operation checks, if this option is enabled, it evaluates a check
+	// run against zero operations as a pass instead of a failure.
+	DowngradeStaticChecks bool `json:"downgradeStaticChecks"`
+	// During operation checks, if this option is enabled, the check will not fail or
+	// mark any operations as broken/changed if the default value has changed, only
+	// if the default value is removed completely.
+	DowngradeDefaultValueChange bool `json:"downgradeDefaultValueChange"`
 }
 
-// GetId returns Key.Id, and is useful for accessing the field via an interface.
-func (v *Key) GetId() string { return v.Id }
+// GetTimeRangeSeconds returns CheckConfiguration.TimeRangeSeconds, and is useful for accessing the field via an interface.
+func (v *CheckConfiguration) GetTimeRangeSeconds() int64 { return v.TimeRangeSeconds }
 
-// GetKeyName returns Key.KeyName, and is useful for accessing the field via an interface.
-func (v *Key) GetKeyName() string { return v.KeyName }
+// GetOperationCountThreshold returns CheckConfiguration.OperationCountThreshold, and is useful for accessing the field via an interface.
+func (v *CheckConfiguration) GetOperationCountThreshold() int { return v.OperationCountThreshold }
 
-// GetRole returns Key.Role, and is useful for accessing the field via an interface.
-func (v *Key) GetRole() string { return v.Role }
+// GetOperationCountThresholdPercentage returns CheckConfiguration.OperationCountThresholdPercentage, and is useful for accessing the field via an interface.
+func (v *CheckConfiguration) GetOperationCountThresholdPercentage() float64 {
+	return v.OperationCountThresholdPercentage
+}
 
-// GetToken returns Key.Token, and is useful for accessing the field via an interface.
-func (v *Key) GetToken() string { return v.Token }
+// GetIncludeBaseVariant returns CheckConfiguration.IncludeBaseVariant, and is useful for accessing the field via an interface.
+func (v *CheckConfiguration) GetIncludeBaseVariant() bool { return v.IncludeBaseVariant }
 
-// Service includes the GraphQL fields of Service requested by the fragment Service.
+// GetIncludedVariants returns CheckConfiguration.IncludedVariants, and is useful for accessing the field via an interface.
+func (v *CheckConfiguration) GetIncludedVariants() []string { return v.IncludedVariants }
+
+// GetExcludedClients returns CheckConfiguration.ExcludedClients, and is useful for accessing the field via an interface.
+func (v *CheckConfiguration) GetExcludedClients() []CheckConfigurationExcludedClientsClientFilter {
+	return v.ExcludedClients
+}
+
+// GetExcludedOperations returns CheckConfiguration.ExcludedOperations, and is useful for accessing the field via an interface.
+func (v *CheckConfiguration) GetExcludedOperations() []CheckConfigurationExcludedOperationsExcludedOperation {
+	return v.ExcludedOperations
+}
+
+// GetDowngradeStaticChecks returns CheckConfiguration.DowngradeStaticChecks, and is useful for accessing the field via an interface.
+func (v *CheckConfiguration) GetDowngradeStaticChecks() bool { return v.DowngradeStaticChecks }
+
+// GetDowngradeDefaultValueChange returns CheckConfiguration.DowngradeDefaultValueChange, and is useful for accessing the field via an interface.
+func (v *CheckConfiguration) GetDowngradeDefaultValueChange() bool {
+	return v.DowngradeDefaultValueChange
+}
+
+// CheckConfigurationExcludedClientsClientFilter includes the requested fields of the GraphQL type ClientFilter.
 // The GraphQL type's documentation follows.
 //
-// A graph in Apollo Studio represents a graph in your organization.
-// Each graph has one or more variants, which correspond to the different
-// environments where that graph runs (such as staging and production).
-// Each variant has its own GraphQL schema, which means schemas can differ between environments.
-type Service struct {
-	// The graph's globally unique identifier.
-	Id string `json:"id"`
-	// The graph's name.
-	Title                  string `json:"title"`
-	OnboardingArchitecture string `json:"onboardingArchitecture"`
-	AccountId              string `json:"accountId"`
-	Description            string `json:"description"`
+// A client to be filtered.
+type CheckConfigurationExcludedClientsClientFilter struct {
+	// Name of the client is required.
+	Name string `json:"name"`
+	// Version string of the client.
+	Version *string `json:"version"`
 }
 
-// GetId returns Service.Id, and is useful for accessing the field via an interface.
-func (v *Service) GetId() string { return v.Id }
+// GetName returns CheckConfigurationExcludedClientsClientFilter.Name, and is useful for accessing the field via an interface.
+func (v *CheckConfigurationExcludedClientsClientFilter) GetName() string { return v.Name }
 
-// GetTitle returns Service.Title, and is useful for accessing the field via an interface.
-func (v *Service) GetTitle() string { return v.Title }
+// GetVersion returns CheckConfigurationExcludedClientsClientFilter.Version, and is useful for accessing the field via an interface.
+func (v *CheckConfigurationExcludedClientsClientFilter) GetVersion() *string { return v.Version }
 
-// GetOnboardingArchitecture returns Service.OnboardingArchitecture, and is useful for accessing the field via an interface.
-func (v *Service) GetOnboardingArchitecture() string { return v.OnboardingArchitecture }
+// CheckConfigurationExcludedOperationsExcludedOperation includes the requested fields of the GraphQL type ExcludedOperation.
+// The GraphQL type's documentation follows.
+//
+// Excluded operation for a graph.
+type CheckConfigurationExcludedOperationsExcludedOperation struct {
+	// Operation ID to exclude from schema check.
+	ID string `json:"ID"`
+}
 
-// GetAccountId returns Service.AccountId, and is useful for accessing the field via an interface.
-func (v *Service) GetAccountId() string { return v.AccountId }
+// GetID returns CheckConfigurationExcludedOperationsExcludedOperation.ID, and is useful for accessing the field via an interface.
+func (v *CheckConfigurationExcludedOperationsExcludedOperation) GetID() string { return v.ID }
 
-// GetDescription returns Service.Description, and is useful for accessing the field via an interface.
-func (v *Service) GetDescription() string { return v.Description }
+// Options to filter by client reference ID, client name, and client version.
+// If passing client version, make sure to either provide a client reference ID or client name.
+type ClientFilterInput struct {
+	// name of the client set by the user and reported alongside metrics
+	Name string `json:"name"`
+	// version of the client set by the user and reported alongside metrics
+	Version *string `json:"version"`
+}
 
-// Variant includes the GraphQL fields of GraphVariant requested by the fragment Variant.
+// GetName returns ClientFilterInput.Name, and is useful for accessing the field via an interface.
+func (v *ClientFilterInput) GetName() string { return v.Name }
+
+// GetVersion returns ClientFilterInput.Version, and is useful for accessing the field via an interface.
+func (v *ClientFilterInput) GetVersion() *string { return v.Version }
+
+type ComparisonOperator string
+
+const (
+	ComparisonOperatorEquals               ComparisonOperator = "EQUALS"
+	ComparisonOperatorGreaterThan          ComparisonOperator = "GREATER_THAN"
+	ComparisonOperatorGreaterThanOrEqualTo ComparisonOperator = "GREATER_THAN_OR_EQUAL_TO"
+	ComparisonOperatorLessThan             ComparisonOperator = "LESS_THAN"
+	ComparisonOperatorLessThanOrEqualTo    ComparisonOperator = "LESS_THAN_OR_EQUAL_TO"
+	ComparisonOperatorNotEquals            ComparisonOperator = "NOT_EQUALS"
+	ComparisonOperatorUnrecognized         ComparisonOperator = "UNRECOGNIZED"
+)
+
+// ContractVariant includes the GraphQL fields of GraphVariant requested by the fragment ContractVariant.
 // The GraphQL type's documentation follows.
 //
 // A graph variant
-type Variant struct {
+type ContractVariant struct {
 	// The variant's global identifier in the form `graphID@variant`.
 	Id string `json:"id"`
 	// The variant's name (e.g., `staging`).
-	Name     string `json:"name"`
-	IsPublic bool   `json:"isPublic"`
-	// The URL of the variant's GraphQL endpoint for query and mutation operations.
-	// For subscription operations, use `subscriptionUrl`.
-	Url *string `json:"url"`
+	Name string `json:"name"`
 	// Graph ID of the variant. Prefer using graph { id } when feasible.
 	GraphId string `json:"graphId"`
+	// The variant this variant is derived from. This property currently only exists on contract variants.
+	SourceVariant *ContractVariantSourceVariantGraphVariant `json:"sourceVariant"`
+	// The filter configuration used to build a contract schema. The configuration
+	// consists of lists of tags for schema elements to include or exclude in the
+	// resulting schema.
+	ContractFilterConfig *ContractVariantContractFilterConfig `json:"contractFilterConfig"`
+	// Latest launch for the variant, whether successful or not.
+	LatestLaunch *ContractVariantLatestLaunch `json:"latestLaunch"`
 }
 
-// GetId returns Variant.Id, and is useful for accessing the field via an interface.
-func (v *Variant) GetId() string { return v.Id }
+// GetId returns ContractVariant.Id, and is useful for accessing the field via an interface.
+func (v *ContractVariant) GetId() string { return v.Id }
 
-// GetName returns Variant.Name, and is useful for accessing the field via an interface.
-func (v *Variant) GetName() string { return v.Name }
+// GetName returns ContractVariant.Name, and is useful for accessing the field via an interface.
+func (v *ContractVariant) GetName() string { return v.Name }
 
-// GetIsPublic returns Variant.IsPublic, and is useful for accessing the field via an interface.
-func (v *Variant) GetIsPublic() bool { return v.IsPublic }
+// GetGraphId returns ContractVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *ContractVariant) GetGraphId() string { return v.GraphId }
 
-// GetUrl returns Variant.Url, and is useful for accessing the field via an interface.
-func (v *Variant) GetUrl() *string { return v.Url }
+// GetSourceVariant returns ContractVariant.SourceVariant, and is useful for accessing the field via an interface.
+func (v *ContractVariant) GetSourceVariant() *ContractVariantSourceVariantGraphVariant {
+	return v.SourceVariant
+}
 
-// GetGraphId returns Variant.GraphId, and is useful for accessing the field via an interface.
-func (v *Variant) GetGraphId() string { return v.GraphId }
+// GetContractFilterConfig returns ContractVariant.ContractFilterConfig, and is useful for accessing the field via an interface.
+func (v *ContractVariant) GetContractFilterConfig() *ContractVariantContractFilterConfig {
+	return v.ContractFilterConfig
+}
 
-// __createKeyInput is used internally by genqlient
-type __createKeyInput struct {
-	ServiceId string `json:"serviceId"`
-	KeyName   string `json:"keyName"`
-	Role      string `json:"role"`
+// GetLatestLaunch returns ContractVariant.LatestLaunch, and is useful for accessing the field via an interface.
+func (v *ContractVariant) GetLatestLaunch() *ContractVariantLatestLaunch { return v.LatestLaunch }
+
+// ContractVariantContractFilterConfig includes the requested fields of the GraphQL type FilterConfig.
+// The GraphQL type's documentation follows.
+//
+// The filter configuration used to build a contract schema. The configuration
+// consists of lists of tags for schema elements to include or exclude in the
+// resulting schema.
+type ContractVariantContractFilterConfig struct {
+	// Tags of schema elements to include in the contract schema.
+	Include []string `json:"include"`
+	// Tags of schema elements to exclude from the contract schema.
+	Exclude []string `json:"exclude"`
+	// Whether to hide unreachable objects, interfaces, unions, inputs, enums and scalars from the resulting contract schema.
+	HideUnreachableTypes bool `json:"hideUnreachableTypes"`
 }
 
-// GetServiceId returns __createKeyInput.ServiceId, and is useful for accessing the field via an interface.
-func (v *__createKeyInput) GetServiceId() string { return v.ServiceId }
+// GetInclude returns ContractVariantContractFilterConfig.Include, and is useful for accessing the field via an interface.
+func (v *ContractVariantContractFilterConfig) GetInclude() []string { return v.Include }
 
-// GetKeyName returns __createKeyInput.KeyName, and is useful for accessing the field via an interface.
-func (v *__createKeyInput) GetKeyName() string { return v.KeyName }
+// GetExclude returns ContractVariantContractFilterConfig.Exclude, and is useful for accessing the field via an interface.
+func (v *ContractVariantContractFilterConfig) GetExclude() []string { return v.Exclude }
 
-// GetRole returns __createKeyInput.Role, and is useful for accessing the field via an interface.
-func (v *__createKeyInput) GetRole() string { return v.Role }
+// GetHideUnreachableTypes returns ContractVariantContractFilterConfig.HideUnreachableTypes, and is useful for accessing the field via an interface.
+func (v *ContractVariantContractFilterConfig) GetHideUnreachableTypes() bool {
+	return v.HideUnreachableTypes
+}
 
-// __createServiceInput is used internally by genqlient
-type __createServiceInput struct {
-	Id                     string `json:"id"`
-	Title                  string `json:"title"`
-	OnboardingArchitecture string `json:"onboardingArchitecture"`
-	AccountId              string `json:"accountId"`
-	Description            string `json:"description"`
+// ContractVariantLatestLaunch includes the requested fields of the GraphQL type Launch.
+// The GraphQL type's documentation follows.
+//
+// Represents the complete process of making a set of updates to a deployed graph variant.
+type ContractVariantLatestLaunch struct {
+	// The launch's status. If a launch is superseded, its status remains
+	// `LAUNCH_INITIATED`. To check for a superseded launch, use `supersededAt`.
+	Status LaunchStatus `json:"status"`
+	// The associated build for this launch (a build includes schema composition and
+	// contract filtering). This value is null until the build is initiated.
+	Build *ContractVariantLatestLaunchBuild `json:"build"`
 }
 
-// GetId returns __createServiceInput.Id, and is useful for accessing the field via an interface.
-func (v *__createServiceInput) GetId() string { return v.Id }
+// GetStatus returns ContractVariantLatestLaunch.Status, and is useful for accessing the field via an interface.
+func (v *ContractVariantLatestLaunch) GetStatus() LaunchStatus { return v.Status }
 
-// GetTitle returns __createServiceInput.Title, and is useful for accessing the field via an interface.
-func (v *__createServiceInput) GetTitle() string { return v.Title }
+// GetBuild returns ContractVariantLatestLaunch.Build, and is useful for accessing the field via an interface.
+func (v *ContractVariantLatestLaunch) GetBuild() *ContractVariantLatestLaunchBuild { return v.Build }
 
-// GetOnboardingArchitecture returns __createServiceInput.OnboardingArchitecture, and is useful for accessing the field via an interface.
-func (v *__createServiceInput) GetOnboardingArchitecture() string { return v.OnboardingArchitecture }
+// ContractVariantLatestLaunchBuild includes the requested fields of the GraphQL type Build.
+// The GraphQL type's documentation follows.
+//
+// The building of a Studio variant (including supergraph composition and any contract filtering) as part of a launch.
+type ContractVariantLatestLaunchBuild struct {
+	// The result of the build. This value is null until the build completes.
+	Result ContractVariantLatestLaunchBuildResult `json:"-"`
+}
 
-// GetAccountId returns __createServiceInput.AccountId, and is useful for accessing the field via an interface.
-func (v *__createServiceInput) GetAccountId() string { return v.AccountId }
+// GetResult returns ContractVariantLatestLaunchBuild.Result, and is useful for accessing the field via an interface.
+func (v *ContractVariantLatestLaunchBuild) GetResult() ContractVariantLatestLaunchBuildResult {
+	return v.Result
+}
 
-// GetDescription returns __createServiceInput.Description, and is useful for accessing the field via an interface.
-func (v *__createServiceInput) GetDescription() string { return v.Description }
+func (v *ContractVariantLatestLaunchBuild) UnmarshalJSON(b []byte) error {
 
-// __createVariantInput is used internally by genqlient
-type __createVariantInput struct {
-	ServiceId   string `json:"serviceId"`
-	VariantName string `json:"variantName"`
-}
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetServiceId returns __createVariantInput.ServiceId, and is useful for accessing the field via an interface.
-func (v *__createVariantInput) GetServiceId() string { return v.ServiceId }
+	var firstPass struct {
+		*ContractVariantLatestLaunchBuild
+		Result json.RawMessage `json:"result"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ContractVariantLatestLaunchBuild = v
 
-// GetVariantName returns __createVariantInput.VariantName, and is useful for accessing the field via an interface.
-func (v *__createVariantInput) GetVariantName() string { return v.VariantName }
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
 
-// __deleteKeyInput is used internally by genqlient
-type __deleteKeyInput struct {
-	ServiceId string `json:"serviceId"`
-	KeyId     string `json:"keyId"`
+	{
+		dst := &v.Result
+		src := firstPass.Result
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalContractVariantLatestLaunchBuildResult(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal ContractVariantLatestLaunchBuild.Result: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetServiceId returns __deleteKeyInput.ServiceId, and is useful for accessing the field via an interface.
-func (v *__deleteKeyInput) GetServiceId() string { return v.ServiceId }
+type __premarshalContractVariantLatestLaunchBuild struct {
+	Result json.RawMessage `json:"result"`
+}
 
-// GetKeyId returns __deleteKeyInput.KeyId, and is useful for accessing the field via an interface.
-func (v *__deleteKeyInput) GetKeyId() string { return v.KeyId }
+func (v *ContractVariantLatestLaunchBuild) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
 
-// __deleteServiceInput is used internally by genqlient
-type __deleteServiceInput struct {
-	Id string `json:"id"`
+func (v *ContractVariantLatestLaunchBuild) __premarshalJSON() (*__premarshalContractVariantLatestLaunchBuild, error) {
+	var retval __premarshalContractVariantLatestLaunchBuild
+
+	{
+
+		dst := &retval.Result
+		src := v.Result
+		var err error
+		*dst, err = __marshalContractVariantLatestLaunchBuildResult(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal ContractVariantLatestLaunchBuild.Result: %w", err)
+		}
+	}
+	return &retval, nil
 }
 
-// GetId returns __deleteServiceInput.Id, and is useful for accessing the field via an interface.
-func (v *__deleteServiceInput) GetId() string { return v.Id }
+// ContractVariantLatestLaunchBuildResult includes the requested fields of the GraphQL interface BuildResult.
+//
+// ContractVariantLatestLaunchBuildResult is implemented by the following types:
+// ContractVariantLatestLaunchBuildResultBuildFailure
+// ContractVariantLatestLaunchBuildResultBuildSuccess
+type ContractVariantLatestLaunchBuildResult interface {
+	implementsGraphQLInterfaceContractVariantLatestLaunchBuildResult()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
 
-// __deleteVariantInput is used internally by genqlient
-type __deleteVariantInput struct {
-	ServiceId   string `json:"serviceId"`
-	VariantName string `json:"variantName"`
+func (v *ContractVariantLatestLaunchBuildResultBuildFailure) implementsGraphQLInterfaceContractVariantLatestLaunchBuildResult() {
+}
+func (v *ContractVariantLatestLaunchBuildResultBuildSuccess) implementsGraphQLInterfaceContractVariantLatestLaunchBuildResult() {
 }
 
-// GetServiceId returns __deleteVariantInput.ServiceId, and is useful for accessing the field via an interface.
-func (v *__deleteVariantInput) GetServiceId() string { return v.ServiceId }
+func __unmarshalContractVariantLatestLaunchBuildResult(b []byte, v *ContractVariantLatestLaunchBuildResult) error {
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetVariantName returns __deleteVariantInput.VariantName, and is useful for accessing the field via an interface.
-func (v *__deleteVariantInput) GetVariantName() string { return v.VariantName }
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
 
-// __getServiceInput is used internally by genqlient
-type __getServiceInput struct {
-	Id string `json:"id"`
+	switch tn.TypeName {
+	case "BuildFailure":
+		*v = new(ContractVariantLatestLaunchBuildResultBuildFailure)
+		return json.Unmarshal(b, *v)
+	case "BuildSuccess":
+		*v = new(ContractVariantLatestLaunchBuildResultBuildSuccess)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing BuildResult.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ContractVariantLatestLaunchBuildResult: "%v"`, tn.TypeName)
+	}
 }
 
-// GetId returns __getServiceInput.Id, and is useful for accessing the field via an interface.
-func (v *__getServiceInput) GetId() string { return v.Id }
+func __marshalContractVariantLatestLaunchBuildResult(v *ContractVariantLatestLaunchBuildResult) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ContractVariantLatestLaunchBuildResultBuildFailure:
+		typename = "BuildFailure"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ContractVariantLatestLaunchBuildResultBuildFailure
+		}{typename, v}
+		return json.Marshal(result)
+	case *ContractVariantLatestLaunchBuildResultBuildSuccess:
+		typename = "BuildSuccess"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ContractVariantLatestLaunchBuildResultBuildSuccess
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ContractVariantLatestLaunchBuildResult: "%T"`, v)
+	}
+}
 
-// __getVariantInput is used internally by genqlient
-type __getVariantInput struct {
-	ServiceId   string `json:"serviceId"`
-	VariantName string `json:"variantName"`
+// ContractVariantLatestLaunchBuildResultBuildFailure includes the requested fields of the GraphQL type BuildFailure.
+// The GraphQL type's documentation follows.
+//
+// Contains the details of an executed build that failed.
+type ContractVariantLatestLaunchBuildResultBuildFailure struct {
+	Typename string `json:"__typename"`
+	// A list of all errors that occurred during the failed build.
+	ErrorMessages []ContractVariantLatestLaunchBuildResultBuildFailureErrorMessagesBuildError `json:"errorMessages"`
 }
 
-// GetServiceId returns __getVariantInput.ServiceId, and is useful for accessing the field via an interface.
-func (v *__getVariantInput) GetServiceId() string { return v.ServiceId }
+// GetTypename returns ContractVariantLatestLaunchBuildResultBuildFailure.Typename, and is useful for accessing the field via an interface.
+func (v *ContractVariantLatestLaunchBuildResultBuildFailure) GetTypename() string { return v.Typename }
 
-// GetVariantName returns __getVariantInput.VariantName, and is useful for accessing the field via an interface.
-func (v *__getVariantInput) GetVariantName() string { return v.VariantName }
+// GetErrorMessages returns ContractVariantLatestLaunchBuildResultBuildFailure.ErrorMessages, and is useful for accessing the field via an interface.
+func (v *ContractVariantLatestLaunchBuildResultBuildFailure) GetErrorMessages() []ContractVariantLatestLaunchBuildResultBuildFailureErrorMessagesBuildError {
+	return v.ErrorMessages
+}
 
-// __listKeysInput is used internally by genqlient
-type __listKeysInput struct {
-	ServiceId string `json:"serviceId"`
+// ContractVariantLatestLaunchBuildResultBuildFailureErrorMessagesBuildError includes the requested fields of the GraphQL type BuildError.
+// The GraphQL type's documentation follows.
+//
+// A single error that occurred during the failed execution of a build.
+type ContractVariantLatestLaunchBuildResultBuildFailureErrorMessagesBuildError struct {
+	Message string `json:"message"`
 }
 
-// GetServiceId returns __listKeysInput.ServiceId, and is useful for accessing the field via an interface.
-func (v *__listKeysInput) GetServiceId() string { return v.ServiceId }
+// GetMessage returns ContractVariantLatestLaunchBuildResultBuildFailureErrorMessagesBuildError.Message, and is useful for accessing the field via an interface.
+func (v *ContractVariantLatestLaunchBuildResultBuildFailureErrorMessagesBuildError) GetMessage() string {
+	return v.Message
+}
 
-// __updateKeyInput is used internally by genqlient
-type __updateKeyInput struct {
-	ServiceId string `json:"serviceId"`
-	KeyId     string `json:"keyId"`
-	KeyName   string `json:"keyName"`
+// ContractVariantLatestLaunchBuildResultBuildSuccess includes the requested fields of the GraphQL type BuildSuccess.
+// The GraphQL type's documentation follows.
+//
+// Contains the details of an executed build that succeeded.
+type ContractVariantLatestLaunchBuildResultBuildSuccess struct {
+	Typename string `json:"__typename"`
 }
 
-// GetServiceId returns __updateKeyInput.ServiceId, and is useful for accessing the field via an interface.
-func (v *__updateKeyInput) GetServiceId() string { return v.ServiceId }
+// GetTypename returns ContractVariantLatestLaunchBuildResultBuildSuccess.Typename, and is useful for accessing the field via an interface.
+func (v *ContractVariantLatestLaunchBuildResultBuildSuccess) GetTypename() string { return v.Typename }
 
-// GetKeyId returns __updateKeyInput.KeyId, and is useful for accessing the field via an interface.
-func (v *__updateKeyInput) GetKeyId() string { return v.KeyId }
+// ContractVariantSourceVariantGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type ContractVariantSourceVariantGraphVariant struct {
+	// The variant's name (e.g., `staging`).
+	Name string `json:"name"`
+}
 
-// GetKeyName returns __updateKeyInput.KeyName, and is useful for accessing the field via an interface.
-func (v *__updateKeyInput) GetKeyName() string { return v.KeyName }
+// GetName returns ContractVariantSourceVariantGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *ContractVariantSourceVariantGraphVariant) GetName() string { return v.Name }
 
-// __updateServiceDescriptionInput is used internally by genqlient
-type __updateServiceDescriptionInput struct {
-	Id          string `json:"id"`
-	Description string `json:"description"`
+// Option to filter by operation ID.
+type ExcludedOperationInput struct {
+	// Operation ID to exclude from schema check.
+	ID string `json:"ID"`
 }
 
-// GetId returns __updateServiceDescriptionInput.Id, and is useful for accessing the field via an interface.
-func (v *__updateServiceDescriptionInput) GetId() string { return v.Id }
+// GetID returns ExcludedOperationInput.ID, and is useful for accessing the field via an interface.
+func (v *ExcludedOperationInput) GetID() string { return v.ID }
+
+// The changes to the linter configuration for this graph.
+type GraphLinterConfigurationChangesInput struct {
+	// A set of allowed @tag names to be added to the linting configuration for this graph or null if no changes should be made.
+	AllowedTagNameAdditions []string `json:"allowedTagNameAdditions"`
+	// A set of @tag names to be removed from the allowed @tag list for this graphs
+	// linting configuration or null if no changes should be made.
+	AllowedTagNameRemovals []string `json:"allowedTagNameRemovals"`
+	// Change whether @deprecated elements should be linted or null if no changes should be made.
+	IgnoreDeprecated *bool `json:"ignoreDeprecated"`
+	// Change whether @inaccessible elements should be linted or null if no changes should be made.
+	IgnoreInaccessible *bool `json:"ignoreInaccessible"`
+	// A set of rule changes or null if no changes should be made.
+	Rules []LinterRuleLevelConfigurationChangesInput `json:"rules"`
+}
 
-// GetDescription returns __updateServiceDescriptionInput.Description, and is useful for accessing the field via an interface.
-func (v *__updateServiceDescriptionInput) GetDescription() string { return v.Description }
+// GetAllowedTagNameAdditions returns GraphLinterConfigurationChangesInput.AllowedTagNameAdditions, and is useful for accessing the field via an interface.
+func (v *GraphLinterConfigurationChangesInput) GetAllowedTagNameAdditions() []string {
+	return v.AllowedTagNameAdditions
+}
 
-// __updateServiceTitleInput is used internally by genqlient
-type __updateServiceTitleInput struct {
-	Id    string `json:"id"`
-	Title string `json:"title"`
+// GetAllowedTagNameRemovals returns GraphLinterConfigurationChangesInput.AllowedTagNameRemovals, and is useful for accessing the field via an interface.
+func (v *GraphLinterConfigurationChangesInput) GetAllowedTagNameRemovals() []string {
+	return v.AllowedTagNameRemovals
 }
 
-// GetId returns __updateServiceTitleInput.Id, and is useful for accessing the field via an interface.
-func (v *__updateServiceTitleInput) GetId() string { return v.Id }
+// GetIgnoreDeprecated returns GraphLinterConfigurationChangesInput.IgnoreDeprecated, and is useful for accessing the field via an interface.
+func (v *GraphLinterConfigurationChangesInput) GetIgnoreDeprecated() *bool { return v.IgnoreDeprecated }
 
-// GetTitle returns __updateServiceTitleInput.Title, and is useful for accessing the field via an interface.
-func (v *__updateServiceTitleInput) GetTitle() string { return v.Title }
+// GetIgnoreInaccessible returns GraphLinterConfigurationChangesInput.IgnoreInaccessible, and is useful for accessing the field via an interface.
+func (v *GraphLinterConfigurationChangesInput) GetIgnoreInaccessible() *bool {
+	return v.IgnoreInaccessible
+}
 
-// __updateVariantIsPublicInput is used internally by genqlient
-type __updateVariantIsPublicInput struct {
-	ServiceId   string `json:"serviceId"`
-	VariantName string `json:"variantName"`
-	IsPublic    bool   `json:"isPublic"`
+// GetRules returns GraphLinterConfigurationChangesInput.Rules, and is useful for accessing the field via an interface.
+func (v *GraphLinterConfigurationChangesInput) GetRules() []LinterRuleLevelConfigurationChangesInput {
+	return v.Rules
 }
 
-// GetServiceId returns __updateVariantIsPublicInput.ServiceId, and is useful for accessing the field via an interface.
-func (v *__updateVariantIsPublicInput) GetServiceId() string { return v.ServiceId }
+// GraphUserPermission includes the GraphQL fields of RoleOverride requested by the fragment GraphUserPermission.
+type GraphUserPermission struct {
+	User GraphUserPermissionUser `json:"user"`
+	Role string                  `json:"role"`
+}
 
-// GetVariantName returns __updateVariantIsPublicInput.VariantName, and is useful for accessing the field via an interface.
-func (v *__updateVariantIsPublicInput) GetVariantName() string { return v.VariantName }
+// GetUser returns GraphUserPermission.User, and is useful for accessing the field via an interface.
+func (v *GraphUserPermission) GetUser() GraphUserPermissionUser { return v.User }
 
-// GetIsPublic returns __updateVariantIsPublicInput.IsPublic, and is useful for accessing the field via an interface.
-func (v *__updateVariantIsPublicInput) GetIsPublic() bool { return v.IsPublic }
+// GetRole returns GraphUserPermission.Role, and is useful for accessing the field via an interface.
+func (v *GraphUserPermission) GetRole() string { return v.Role }
 
-// __updateVariantURLInput is used internally by genqlient
-type __updateVariantURLInput struct {
-	ServiceId   string  `json:"serviceId"`
-	VariantName string  `json:"variantName"`
-	Url         *string `json:"url"`
+// GraphUserPermissionUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A registered Apollo Studio user.
+type GraphUserPermissionUser struct {
+	// The user's unique ID.
+	Id string `json:"id"`
 }
 
-// GetServiceId returns __updateVariantURLInput.ServiceId, and is useful for accessing the field via an interface.
-func (v *__updateVariantURLInput) GetServiceId() string { return v.ServiceId }
+// GetId returns GraphUserPermissionUser.Id, and is useful for accessing the field via an interface.
+func (v *GraphUserPermissionUser) GetId() string { return v.Id }
 
-// GetVariantName returns __updateVariantURLInput.VariantName, and is useful for accessing the field via an interface.
-func (v *__updateVariantURLInput) GetVariantName() string { return v.VariantName }
+// Key includes the GraphQL fields of GraphApiKey requested by the fragment Key.
+// The GraphQL type's documentation follows.
+//
+// Represents a graph API key, which has permissions scoped to a
+// user role for a single Apollo graph.
+type Key struct {
+	// The API key's ID.
+	Id string `json:"id"`
+	// The API key's name, for distinguishing it from other keys.
+	KeyName string `json:"keyName"`
+	// The permission level assigned to the API key upon creation.
+	Role string `json:"role"`
+	// The value of the API key. **This is a secret credential!**
+	Token string `json:"token"`
+}
 
-// GetUrl returns __updateVariantURLInput.Url, and is useful for accessing the field via an interface.
-func (v *__updateVariantURLInput) GetUrl() *string { return v.Url }
+// GetId returns Key.Id, and is useful for accessing the field via an interface.
+func (v *Key) GetId() string { return v.Id }
 
-// createKeyResponse is returned by createKey on success.
-type createKeyResponse struct {
-	Service createKeyServiceServiceMutation `json:"service"`
-}
+// GetKeyName returns Key.KeyName, and is useful for accessing the field via an interface.
+func (v *Key) GetKeyName() string { return v.KeyName }
 
-// GetService returns createKeyResponse.Service, and is useful for accessing the field via an interface.
-func (v *createKeyResponse) GetService() createKeyServiceServiceMutation { return v.Service }
+// GetRole returns Key.Role, and is useful for accessing the field via an interface.
+func (v *Key) GetRole() string { return v.Role }
 
-// createKeyServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// GetToken returns Key.Token, and is useful for accessing the field via an interface.
+func (v *Key) GetToken() string { return v.Token }
+
+type LaunchStatus string
+
+const (
+	LaunchStatusLaunchCompleted LaunchStatus = "LAUNCH_COMPLETED"
+	LaunchStatusLaunchFailed    LaunchStatus = "LAUNCH_FAILED"
+	LaunchStatusLaunchInitiated LaunchStatus = "LAUNCH_INITIATED"
+)
+
+type LinkInfoType string
+
+const (
+	LinkInfoTypeDeveloperPortal LinkInfoType = "DEVELOPER_PORTAL"
+	LinkInfoTypeOther           LinkInfoType = "OTHER"
+	LinkInfoTypeRepository      LinkInfoType = "REPOSITORY"
+)
+
+// The severity level of an lint result.
+type LintDiagnosticLevel string
+
+const (
+	LintDiagnosticLevelError   LintDiagnosticLevel = "ERROR"
+	LintDiagnosticLevelIgnored LintDiagnosticLevel = "IGNORED"
+	LintDiagnosticLevelWarning LintDiagnosticLevel = "WARNING"
+)
+
+type LintRule string
+
+const (
+	LintRuleAllElementsRequireDescription               LintRule = "ALL_ELEMENTS_REQUIRE_DESCRIPTION"
+	LintRuleContactDirectiveMissing                     LintRule = "CONTACT_DIRECTIVE_MISSING"
+	LintRuleDefinedTypesAreUnused                       LintRule = "DEFINED_TYPES_ARE_UNUSED"
+	LintRuleDeprecatedDirectiveMissingReason            LintRule = "DEPRECATED_DIRECTIVE_MISSING_REASON"
+	LintRuleDirectiveComposition                        LintRule = "DIRECTIVE_COMPOSITION"
+	LintRuleDirectiveNamesShouldBeCamelCase             LintRule = "DIRECTIVE_NAMES_SHOULD_BE_CAMEL_CASE"
+	LintRuleDoesNotParse                                LintRule = "DOES_NOT_PARSE"
+	LintRuleEnumPrefix                                  LintRule = "ENUM_PREFIX"
+	LintRuleEnumSuffix                                  LintRule = "ENUM_SUFFIX"
+	LintRuleEnumUsedAsInputWithoutSuffix                LintRule = "ENUM_USED_AS_INPUT_WITHOUT_SUFFIX"
+	LintRuleEnumUsedAsOutputDespiteSuffix               LintRule = "ENUM_USED_AS_OUTPUT_DESPITE_SUFFIX"
+	LintRuleEnumValuesShouldBeScreamingSnakeCase        LintRule = "ENUM_VALUES_SHOULD_BE_SCREAMING_SNAKE_CASE"
+	LintRuleFieldNamesShouldBeCamelCase                 LintRule = "FIELD_NAMES_SHOULD_BE_CAMEL_CASE"
+	LintRuleFromSubgraphDoesNotExist                    LintRule = "FROM_SUBGRAPH_DOES_NOT_EXIST"
+	LintRuleInconsistentArgumentPresence                LintRule = "INCONSISTENT_ARGUMENT_PRESENCE"
+	LintRuleInconsistentButCompatibleArgumentType       LintRule = "INCONSISTENT_BUT_COMPATIBLE_ARGUMENT_TYPE"
+	LintRuleInconsistentButCompatibleFieldType          LintRule = "INCONSISTENT_BUT_COMPATIBLE_FIELD_TYPE"
+	LintRuleInconsistentDefaultValuePresence            LintRule = "INCONSISTENT_DEFAULT_VALUE_PRESENCE"
+	LintRuleInconsistentDescription                     LintRule = "INCONSISTENT_DESCRIPTION"
+	LintRuleInconsistentEntity                          LintRule = "INCONSISTENT_ENTITY"
+	LintRuleInconsistentEnumValueForInputEnum           LintRule = "INCONSISTENT_ENUM_VALUE_FOR_INPUT_ENUM"
+	LintRuleInconsistentEnumValueForOutputEnum          LintRule = "INCONSISTENT_ENUM_VALUE_FOR_OUTPUT_ENUM"
+	LintRuleInconsistentExecutableDirectiveLocations    LintRule = "INCONSISTENT_EXECUTABLE_DIRECTIVE_LOCATIONS"
+	LintRuleInconsistentExecutableDirectivePresence     LintRule = "INCONSISTENT_EXECUTABLE_DIRECTIVE_PRESENCE"
+	LintRuleInconsistentExecutableDirectiveRepeatable   LintRule = "INCONSISTENT_EXECUTABLE_DIRECTIVE_REPEATABLE"
+	LintRuleInconsistentInputObjectField                LintRule = "INCONSISTENT_INPUT_OBJECT_FIELD"
+	LintRuleInconsistentInterfaceValueTypeField         LintRule = "INCONSISTENT_INTERFACE_VALUE_TYPE_FIELD"
+	LintRuleInconsistentNonRepeatableDirectiveArguments LintRule = "INCONSISTENT_NON_REPEATABLE_DIRECTIVE_ARGUMENTS"
+	LintRuleInconsistentObjectValueTypeField            LintRule = "INCONSISTENT_OBJECT_VALUE_TYPE_FIELD"
+	LintRuleInconsistentRuntimeTypesForShareableReturn  LintRule = "INCONSISTENT_RUNTIME_TYPES_FOR_SHAREABLE_RETURN"
+	LintRuleInconsistentTypeSystemDirectiveLocations    LintRule = "INCONSISTENT_TYPE_SYSTEM_DIRECTIVE_LOCATIONS"
+	LintRuleInconsistentTypeSystemDirectiveRepeatable   LintRule = "INCONSISTENT_TYPE_SYSTEM_DIRECTIVE_REPEATABLE"
+	LintRuleInconsistentUnionMember                     LintRule = "INCONSISTENT_UNION_MEMBER"
+	LintRuleInputArgumentNamesShouldBeCamelCase         LintRule = "INPUT_ARGUMENT_NAMES_SHOULD_BE_CAMEL_CASE"
+	LintRuleInputTypeSuffix                             LintRule = "INPUT_TYPE_SUFFIX"
+	LintRuleInterfacePrefix                             LintRule = "INTERFACE_PREFIX"
+	LintRuleInterfaceSuffix                             LintRule = "INTERFACE_SUFFIX"
+	LintRuleMergedNonRepeatableDirectiveArguments       LintRule = "MERGED_NON_REPEATABLE_DIRECTIVE_ARGUMENTS"
+	LintRuleNoExecutableDirectiveIntersection           LintRule = "NO_EXECUTABLE_DIRECTIVE_INTERSECTION"
+	LintRuleObjectPrefix                                LintRule = "OBJECT_PREFIX"
+	LintRuleObjectSuffix                                LintRule = "OBJECT_SUFFIX"
+	LintRuleOverriddenFieldCanBeRemoved                 LintRule = "OVERRIDDEN_FIELD_CAN_BE_REMOVED"
+	LintRuleOverrideDirectiveCanBeRemoved               LintRule = "OVERRIDE_DIRECTIVE_CAN_BE_REMOVED"
+	LintRuleQueryDocumentDeclaration                    LintRule = "QUERY_DOCUMENT_DECLARATION"
+	LintRuleRestyFieldNames                             LintRule = "RESTY_FIELD_NAMES"
+	LintRuleTagDirectiveUsesUnknownName                 LintRule = "TAG_DIRECTIVE_USES_UNKNOWN_NAME"
+	LintRuleTypeNamesShouldBePascalCase                 LintRule = "TYPE_NAMES_SHOULD_BE_PASCAL_CASE"
+	LintRuleTypePrefix                                  LintRule = "TYPE_PREFIX"
+	LintRuleTypeSuffix                                  LintRule = "TYPE_SUFFIX"
+	LintRuleUnusedEnumType                              LintRule = "UNUSED_ENUM_TYPE"
+)
+
+// LinterConfiguration includes the GraphQL fields of GraphLinterConfiguration requested by the fragment LinterConfiguration.
 // The GraphQL type's documentation follows.
 //
-// Provides access to mutation fields for managing Studio graphs and subgraphs.
-type createKeyServiceServiceMutation struct {
-	// Generates a new graph API key for this graph with the specified permission level.
-	NewKey createKeyServiceServiceMutationNewKeyGraphApiKey `json:"newKey"`
+// The linter configuration for this graph.
+type LinterConfiguration struct {
+	// The set of @tag names allowed in the schema.
+	AllowedTagNames []string `json:"allowedTagNames"`
+	// Whether to ignore @deprecated elements from linting violations.
+	IgnoreDeprecated bool `json:"ignoreDeprecated"`
+	// Whether to ignore @inaccessible elements from linting violations.
+	IgnoreInaccessible bool `json:"ignoreInaccessible"`
+	// The set of lint rules configured for this graph.
+	Rules []LinterConfigurationRulesLinterRuleLevelConfiguration `json:"rules"`
 }
 
-// GetNewKey returns createKeyServiceServiceMutation.NewKey, and is useful for accessing the field via an interface.
-func (v *createKeyServiceServiceMutation) GetNewKey() createKeyServiceServiceMutationNewKeyGraphApiKey {
-	return v.NewKey
+// GetAllowedTagNames returns LinterConfiguration.AllowedTagNames, and is useful for accessing the field via an interface.
+func (v *LinterConfiguration) GetAllowedTagNames() []string { return v.AllowedTagNames }
+
+// GetIgnoreDeprecated returns LinterConfiguration.IgnoreDeprecated, and is useful for accessing the field via an interface.
+func (v *LinterConfiguration) GetIgnoreDeprecated() bool { return v.IgnoreDeprecated }
+
+// GetIgnoreInaccessible returns LinterConfiguration.IgnoreInaccessible, and is useful for accessing the field via an interface.
+func (v *LinterConfiguration) GetIgnoreInaccessible() bool { return v.IgnoreInaccessible }
+
+// GetRules returns LinterConfiguration.Rules, and is useful for accessing the field via an interface.
+func (v *LinterConfiguration) GetRules() []LinterConfigurationRulesLinterRuleLevelConfiguration {
+	return v.Rules
 }
 
-// createKeyServiceServiceMutationNewKeyGraphApiKey includes the requested fields of the GraphQL type GraphApiKey.
+// LinterConfigurationRulesLinterRuleLevelConfiguration includes the requested fields of the GraphQL type LinterRuleLevelConfiguration.
+type LinterConfigurationRulesLinterRuleLevelConfiguration struct {
+	// The name for this lint rule.
+	Rule LintRule `json:"rule"`
+	// The configured level for the rule.
+	Level LintDiagnosticLevel `json:"level"`
+}
+
+// GetRule returns LinterConfigurationRulesLinterRuleLevelConfiguration.Rule, and is useful for accessing the field via an interface.
+func (v *LinterConfigurationRulesLinterRuleLevelConfiguration) GetRule() LintRule { return v.Rule }
+
+// GetLevel returns LinterConfigurationRulesLinterRuleLevelConfiguration.Level, and is useful for accessing the field via an interface.
+func (v *LinterConfigurationRulesLinterRuleLevelConfiguration) GetLevel() LintDiagnosticLevel {
+	return v.Level
+}
+
+type LinterRuleLevelConfigurationChangesInput struct {
+	Level LintDiagnosticLevel `json:"level"`
+	Rule  LintRule            `json:"rule"`
+}
+
+// GetLevel returns LinterRuleLevelConfigurationChangesInput.Level, and is useful for accessing the field via an interface.
+func (v *LinterRuleLevelConfigurationChangesInput) GetLevel() LintDiagnosticLevel { return v.Level }
+
+// GetRule returns LinterRuleLevelConfigurationChangesInput.Rule, and is useful for accessing the field via an interface.
+func (v *LinterRuleLevelConfigurationChangesInput) GetRule() LintRule { return v.Rule }
+
+type OperationInfoFilterInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns OperationInfoFilterInput.Id, and is useful for accessing the field via an interface.
+func (v *OperationInfoFilterInput) GetId() string { return v.Id }
+
+type OperationType string
+
+const (
+	OperationTypeMutation     OperationType = "MUTATION"
+	OperationTypeQuery        OperationType = "QUERY"
+	OperationTypeSubscription OperationType = "SUBSCRIPTION"
+)
+
+// OrganizationInvitation includes the GraphQL fields of AccountInvitation requested by the fragment OrganizationInvitation.
+type OrganizationInvitation struct {
+	Id    string `json:"id"`
+	Email string `json:"email"`
+	// Access role for the invitee
+	Role string `json:"role"`
+	// An accepted invitation cannot be used anymore
+	AcceptedAt *time.Time `json:"acceptedAt"`
+}
+
+// GetId returns OrganizationInvitation.Id, and is useful for accessing the field via an interface.
+func (v *OrganizationInvitation) GetId() string { return v.Id }
+
+// GetEmail returns OrganizationInvitation.Email, and is useful for accessing the field via an interface.
+func (v *OrganizationInvitation) GetEmail() string { return v.Email }
+
+// GetRole returns OrganizationInvitation.Role, and is useful for accessing the field via an interface.
+func (v *OrganizationInvitation) GetRole() string { return v.Role }
+
+// GetAcceptedAt returns OrganizationInvitation.AcceptedAt, and is useful for accessing the field via an interface.
+func (v *OrganizationInvitation) GetAcceptedAt() *time.Time { return v.AcceptedAt }
+
+// OrganizationInviteLink includes the GraphQL fields of OrganizationInviteLink requested by the fragment OrganizationInviteLink.
 // The GraphQL type's documentation follows.
 //
-// Represents a graph API key, which has permissions scoped to a
-// user role for a single Apollo graph.
-type createKeyServiceServiceMutationNewKeyGraphApiKey struct {
-	Key `json:"-"`
+// A reusable invite link for an organization.
+type OrganizationInviteLink struct {
+	// A joinToken that can be passed to Mutation.joinAccount to join the organization.
+	JoinToken string `json:"joinToken"`
+	// The role that the user will receive if they join the organization with this link.
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
 }
 
-// GetId returns createKeyServiceServiceMutationNewKeyGraphApiKey.Id, and is useful for accessing the field via an interface.
-func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) GetId() string { return v.Key.Id }
+// GetJoinToken returns OrganizationInviteLink.JoinToken, and is useful for accessing the field via an interface.
+func (v *OrganizationInviteLink) GetJoinToken() string { return v.JoinToken }
 
-// GetKeyName returns createKeyServiceServiceMutationNewKeyGraphApiKey.KeyName, and is useful for accessing the field via an interface.
-func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) GetKeyName() string { return v.Key.KeyName }
+// GetRole returns OrganizationInviteLink.Role, and is useful for accessing the field via an interface.
+func (v *OrganizationInviteLink) GetRole() string { return v.Role }
 
-// GetRole returns createKeyServiceServiceMutationNewKeyGraphApiKey.Role, and is useful for accessing the field via an interface.
-func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) GetRole() string { return v.Key.Role }
+// GetCreatedAt returns OrganizationInviteLink.CreatedAt, and is useful for accessing the field via an interface.
+func (v *OrganizationInviteLink) GetCreatedAt() time.Time { return v.CreatedAt }
 
-// GetToken returns createKeyServiceServiceMutationNewKeyGraphApiKey.Token, and is useful for accessing the field via an interface.
-func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) GetToken() string { return v.Key.Token }
+// OrganizationMember includes the GraphQL fields of AccountMembership requested by the fragment OrganizationMember.
+type OrganizationMember struct {
+	User       OrganizationMemberUser `json:"user"`
+	Permission string                 `json:"permission"`
+}
 
-func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) UnmarshalJSON(b []byte) error {
+// GetUser returns OrganizationMember.User, and is useful for accessing the field via an interface.
+func (v *OrganizationMember) GetUser() OrganizationMemberUser { return v.User }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetPermission returns OrganizationMember.Permission, and is useful for accessing the field via an interface.
+func (v *OrganizationMember) GetPermission() string { return v.Permission }
 
-	var firstPass struct {
-		*createKeyServiceServiceMutationNewKeyGraphApiKey
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.createKeyServiceServiceMutationNewKeyGraphApiKey = v
+// OrganizationMemberUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A registered Apollo Studio user.
+type OrganizationMemberUser struct {
+	// The user's unique ID.
+	Id    string  `json:"id"`
+	Email *string `json:"email"`
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetId returns OrganizationMemberUser.Id, and is useful for accessing the field via an interface.
+func (v *OrganizationMemberUser) GetId() string { return v.Id }
 
-	err = json.Unmarshal(
-		b, &v.Key)
-	if err != nil {
-		return err
-	}
-	return nil
+// GetEmail returns OrganizationMemberUser.Email, and is useful for accessing the field via an interface.
+func (v *OrganizationMemberUser) GetEmail() *string { return v.Email }
+
+// PagerDutyChannel includes the GraphQL fields of PagerDutyChannel requested by the fragment PagerDutyChannel.
+// The GraphQL type's documentation follows.
+//
+// PagerDuty notification channel
+type PagerDutyChannel struct {
+	Id         string `json:"id"`
+	Name       string `json:"name"`
+	RoutingKey string `json:"routingKey"`
 }
 
-type __premarshalcreateKeyServiceServiceMutationNewKeyGraphApiKey struct {
-	Id string `json:"id"`
+// GetId returns PagerDutyChannel.Id, and is useful for accessing the field via an interface.
+func (v *PagerDutyChannel) GetId() string { return v.Id }
 
-	KeyName string `json:"keyName"`
+// GetName returns PagerDutyChannel.Name, and is useful for accessing the field via an interface.
+func (v *PagerDutyChannel) GetName() string { return v.Name }
 
-	Role string `json:"role"`
+// GetRoutingKey returns PagerDutyChannel.RoutingKey, and is useful for accessing the field via an interface.
+func (v *PagerDutyChannel) GetRoutingKey() string { return v.RoutingKey }
 
-	Token string `json:"token"`
+// PagerDuty notification channel parameters
+type PagerDutyChannelInput struct {
+	Name       string `json:"name"`
+	RoutingKey string `json:"routingKey"`
 }
 
-func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+// GetName returns PagerDutyChannelInput.Name, and is useful for accessing the field via an interface.
+func (v *PagerDutyChannelInput) GetName() string { return v.Name }
 
-func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) __premarshalJSON() (*__premarshalcreateKeyServiceServiceMutationNewKeyGraphApiKey, error) {
-	var retval __premarshalcreateKeyServiceServiceMutationNewKeyGraphApiKey
+// GetRoutingKey returns PagerDutyChannelInput.RoutingKey, and is useful for accessing the field via an interface.
+func (v *PagerDutyChannelInput) GetRoutingKey() string { return v.RoutingKey }
 
-	retval.Id = v.Key.Id
-	retval.KeyName = v.Key.KeyName
-	retval.Role = v.Key.Role
-	retval.Token = v.Key.Token
-	return &retval, nil
+// Operations to be published to the Persisted Query List.
+type PersistedQueryInput struct {
+	// The GraphQL document for this operation, including all necessary fragment definitions.
+	Body string `json:"body"`
+	// An opaque identifier for this operation. This should map uniquely to an
+	// operation body; editing the body should generally result in a new ID. Apollo's
+	// tools generally use the lowercase hex SHA256 of the operation body.
+	Id string `json:"id"`
+	// A name for the operation. Typically this is the name of the actual GraphQL
+	// operation in the body. This does not need to be unique within a Persisted
+	// Query List; as a client project evolves and its operations change, multiple
+	// operations with the same name (but different body and id) can be published.
+	Name string `json:"name"`
+	// The operation's type.
+	Type OperationType `json:"type"`
 }
 
-// createServiceNewService includes the requested fields of the GraphQL type Service.
+// GetBody returns PersistedQueryInput.Body, and is useful for accessing the field via an interface.
+func (v *PersistedQueryInput) GetBody() string { return v.Body }
+
+// GetId returns PersistedQueryInput.Id, and is useful for accessing the field via an interface.
+func (v *PersistedQueryInput) GetId() string { return v.Id }
+
+// GetName returns PersistedQueryInput.Name, and is useful for accessing the field via an interface.
+func (v *PersistedQueryInput) GetName() string { return v.Name }
+
+// GetType returns PersistedQueryInput.Type, and is useful for accessing the field via an interface.
+func (v *PersistedQueryInput) GetType() OperationType { return v.Type }
+
+// PersistedQueryList includes the GraphQL fields of PersistedQueryList requested by the fragment PersistedQueryList.
 // The GraphQL type's documentation follows.
 //
-// A graph in Apollo Studio represents a graph in your organization.
-// Each graph has one or more variants, which correspond to the different
-// environments where that graph runs (such as staging and production).
-// Each variant has its own GraphQL schema, which means schemas can differ between environments.
-type createServiceNewService struct {
-	Service `json:"-"`
+// TODO
+type PersistedQueryList struct {
+	// The immutable ID for this Persisted Query List.
+	Id string `json:"id"`
+	// The list's name; can be changed and does not need to be unique.
+	Name        string `json:"name"`
+	Description string `json:"description"`
 }
 
-// GetId returns createServiceNewService.Id, and is useful for accessing the field via an interface.
-func (v *createServiceNewService) GetId() string { return v.Service.Id }
+// GetId returns PersistedQueryList.Id, and is useful for accessing the field via an interface.
+func (v *PersistedQueryList) GetId() string { return v.Id }
 
-// GetTitle returns createServiceNewService.Title, and is useful for accessing the field via an interface.
-func (v *createServiceNewService) GetTitle() string { return v.Service.Title }
+// GetName returns PersistedQueryList.Name, and is useful for accessing the field via an interface.
+func (v *PersistedQueryList) GetName() string { return v.Name }
 
-// GetOnboardingArchitecture returns createServiceNewService.OnboardingArchitecture, and is useful for accessing the field via an interface.
-func (v *createServiceNewService) GetOnboardingArchitecture() string {
-	return v.Service.OnboardingArchitecture
+// GetDescription returns PersistedQueryList.Description, and is useful for accessing the field via an interface.
+func (v *PersistedQueryList) GetDescription() string { return v.Description }
+
+// QueryTrigger includes the GraphQL fields of QueryTrigger requested by the fragment QueryTrigger.
+// The GraphQL type's documentation follows.
+//
+// Query Trigger
+type QueryTrigger struct {
+	Id                     string                        `json:"id"`
+	Variant                *string                       `json:"variant"`
+	Metric                 QueryTriggerMetric            `json:"metric"`
+	ComparisonOperator     ComparisonOperator            `json:"comparisonOperator"`
+	Threshold              float64                       `json:"threshold"`
+	Percentile             *float64                      `json:"percentile"`
+	Window                 QueryTriggerWindow            `json:"window"`
+	Scope                  QueryTriggerScope             `json:"scope"`
+	OperationNames         []string                      `json:"operationNames"`
+	ExcludedOperationNames []string                      `json:"excludedOperationNames"`
+	Enabled                bool                          `json:"enabled"`
+	Channels               []QueryTriggerChannelsChannel `json:"-"`
 }
 
-// GetAccountId returns createServiceNewService.AccountId, and is useful for accessing the field via an interface.
-func (v *createServiceNewService) GetAccountId() string { return v.Service.AccountId }
+// GetId returns QueryTrigger.Id, and is useful for accessing the field via an interface.
+func (v *QueryTrigger) GetId() string { return v.Id }
 
-// GetDescription returns createServiceNewService.Description, and is useful for accessing the field via an interface.
-func (v *createServiceNewService) GetDescription() string { return v.Service.Description }
+// GetVariant returns QueryTrigger.Variant, and is useful for accessing the field via an interface.
+func (v *QueryTrigger) GetVariant() *string { return v.Variant }
 
-func (v *createServiceNewService) UnmarshalJSON(b []byte) error {
+// GetMetric returns QueryTrigger.Metric, and is useful for accessing the field via an interface.
+func (v *QueryTrigger) GetMetric() QueryTriggerMetric { return v.Metric }
+
+// GetComparisonOperator returns QueryTrigger.ComparisonOperator, and is useful for accessing the field via an interface.
+func (v *QueryTrigger) GetComparisonOperator() ComparisonOperator { return v.ComparisonOperator }
+
+// GetThreshold returns QueryTrigger.Threshold, and is useful for accessing the field via an interface.
+func (v *QueryTrigger) GetThreshold() float64 { return v.Threshold }
+
+// GetPercentile returns QueryTrigger.Percentile, and is useful for accessing the field via an interface.
+func (v *QueryTrigger) GetPercentile() *float64 { return v.Percentile }
+
+// GetWindow returns QueryTrigger.Window, and is useful for accessing the field via an interface.
+func (v *QueryTrigger) GetWindow() QueryTriggerWindow { return v.Window }
+
+// GetScope returns QueryTrigger.Scope, and is useful for accessing the field via an interface.
+func (v *QueryTrigger) GetScope() QueryTriggerScope { return v.Scope }
+
+// GetOperationNames returns QueryTrigger.OperationNames, and is useful for accessing the field via an interface.
+func (v *QueryTrigger) GetOperationNames() []string { return v.OperationNames }
+
+// GetExcludedOperationNames returns QueryTrigger.ExcludedOperationNames, and is useful for accessing the field via an interface.
+func (v *QueryTrigger) GetExcludedOperationNames() []string { return v.ExcludedOperationNames }
+
+// GetEnabled returns QueryTrigger.Enabled, and is useful for accessing the field via an interface.
+func (v *QueryTrigger) GetEnabled() bool { return v.Enabled }
+
+// GetChannels returns QueryTrigger.Channels, and is useful for accessing the field via an interface.
+func (v *QueryTrigger) GetChannels() []QueryTriggerChannelsChannel { return v.Channels }
+
+func (v *QueryTrigger) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*createServiceNewService
+		*QueryTrigger
+		Channels []json.RawMessage `json:"channels"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.createServiceNewService = v
+	firstPass.QueryTrigger = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	err = json.Unmarshal(
-		b, &v.Service)
-	if err != nil {
-		return err
+	{
+		dst := &v.Channels
+		src := firstPass.Channels
+		*dst = make(
+			[]QueryTriggerChannelsChannel,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalQueryTriggerChannelsChannel(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal QueryTrigger.Channels: %w", err)
+				}
+			}
+		}
 	}
 	return nil
 }
 
-type __premarshalcreateServiceNewService struct {
+type __premarshalQueryTrigger struct {
 	Id string `json:"id"`
 
-	Title string `json:"title"`
+	Variant *string `json:"variant"`
 
-	OnboardingArchitecture string `json:"onboardingArchitecture"`
+	Metric QueryTriggerMetric `json:"metric"`
 
-	AccountId string `json:"accountId"`
+	ComparisonOperator ComparisonOperator `json:"comparisonOperator"`
 
-	Description string `json:"description"`
+	Threshold float64 `json:"threshold"`
+
+	Percentile *float64 `json:"percentile"`
+
+	Window QueryTriggerWindow `json:"window"`
+
+	Scope QueryTriggerScope `json:"scope"`
+
+	OperationNames []string `json:"operationNames"`
+
+	ExcludedOperationNames []string `json:"excludedOperationNames"`
+
+	Enabled bool `json:"enabled"`
+
+	Channels []json.RawMessage `json:"channels"`
 }
 
-func (v *createServiceNewService) MarshalJSON() ([]byte, error) {
+func (v *QueryTrigger) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -454,226 +955,325 @@ func (v *createServiceNewService) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *createServiceNewService) __premarshalJSON() (*__premarshalcreateServiceNewService, error) {
-	var retval __premarshalcreateServiceNewService
-
-	retval.Id = v.Service.Id
-	retval.Title = v.Service.Title
-	retval.OnboardingArchitecture = v.Service.OnboardingArchitecture
-	retval.AccountId = v.Service.AccountId
-	retval.Description = v.Service.Description
+func (v *QueryTrigger) __premarshalJSON() (*__premarshalQueryTrigger, error) {
+	var retval __premarshalQueryTrigger
+
+	retval.Id = v.Id
+	retval.Variant = v.Variant
+	retval.Metric = v.Metric
+	retval.ComparisonOperator = v.ComparisonOperator
+	retval.Threshold = v.Threshold
+	retval.Percentile = v.Percentile
+	retval.Window = v.Window
+	retval.Scope = v.Scope
+	retval.OperationNames = v.OperationNames
+	retval.ExcludedOperationNames = v.ExcludedOperationNames
+	retval.Enabled = v.Enabled
+	{
+
+		dst := &retval.Channels
+		src := v.Channels
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalQueryTriggerChannelsChannel(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal QueryTrigger.Channels: %w", err)
+			}
+		}
+	}
 	return &retval, nil
 }
 
-// createServiceResponse is returned by createService on success.
-type createServiceResponse struct {
-	NewService createServiceNewService `json:"newService"`
+// QueryTriggerChannelsChannel includes the requested fields of the GraphQL interface Channel.
+//
+// QueryTriggerChannelsChannel is implemented by the following types:
+// QueryTriggerChannelsPagerDutyChannel
+// QueryTriggerChannelsSlackChannel
+// QueryTriggerChannelsWebhookChannel
+// The GraphQL type's documentation follows.
+//
+// Destination for notifications
+type QueryTriggerChannelsChannel interface {
+	implementsGraphQLInterfaceQueryTriggerChannelsChannel()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	GetId() string
 }
 
-// GetNewService returns createServiceResponse.NewService, and is useful for accessing the field via an interface.
-func (v *createServiceResponse) GetNewService() createServiceNewService { return v.NewService }
+func (v *QueryTriggerChannelsPagerDutyChannel) implementsGraphQLInterfaceQueryTriggerChannelsChannel() {
+}
+func (v *QueryTriggerChannelsSlackChannel) implementsGraphQLInterfaceQueryTriggerChannelsChannel() {}
+func (v *QueryTriggerChannelsWebhookChannel) implementsGraphQLInterfaceQueryTriggerChannelsChannel() {
+}
 
-// createVariantResponse is returned by createVariant on success.
-type createVariantResponse struct {
-	Service createVariantServiceServiceMutation `json:"service"`
+func __unmarshalQueryTriggerChannelsChannel(b []byte, v *QueryTriggerChannelsChannel) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "PagerDutyChannel":
+		*v = new(QueryTriggerChannelsPagerDutyChannel)
+		return json.Unmarshal(b, *v)
+	case "SlackChannel":
+		*v = new(QueryTriggerChannelsSlackChannel)
+		return json.Unmarshal(b, *v)
+	case "WebhookChannel":
+		*v = new(QueryTriggerChannelsWebhookChannel)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Channel.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for QueryTriggerChannelsChannel: "%v"`, tn.TypeName)
+	}
 }
 
-// GetService returns createVariantResponse.Service, and is useful for accessing the field via an interface.
-func (v *createVariantResponse) GetService() createVariantServiceServiceMutation { return v.Service }
+func __marshalQueryTriggerChannelsChannel(v *QueryTriggerChannelsChannel) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *QueryTriggerChannelsPagerDutyChannel:
+		typename = "PagerDutyChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*QueryTriggerChannelsPagerDutyChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case *QueryTriggerChannelsSlackChannel:
+		typename = "SlackChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*QueryTriggerChannelsSlackChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case *QueryTriggerChannelsWebhookChannel:
+		typename = "WebhookChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*QueryTriggerChannelsWebhookChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for QueryTriggerChannelsChannel: "%T"`, v)
+	}
+}
 
-// createVariantServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// QueryTriggerChannelsPagerDutyChannel includes the requested fields of the GraphQL type PagerDutyChannel.
 // The GraphQL type's documentation follows.
 //
-// Provides access to mutation fields for managing Studio graphs and subgraphs.
-type createVariantServiceServiceMutation struct {
-	// Publish a schema to this variant, either via a document or an introspection query result.
-	UploadSchema createVariantServiceServiceMutationUploadSchemaUploadSchemaMutationResponse `json:"uploadSchema"`
+// PagerDuty notification channel
+type QueryTriggerChannelsPagerDutyChannel struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
 }
 
-// GetUploadSchema returns createVariantServiceServiceMutation.UploadSchema, and is useful for accessing the field via an interface.
-func (v *createVariantServiceServiceMutation) GetUploadSchema() createVariantServiceServiceMutationUploadSchemaUploadSchemaMutationResponse {
-	return v.UploadSchema
-}
+// GetTypename returns QueryTriggerChannelsPagerDutyChannel.Typename, and is useful for accessing the field via an interface.
+func (v *QueryTriggerChannelsPagerDutyChannel) GetTypename() string { return v.Typename }
 
-// createVariantServiceServiceMutationUploadSchemaUploadSchemaMutationResponse includes the requested fields of the GraphQL type UploadSchemaMutationResponse.
+// GetId returns QueryTriggerChannelsPagerDutyChannel.Id, and is useful for accessing the field via an interface.
+func (v *QueryTriggerChannelsPagerDutyChannel) GetId() string { return v.Id }
+
+// QueryTriggerChannelsSlackChannel includes the requested fields of the GraphQL type SlackChannel.
 // The GraphQL type's documentation follows.
 //
-// Describes the result of publishing a schema to a graph variant.
-type createVariantServiceServiceMutationUploadSchemaUploadSchemaMutationResponse struct {
-	// Whether the schema publish operation succeeded (`true`) or encountered errors (`false`).
-	Success bool `json:"success"`
-}
-
-// GetSuccess returns createVariantServiceServiceMutationUploadSchemaUploadSchemaMutationResponse.Success, and is useful for accessing the field via an interface.
-func (v *createVariantServiceServiceMutationUploadSchemaUploadSchemaMutationResponse) GetSuccess() bool {
-	return v.Success
+// Slack notification channel
+type QueryTriggerChannelsSlackChannel struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
 }
 
-// deleteKeyResponse is returned by deleteKey on success.
-type deleteKeyResponse struct {
-	Service deleteKeyServiceServiceMutation `json:"service"`
-}
+// GetTypename returns QueryTriggerChannelsSlackChannel.Typename, and is useful for accessing the field via an interface.
+func (v *QueryTriggerChannelsSlackChannel) GetTypename() string { return v.Typename }
 
-// GetService returns deleteKeyResponse.Service, and is useful for accessing the field via an interface.
-func (v *deleteKeyResponse) GetService() deleteKeyServiceServiceMutation { return v.Service }
+// GetId returns QueryTriggerChannelsSlackChannel.Id, and is useful for accessing the field via an interface.
+func (v *QueryTriggerChannelsSlackChannel) GetId() string { return v.Id }
 
-// deleteKeyServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// QueryTriggerChannelsWebhookChannel includes the requested fields of the GraphQL type WebhookChannel.
 // The GraphQL type's documentation follows.
 //
-// Provides access to mutation fields for managing Studio graphs and subgraphs.
-type deleteKeyServiceServiceMutation struct {
-	// Deletes the existing graph API key with the provided ID, if any.
-	RemoveKey interface{} `json:"removeKey"`
+// Webhook notification channel
+type QueryTriggerChannelsWebhookChannel struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
 }
 
-// GetRemoveKey returns deleteKeyServiceServiceMutation.RemoveKey, and is useful for accessing the field via an interface.
-func (v *deleteKeyServiceServiceMutation) GetRemoveKey() interface{} { return v.RemoveKey }
-
-// deleteServiceResponse is returned by deleteService on success.
-type deleteServiceResponse struct {
-	Service deleteServiceServiceServiceMutation `json:"service"`
+// GetTypename returns QueryTriggerChannelsWebhookChannel.Typename, and is useful for accessing the field via an interface.
+func (v *QueryTriggerChannelsWebhookChannel) GetTypename() string { return v.Typename }
+
+// GetId returns QueryTriggerChannelsWebhookChannel.Id, and is useful for accessing the field via an interface.
+func (v *QueryTriggerChannelsWebhookChannel) GetId() string { return v.Id }
+
+// Query trigger
+type QueryTriggerInput struct {
+	ChannelIds             []string           `json:"channelIds"`
+	ComparisonOperator     ComparisonOperator `json:"comparisonOperator"`
+	Enabled                bool               `json:"enabled"`
+	ExcludedOperationNames []string           `json:"excludedOperationNames"`
+	Metric                 QueryTriggerMetric `json:"metric"`
+	OperationNames         []string           `json:"operationNames"`
+	Percentile             *float64           `json:"percentile"`
+	Scope                  QueryTriggerScope  `json:"scope"`
+	Threshold              float64            `json:"threshold"`
+	Variant                *string            `json:"variant"`
+	Window                 QueryTriggerWindow `json:"window"`
 }
 
-// GetService returns deleteServiceResponse.Service, and is useful for accessing the field via an interface.
-func (v *deleteServiceResponse) GetService() deleteServiceServiceServiceMutation { return v.Service }
+// GetChannelIds returns QueryTriggerInput.ChannelIds, and is useful for accessing the field via an interface.
+func (v *QueryTriggerInput) GetChannelIds() []string { return v.ChannelIds }
 
-// deleteServiceServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
-// The GraphQL type's documentation follows.
-//
-// Provides access to mutation fields for managing Studio graphs and subgraphs.
-type deleteServiceServiceServiceMutation struct {
-	// Soft delete a graph. Data associated with the graph is not permanently deleted; Apollo support can undo.
-	Delete interface{} `json:"delete"`
-}
+// GetComparisonOperator returns QueryTriggerInput.ComparisonOperator, and is useful for accessing the field via an interface.
+func (v *QueryTriggerInput) GetComparisonOperator() ComparisonOperator { return v.ComparisonOperator }
 
-// GetDelete returns deleteServiceServiceServiceMutation.Delete, and is useful for accessing the field via an interface.
-func (v *deleteServiceServiceServiceMutation) GetDelete() interface{} { return v.Delete }
+// GetEnabled returns QueryTriggerInput.Enabled, and is useful for accessing the field via an interface.
+func (v *QueryTriggerInput) GetEnabled() bool { return v.Enabled }
 
-// deleteVariantResponse is returned by deleteVariant on success.
-type deleteVariantResponse struct {
-	Service deleteVariantServiceServiceMutation `json:"service"`
-}
+// GetExcludedOperationNames returns QueryTriggerInput.ExcludedOperationNames, and is useful for accessing the field via an interface.
+func (v *QueryTriggerInput) GetExcludedOperationNames() []string { return v.ExcludedOperationNames }
 
-// GetService returns deleteVariantResponse.Service, and is useful for accessing the field via an interface.
-func (v *deleteVariantResponse) GetService() deleteVariantServiceServiceMutation { return v.Service }
+// GetMetric returns QueryTriggerInput.Metric, and is useful for accessing the field via an interface.
+func (v *QueryTriggerInput) GetMetric() QueryTriggerMetric { return v.Metric }
 
-// deleteVariantServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
-// The GraphQL type's documentation follows.
-//
-// Provides access to mutation fields for managing Studio graphs and subgraphs.
-type deleteVariantServiceServiceMutation struct {
-	// Make changes to a graph variant.
-	Variant deleteVariantServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
-}
+// GetOperationNames returns QueryTriggerInput.OperationNames, and is useful for accessing the field via an interface.
+func (v *QueryTriggerInput) GetOperationNames() []string { return v.OperationNames }
 
-// GetVariant returns deleteVariantServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
-func (v *deleteVariantServiceServiceMutation) GetVariant() deleteVariantServiceServiceMutationVariantGraphVariantMutation {
-	return v.Variant
-}
+// GetPercentile returns QueryTriggerInput.Percentile, and is useful for accessing the field via an interface.
+func (v *QueryTriggerInput) GetPercentile() *float64 { return v.Percentile }
 
-// deleteVariantServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
-// The GraphQL type's documentation follows.
-//
-// Modifies a variant of a graph, also called a schema tag in parts of our product.
-type deleteVariantServiceServiceMutationVariantGraphVariantMutation struct {
-	// Delete the variant.
-	Delete deleteVariantServiceServiceMutationVariantGraphVariantMutationDeleteDeleteSchemaTagResult `json:"delete"`
-}
+// GetScope returns QueryTriggerInput.Scope, and is useful for accessing the field via an interface.
+func (v *QueryTriggerInput) GetScope() QueryTriggerScope { return v.Scope }
 
-// GetDelete returns deleteVariantServiceServiceMutationVariantGraphVariantMutation.Delete, and is useful for accessing the field via an interface.
-func (v *deleteVariantServiceServiceMutationVariantGraphVariantMutation) GetDelete() deleteVariantServiceServiceMutationVariantGraphVariantMutationDeleteDeleteSchemaTagResult {
-	return v.Delete
-}
+// GetThreshold returns QueryTriggerInput.Threshold, and is useful for accessing the field via an interface.
+func (v *QueryTriggerInput) GetThreshold() float64 { return v.Threshold }
 
-// deleteVariantServiceServiceMutationVariantGraphVariantMutationDeleteDeleteSchemaTagResult includes the requested fields of the GraphQL type DeleteSchemaTagResult.
-// The GraphQL type's documentation follows.
-//
-// The result of attempting to delete a graph variant.
-type deleteVariantServiceServiceMutationVariantGraphVariantMutationDeleteDeleteSchemaTagResult struct {
-	// Whether the variant was deleted or not.
-	Deleted bool `json:"deleted"`
-}
+// GetVariant returns QueryTriggerInput.Variant, and is useful for accessing the field via an interface.
+func (v *QueryTriggerInput) GetVariant() *string { return v.Variant }
 
-// GetDeleted returns deleteVariantServiceServiceMutationVariantGraphVariantMutationDeleteDeleteSchemaTagResult.Deleted, and is useful for accessing the field via an interface.
-func (v *deleteVariantServiceServiceMutationVariantGraphVariantMutationDeleteDeleteSchemaTagResult) GetDeleted() bool {
-	return v.Deleted
-}
+// GetWindow returns QueryTriggerInput.Window, and is useful for accessing the field via an interface.
+func (v *QueryTriggerInput) GetWindow() QueryTriggerWindow { return v.Window }
 
-// getServiceResponse is returned by getService on success.
-type getServiceResponse struct {
-	// Service by ID
-	Service getServiceService `json:"service"`
-}
+type QueryTriggerMetric string
 
-// GetService returns getServiceResponse.Service, and is useful for accessing the field via an interface.
-func (v *getServiceResponse) GetService() getServiceService { return v.Service }
+const (
+	// Number of requests within the window that resulted in an error. Ignores `percentile`.
+	QueryTriggerMetricErrorCount QueryTriggerMetric = "ERROR_COUNT"
+	// Number of error requests divided by total number of requests. Ignores `percentile`.
+	QueryTriggerMetricErrorPercentage QueryTriggerMetric = "ERROR_PERCENTAGE"
+	// Number of requests within the window. Ignores `percentile`.
+	QueryTriggerMetricRequestCount QueryTriggerMetric = "REQUEST_COUNT"
+	// Request latency in ms. Requires `percentile`.
+	QueryTriggerMetricRequestServiceTime QueryTriggerMetric = "REQUEST_SERVICE_TIME"
+)
 
-// getServiceService includes the requested fields of the GraphQL type Service.
-// The GraphQL type's documentation follows.
-//
-// A graph in Apollo Studio represents a graph in your organization.
-// Each graph has one or more variants, which correspond to the different
-// environments where that graph runs (such as staging and production).
-// Each variant has its own GraphQL schema, which means schemas can differ between environments.
-type getServiceService struct {
-	Service `json:"-"`
-}
+type QueryTriggerScope string
 
-// GetId returns getServiceService.Id, and is useful for accessing the field via an interface.
-func (v *getServiceService) GetId() string { return v.Service.Id }
+const (
+	QueryTriggerScopeAll          QueryTriggerScope = "ALL"
+	QueryTriggerScopeAny          QueryTriggerScope = "ANY"
+	QueryTriggerScopeUnrecognized QueryTriggerScope = "UNRECOGNIZED"
+)
 
-// GetTitle returns getServiceService.Title, and is useful for accessing the field via an interface.
-func (v *getServiceService) GetTitle() string { return v.Service.Title }
+type QueryTriggerWindow string
 
-// GetOnboardingArchitecture returns getServiceService.OnboardingArchitecture, and is useful for accessing the field via an interface.
-func (v *getServiceService) GetOnboardingArchitecture() string {
-	return v.Service.OnboardingArchitecture
+const (
+	QueryTriggerWindowFifteenMinutes QueryTriggerWindow = "FIFTEEN_MINUTES"
+	QueryTriggerWindowFiveMinutes    QueryTriggerWindow = "FIVE_MINUTES"
+	QueryTriggerWindowOneMinute      QueryTriggerWindow = "ONE_MINUTE"
+	QueryTriggerWindowUnrecognized   QueryTriggerWindow = "UNRECOGNIZED"
+)
+
+// RegistrySubscription includes the GraphQL fields of RegistrySubscription requested by the fragment RegistrySubscription.
+type RegistrySubscription struct {
+	Id      string                      `json:"id"`
+	Variant *string                     `json:"variant"`
+	Enabled bool                        `json:"enabled"`
+	Channel RegistrySubscriptionChannel `json:"-"`
+	Options RegistrySubscriptionOptions `json:"options"`
 }
 
-// GetAccountId returns getServiceService.AccountId, and is useful for accessing the field via an interface.
-func (v *getServiceService) GetAccountId() string { return v.Service.AccountId }
+// GetId returns RegistrySubscription.Id, and is useful for accessing the field via an interface.
+func (v *RegistrySubscription) GetId() string { return v.Id }
 
-// GetDescription returns getServiceService.Description, and is useful for accessing the field via an interface.
-func (v *getServiceService) GetDescription() string { return v.Service.Description }
+// GetVariant returns RegistrySubscription.Variant, and is useful for accessing the field via an interface.
+func (v *RegistrySubscription) GetVariant() *string { return v.Variant }
 
-func (v *getServiceService) UnmarshalJSON(b []byte) error {
+// GetEnabled returns RegistrySubscription.Enabled, and is useful for accessing the field via an interface.
+func (v *RegistrySubscription) GetEnabled() bool { return v.Enabled }
+
+// GetChannel returns RegistrySubscription.Channel, and is useful for accessing the field via an interface.
+func (v *RegistrySubscription) GetChannel() RegistrySubscriptionChannel { return v.Channel }
+
+// GetOptions returns RegistrySubscription.Options, and is useful for accessing the field via an interface.
+func (v *RegistrySubscription) GetOptions() RegistrySubscriptionOptions { return v.Options }
+
+func (v *RegistrySubscription) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getServiceService
+		*RegistrySubscription
+		Channel json.RawMessage `json:"channel"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getServiceService = v
+	firstPass.RegistrySubscription = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	err = json.Unmarshal(
-		b, &v.Service)
-	if err != nil {
-		return err
+	{
+		dst := &v.Channel
+		src := firstPass.Channel
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalRegistrySubscriptionChannel(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal RegistrySubscription.Channel: %w", err)
+			}
+		}
 	}
 	return nil
 }
 
-type __premarshalgetServiceService struct {
+type __premarshalRegistrySubscription struct {
 	Id string `json:"id"`
 
-	Title string `json:"title"`
+	Variant *string `json:"variant"`
 
-	OnboardingArchitecture string `json:"onboardingArchitecture"`
+	Enabled bool `json:"enabled"`
 
-	AccountId string `json:"accountId"`
+	Channel json.RawMessage `json:"channel"`
 
-	Description string `json:"description"`
+	Options RegistrySubscriptionOptions `json:"options"`
 }
 
-func (v *getServiceService) MarshalJSON() ([]byte, error) {
+func (v *RegistrySubscription) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -681,105 +1281,250 @@ func (v *getServiceService) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getServiceService) __premarshalJSON() (*__premarshalgetServiceService, error) {
-	var retval __premarshalgetServiceService
-
-	retval.Id = v.Service.Id
-	retval.Title = v.Service.Title
-	retval.OnboardingArchitecture = v.Service.OnboardingArchitecture
-	retval.AccountId = v.Service.AccountId
-	retval.Description = v.Service.Description
+func (v *RegistrySubscription) __premarshalJSON() (*__premarshalRegistrySubscription, error) {
+	var retval __premarshalRegistrySubscription
+
+	retval.Id = v.Id
+	retval.Variant = v.Variant
+	retval.Enabled = v.Enabled
+	{
+
+		dst := &retval.Channel
+		src := v.Channel
+		var err error
+		*dst, err = __marshalRegistrySubscriptionChannel(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal RegistrySubscription.Channel: %w", err)
+		}
+	}
+	retval.Options = v.Options
 	return &retval, nil
 }
 
-// getVariantResponse is returned by getVariant on success.
-type getVariantResponse struct {
-	// Service by ID
-	Service getVariantService `json:"service"`
+// RegistrySubscriptionChannel includes the requested fields of the GraphQL interface Channel.
+//
+// RegistrySubscriptionChannel is implemented by the following types:
+// RegistrySubscriptionChannelPagerDutyChannel
+// RegistrySubscriptionChannelSlackChannel
+// RegistrySubscriptionChannelWebhookChannel
+// The GraphQL type's documentation follows.
+//
+// Destination for notifications
+type RegistrySubscriptionChannel interface {
+	implementsGraphQLInterfaceRegistrySubscriptionChannel()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	GetId() string
 }
 
-// GetService returns getVariantResponse.Service, and is useful for accessing the field via an interface.
-func (v *getVariantResponse) GetService() getVariantService { return v.Service }
+func (v *RegistrySubscriptionChannelPagerDutyChannel) implementsGraphQLInterfaceRegistrySubscriptionChannel() {
+}
+func (v *RegistrySubscriptionChannelSlackChannel) implementsGraphQLInterfaceRegistrySubscriptionChannel() {
+}
+func (v *RegistrySubscriptionChannelWebhookChannel) implementsGraphQLInterfaceRegistrySubscriptionChannel() {
+}
 
-// getVariantService includes the requested fields of the GraphQL type Service.
+func __unmarshalRegistrySubscriptionChannel(b []byte, v *RegistrySubscriptionChannel) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "PagerDutyChannel":
+		*v = new(RegistrySubscriptionChannelPagerDutyChannel)
+		return json.Unmarshal(b, *v)
+	case "SlackChannel":
+		*v = new(RegistrySubscriptionChannelSlackChannel)
+		return json.Unmarshal(b, *v)
+	case "WebhookChannel":
+		*v = new(RegistrySubscriptionChannelWebhookChannel)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Channel.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for RegistrySubscriptionChannel: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalRegistrySubscriptionChannel(v *RegistrySubscriptionChannel) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *RegistrySubscriptionChannelPagerDutyChannel:
+		typename = "PagerDutyChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*RegistrySubscriptionChannelPagerDutyChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case *RegistrySubscriptionChannelSlackChannel:
+		typename = "SlackChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*RegistrySubscriptionChannelSlackChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case *RegistrySubscriptionChannelWebhookChannel:
+		typename = "WebhookChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*RegistrySubscriptionChannelWebhookChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for RegistrySubscriptionChannel: "%T"`, v)
+	}
+}
+
+// RegistrySubscriptionChannelPagerDutyChannel includes the requested fields of the GraphQL type PagerDutyChannel.
 // The GraphQL type's documentation follows.
 //
-// A graph in Apollo Studio represents a graph in your organization.
-// Each graph has one or more variants, which correspond to the different
-// environments where that graph runs (such as staging and production).
-// Each variant has its own GraphQL schema, which means schemas can differ between environments.
-type getVariantService struct {
-	// Provides details of the graph variant with the provided `name`, if a variant
-	// with that name exists for this graph. Otherwise, returns null.
-	//
-	// For a list of _all_ variants associated with a graph, use `Graph.variants` instead.
-	Variant getVariantServiceVariantGraphVariant `json:"variant"`
+// PagerDuty notification channel
+type RegistrySubscriptionChannelPagerDutyChannel struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
 }
 
-// GetVariant returns getVariantService.Variant, and is useful for accessing the field via an interface.
-func (v *getVariantService) GetVariant() getVariantServiceVariantGraphVariant { return v.Variant }
+// GetTypename returns RegistrySubscriptionChannelPagerDutyChannel.Typename, and is useful for accessing the field via an interface.
+func (v *RegistrySubscriptionChannelPagerDutyChannel) GetTypename() string { return v.Typename }
 
-// getVariantServiceVariantGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// GetId returns RegistrySubscriptionChannelPagerDutyChannel.Id, and is useful for accessing the field via an interface.
+func (v *RegistrySubscriptionChannelPagerDutyChannel) GetId() string { return v.Id }
+
+// RegistrySubscriptionChannelSlackChannel includes the requested fields of the GraphQL type SlackChannel.
 // The GraphQL type's documentation follows.
 //
-// A graph variant
-type getVariantServiceVariantGraphVariant struct {
-	Variant `json:"-"`
+// Slack notification channel
+type RegistrySubscriptionChannelSlackChannel struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
 }
 
-// GetId returns getVariantServiceVariantGraphVariant.Id, and is useful for accessing the field via an interface.
-func (v *getVariantServiceVariantGraphVariant) GetId() string { return v.Variant.Id }
+// GetTypename returns RegistrySubscriptionChannelSlackChannel.Typename, and is useful for accessing the field via an interface.
+func (v *RegistrySubscriptionChannelSlackChannel) GetTypename() string { return v.Typename }
 
-// GetName returns getVariantServiceVariantGraphVariant.Name, and is useful for accessing the field via an interface.
-func (v *getVariantServiceVariantGraphVariant) GetName() string { return v.Variant.Name }
+// GetId returns RegistrySubscriptionChannelSlackChannel.Id, and is useful for accessing the field via an interface.
+func (v *RegistrySubscriptionChannelSlackChannel) GetId() string { return v.Id }
 
-// GetIsPublic returns getVariantServiceVariantGraphVariant.IsPublic, and is useful for accessing the field via an interface.
-func (v *getVariantServiceVariantGraphVariant) GetIsPublic() bool { return v.Variant.IsPublic }
+// RegistrySubscriptionChannelWebhookChannel includes the requested fields of the GraphQL type WebhookChannel.
+// The GraphQL type's documentation follows.
+//
+// Webhook notification channel
+type RegistrySubscriptionChannelWebhookChannel struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+}
 
-// GetUrl returns getVariantServiceVariantGraphVariant.Url, and is useful for accessing the field via an interface.
-func (v *getVariantServiceVariantGraphVariant) GetUrl() *string { return v.Variant.Url }
+// GetTypename returns RegistrySubscriptionChannelWebhookChannel.Typename, and is useful for accessing the field via an interface.
+func (v *RegistrySubscriptionChannelWebhookChannel) GetTypename() string { return v.Typename }
 
-// GetGraphId returns getVariantServiceVariantGraphVariant.GraphId, and is useful for accessing the field via an interface.
-func (v *getVariantServiceVariantGraphVariant) GetGraphId() string { return v.Variant.GraphId }
+// GetId returns RegistrySubscriptionChannelWebhookChannel.Id, and is useful for accessing the field via an interface.
+func (v *RegistrySubscriptionChannelWebhookChannel) GetId() string { return v.Id }
 
-func (v *getVariantServiceVariantGraphVariant) UnmarshalJSON(b []byte) error {
+// RegistrySubscriptionOptions includes the requested fields of the GraphQL type SubscriptionOptions.
+type RegistrySubscriptionOptions struct {
+	// Enables notifications for schema updates
+	SchemaUpdates bool `json:"schemaUpdates"`
+}
+
+// GetSchemaUpdates returns RegistrySubscriptionOptions.SchemaUpdates, and is useful for accessing the field via an interface.
+func (v *RegistrySubscriptionOptions) GetSchemaUpdates() bool { return v.SchemaUpdates }
+
+// ScheduledSummary includes the GraphQL fields of ScheduledSummary requested by the fragment ScheduledSummary.
+type ScheduledSummary struct {
+	Id       string                            `json:"id"`
+	Variant  string                            `json:"variant"`
+	Timezone string                            `json:"timezone"`
+	Enabled  bool                              `json:"enabled"`
+	Channels []ScheduledSummaryChannelsChannel `json:"-"`
+}
+
+// GetId returns ScheduledSummary.Id, and is useful for accessing the field via an interface.
+func (v *ScheduledSummary) GetId() string { return v.Id }
+
+// GetVariant returns ScheduledSummary.Variant, and is useful for accessing the field via an interface.
+func (v *ScheduledSummary) GetVariant() string { return v.Variant }
+
+// GetTimezone returns ScheduledSummary.Timezone, and is useful for accessing the field via an interface.
+func (v *ScheduledSummary) GetTimezone() string { return v.Timezone }
+
+// GetEnabled returns ScheduledSummary.Enabled, and is useful for accessing the field via an interface.
+func (v *ScheduledSummary) GetEnabled() bool { return v.Enabled }
+
+// GetChannels returns ScheduledSummary.Channels, and is useful for accessing the field via an interface.
+func (v *ScheduledSummary) GetChannels() []ScheduledSummaryChannelsChannel { return v.Channels }
+
+func (v *ScheduledSummary) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getVariantServiceVariantGraphVariant
+		*ScheduledSummary
+		Channels []json.RawMessage `json:"channels"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getVariantServiceVariantGraphVariant = v
+	firstPass.ScheduledSummary = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	err = json.Unmarshal(
-		b, &v.Variant)
-	if err != nil {
-		return err
+	{
+		dst := &v.Channels
+		src := firstPass.Channels
+		*dst = make(
+			[]ScheduledSummaryChannelsChannel,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalScheduledSummaryChannelsChannel(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal ScheduledSummary.Channels: %w", err)
+				}
+			}
+		}
 	}
 	return nil
 }
 
-type __premarshalgetVariantServiceVariantGraphVariant struct {
+type __premarshalScheduledSummary struct {
 	Id string `json:"id"`
 
-	Name string `json:"name"`
+	Variant string `json:"variant"`
 
-	IsPublic bool `json:"isPublic"`
+	Timezone string `json:"timezone"`
 
-	Url *string `json:"url"`
+	Enabled bool `json:"enabled"`
 
-	GraphId string `json:"graphId"`
+	Channels []json.RawMessage `json:"channels"`
 }
 
-func (v *getVariantServiceVariantGraphVariant) MarshalJSON() ([]byte, error) {
+func (v *ScheduledSummary) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -787,728 +1532,16610 @@ func (v *getVariantServiceVariantGraphVariant) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getVariantServiceVariantGraphVariant) __premarshalJSON() (*__premarshalgetVariantServiceVariantGraphVariant, error) {
-	var retval __premarshalgetVariantServiceVariantGraphVariant
-
-	retval.Id = v.Variant.Id
-	retval.Name = v.Variant.Name
-	retval.IsPublic = v.Variant.IsPublic
-	retval.Url = v.Variant.Url
-	retval.GraphId = v.Variant.GraphId
+func (v *ScheduledSummary) __premarshalJSON() (*__premarshalScheduledSummary, error) {
+	var retval __premarshalScheduledSummary
+
+	retval.Id = v.Id
+	retval.Variant = v.Variant
+	retval.Timezone = v.Timezone
+	retval.Enabled = v.Enabled
+	{
+
+		dst := &retval.Channels
+		src := v.Channels
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalScheduledSummaryChannelsChannel(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal ScheduledSummary.Channels: %w", err)
+			}
+		}
+	}
 	return &retval, nil
 }
 
-// listKeysResponse is returned by listKeys on success.
-type listKeysResponse struct {
-	// Service by ID
-	Service listKeysService `json:"service"`
-}
-
-// GetService returns listKeysResponse.Service, and is useful for accessing the field via an interface.
-func (v *listKeysResponse) GetService() listKeysService { return v.Service }
-
-// listKeysService includes the requested fields of the GraphQL type Service.
+// ScheduledSummaryChannelsChannel includes the requested fields of the GraphQL interface Channel.
+//
+// ScheduledSummaryChannelsChannel is implemented by the following types:
+// ScheduledSummaryChannelsPagerDutyChannel
+// ScheduledSummaryChannelsSlackChannel
+// ScheduledSummaryChannelsWebhookChannel
 // The GraphQL type's documentation follows.
 //
-// A graph in Apollo Studio represents a graph in your organization.
-// Each graph has one or more variants, which correspond to the different
-// environments where that graph runs (such as staging and production).
-// Each variant has its own GraphQL schema, which means schemas can differ between environments.
-type listKeysService struct {
-	// A list of the graph API keys that are active for this graph.
-	ApiKeys []listKeysServiceApiKeysGraphApiKey `json:"apiKeys"`
+// Destination for notifications
+type ScheduledSummaryChannelsChannel interface {
+	implementsGraphQLInterfaceScheduledSummaryChannelsChannel()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	GetId() string
 }
 
-// GetApiKeys returns listKeysService.ApiKeys, and is useful for accessing the field via an interface.
-func (v *listKeysService) GetApiKeys() []listKeysServiceApiKeysGraphApiKey { return v.ApiKeys }
-
-// listKeysServiceApiKeysGraphApiKey includes the requested fields of the GraphQL type GraphApiKey.
-// The GraphQL type's documentation follows.
-//
-// Represents a graph API key, which has permissions scoped to a
-// user role for a single Apollo graph.
-type listKeysServiceApiKeysGraphApiKey struct {
-	Key `json:"-"`
+func (v *ScheduledSummaryChannelsPagerDutyChannel) implementsGraphQLInterfaceScheduledSummaryChannelsChannel() {
+}
+func (v *ScheduledSummaryChannelsSlackChannel) implementsGraphQLInterfaceScheduledSummaryChannelsChannel() {
+}
+func (v *ScheduledSummaryChannelsWebhookChannel) implementsGraphQLInterfaceScheduledSummaryChannelsChannel() {
 }
 
-// GetId returns listKeysServiceApiKeysGraphApiKey.Id, and is useful for accessing the field via an interface.
-func (v *listKeysServiceApiKeysGraphApiKey) GetId() string { return v.Key.Id }
-
-// GetKeyName returns listKeysServiceApiKeysGraphApiKey.KeyName, and is useful for accessing the field via an interface.
-func (v *listKeysServiceApiKeysGraphApiKey) GetKeyName() string { return v.Key.KeyName }
-
-// GetRole returns listKeysServiceApiKeysGraphApiKey.Role, and is useful for accessing the field via an interface.
-func (v *listKeysServiceApiKeysGraphApiKey) GetRole() string { return v.Key.Role }
-
-// GetToken returns listKeysServiceApiKeysGraphApiKey.Token, and is useful for accessing the field via an interface.
-func (v *listKeysServiceApiKeysGraphApiKey) GetToken() string { return v.Key.Token }
-
-func (v *listKeysServiceApiKeysGraphApiKey) UnmarshalJSON(b []byte) error {
-
+func __unmarshalScheduledSummaryChannelsChannel(b []byte, v *ScheduledSummaryChannelsChannel) error {
 	if string(b) == "null" {
 		return nil
 	}
 
-	var firstPass struct {
-		*listKeysServiceApiKeysGraphApiKey
-		graphql.NoUnmarshalJSON
+	var tn struct {
+		TypeName string `json:"__typename"`
 	}
-	firstPass.listKeysServiceApiKeysGraphApiKey = v
-
-	err := json.Unmarshal(b, &firstPass)
+	err := json.Unmarshal(b, &tn)
 	if err != nil {
 		return err
 	}
 
-	err = json.Unmarshal(
-		b, &v.Key)
-	if err != nil {
-		return err
+	switch tn.TypeName {
+	case "PagerDutyChannel":
+		*v = new(ScheduledSummaryChannelsPagerDutyChannel)
+		return json.Unmarshal(b, *v)
+	case "SlackChannel":
+		*v = new(ScheduledSummaryChannelsSlackChannel)
+		return json.Unmarshal(b, *v)
+	case "WebhookChannel":
+		*v = new(ScheduledSummaryChannelsWebhookChannel)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Channel.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for ScheduledSummaryChannelsChannel: "%v"`, tn.TypeName)
 	}
-	return nil
 }
 
-type __premarshallistKeysServiceApiKeysGraphApiKey struct {
-	Id string `json:"id"`
+func __marshalScheduledSummaryChannelsChannel(v *ScheduledSummaryChannelsChannel) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *ScheduledSummaryChannelsPagerDutyChannel:
+		typename = "PagerDutyChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ScheduledSummaryChannelsPagerDutyChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case *ScheduledSummaryChannelsSlackChannel:
+		typename = "SlackChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ScheduledSummaryChannelsSlackChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case *ScheduledSummaryChannelsWebhookChannel:
+		typename = "WebhookChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ScheduledSummaryChannelsWebhookChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for ScheduledSummaryChannelsChannel: "%T"`, v)
+	}
+}
 
-	KeyName string `json:"keyName"`
+// ScheduledSummaryChannelsPagerDutyChannel includes the requested fields of the GraphQL type PagerDutyChannel.
+// The GraphQL type's documentation follows.
+//
+// PagerDuty notification channel
+type ScheduledSummaryChannelsPagerDutyChannel struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+}
 
-	Role string `json:"role"`
+// GetTypename returns ScheduledSummaryChannelsPagerDutyChannel.Typename, and is useful for accessing the field via an interface.
+func (v *ScheduledSummaryChannelsPagerDutyChannel) GetTypename() string { return v.Typename }
 
-	Token string `json:"token"`
-}
+// GetId returns ScheduledSummaryChannelsPagerDutyChannel.Id, and is useful for accessing the field via an interface.
+func (v *ScheduledSummaryChannelsPagerDutyChannel) GetId() string { return v.Id }
 
-func (v *listKeysServiceApiKeysGraphApiKey) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// ScheduledSummaryChannelsSlackChannel includes the requested fields of the GraphQL type SlackChannel.
+// The GraphQL type's documentation follows.
+//
+// Slack notification channel
+type ScheduledSummaryChannelsSlackChannel struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
 }
 
-func (v *listKeysServiceApiKeysGraphApiKey) __premarshalJSON() (*__premarshallistKeysServiceApiKeysGraphApiKey, error) {
-	var retval __premarshallistKeysServiceApiKeysGraphApiKey
+// GetTypename returns ScheduledSummaryChannelsSlackChannel.Typename, and is useful for accessing the field via an interface.
+func (v *ScheduledSummaryChannelsSlackChannel) GetTypename() string { return v.Typename }
 
-	retval.Id = v.Key.Id
-	retval.KeyName = v.Key.KeyName
-	retval.Role = v.Key.Role
-	retval.Token = v.Key.Token
-	return &retval, nil
-}
+// GetId returns ScheduledSummaryChannelsSlackChannel.Id, and is useful for accessing the field via an interface.
+func (v *ScheduledSummaryChannelsSlackChannel) GetId() string { return v.Id }
 
-// updateKeyResponse is returned by updateKey on success.
-type updateKeyResponse struct {
-	Service updateKeyServiceServiceMutation `json:"service"`
+// ScheduledSummaryChannelsWebhookChannel includes the requested fields of the GraphQL type WebhookChannel.
+// The GraphQL type's documentation follows.
+//
+// Webhook notification channel
+type ScheduledSummaryChannelsWebhookChannel struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
 }
 
-// GetService returns updateKeyResponse.Service, and is useful for accessing the field via an interface.
-func (v *updateKeyResponse) GetService() updateKeyServiceServiceMutation { return v.Service }
+// GetTypename returns ScheduledSummaryChannelsWebhookChannel.Typename, and is useful for accessing the field via an interface.
+func (v *ScheduledSummaryChannelsWebhookChannel) GetTypename() string { return v.Typename }
 
-// updateKeyServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// GetId returns ScheduledSummaryChannelsWebhookChannel.Id, and is useful for accessing the field via an interface.
+func (v *ScheduledSummaryChannelsWebhookChannel) GetId() string { return v.Id }
+
+// Service includes the GraphQL fields of Service requested by the fragment Service.
 // The GraphQL type's documentation follows.
 //
-// Provides access to mutation fields for managing Studio graphs and subgraphs.
-type updateKeyServiceServiceMutation struct {
-	// Sets a new name for the graph API key with the provided ID, if any. This does not invalidate the key or change its value.
-	RenameKey updateKeyServiceServiceMutationRenameKeyGraphApiKey `json:"renameKey"`
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type Service struct {
+	// The graph's globally unique identifier.
+	Id string `json:"id"`
+	// The graph's name.
+	Title                  string `json:"title"`
+	OnboardingArchitecture string `json:"onboardingArchitecture"`
+	AccountId              string `json:"accountId"`
+	Description            string `json:"description"`
+	// When this is true, this graph will be hidden from non-admin members of the org who haven't been explicitly assigned a
+	// role on this graph.
+	HiddenFromUninvitedNonAdminAccountMembers bool           `json:"hiddenFromUninvitedNonAdminAccountMembers"`
+	Readme                                    *ServiceReadme `json:"readme"`
 }
 
-// GetRenameKey returns updateKeyServiceServiceMutation.RenameKey, and is useful for accessing the field via an interface.
-func (v *updateKeyServiceServiceMutation) GetRenameKey() updateKeyServiceServiceMutationRenameKeyGraphApiKey {
-	return v.RenameKey
+// GetId returns Service.Id, and is useful for accessing the field via an interface.
+func (v *Service) GetId() string { return v.Id }
+
+// GetTitle returns Service.Title, and is useful for accessing the field via an interface.
+func (v *Service) GetTitle() string { return v.Title }
+
+// GetOnboardingArchitecture returns Service.OnboardingArchitecture, and is useful for accessing the field via an interface.
+func (v *Service) GetOnboardingArchitecture() string { return v.OnboardingArchitecture }
+
+// GetAccountId returns Service.AccountId, and is useful for accessing the field via an interface.
+func (v *Service) GetAccountId() string { return v.AccountId }
+
+// GetDescription returns Service.Description, and is useful for accessing the field via an interface.
+func (v *Service) GetDescription() string { return v.Description }
+
+// GetHiddenFromUninvitedNonAdminAccountMembers returns Service.HiddenFromUninvitedNonAdminAccountMembers, and is useful for accessing the field via an interface.
+func (v *Service) GetHiddenFromUninvitedNonAdminAccountMembers() bool {
+	return v.HiddenFromUninvitedNonAdminAccountMembers
 }
 
-// updateKeyServiceServiceMutationRenameKeyGraphApiKey includes the requested fields of the GraphQL type GraphApiKey.
+// GetReadme returns Service.Readme, and is useful for accessing the field via an interface.
+func (v *Service) GetReadme() *ServiceReadme { return v.Readme }
+
+// ServiceReadme includes the requested fields of the GraphQL type Readme.
 // The GraphQL type's documentation follows.
 //
-// Represents a graph API key, which has permissions scoped to a
-// user role for a single Apollo graph.
-type updateKeyServiceServiceMutationRenameKeyGraphApiKey struct {
-	Key `json:"-"`
+// The README documentation for a graph variant, which is displayed in Studio.
+type ServiceReadme struct {
+	// The contents of the README in plaintext.
+	Content string `json:"content"`
 }
 
-// GetId returns updateKeyServiceServiceMutationRenameKeyGraphApiKey.Id, and is useful for accessing the field via an interface.
-func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) GetId() string { return v.Key.Id }
+// GetContent returns ServiceReadme.Content, and is useful for accessing the field via an interface.
+func (v *ServiceReadme) GetContent() string { return v.Content }
 
-// GetKeyName returns updateKeyServiceServiceMutationRenameKeyGraphApiKey.KeyName, and is useful for accessing the field via an interface.
-func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) GetKeyName() string {
-	return v.Key.KeyName
+// SlackChannel includes the GraphQL fields of SlackChannel requested by the fragment SlackChannel.
+// The GraphQL type's documentation follows.
+//
+// Slack notification channel
+type SlackChannel struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Url  string `json:"url"`
 }
 
-// GetRole returns updateKeyServiceServiceMutationRenameKeyGraphApiKey.Role, and is useful for accessing the field via an interface.
-func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) GetRole() string { return v.Key.Role }
+// GetId returns SlackChannel.Id, and is useful for accessing the field via an interface.
+func (v *SlackChannel) GetId() string { return v.Id }
 
-// GetToken returns updateKeyServiceServiceMutationRenameKeyGraphApiKey.Token, and is useful for accessing the field via an interface.
-func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) GetToken() string { return v.Key.Token }
+// GetName returns SlackChannel.Name, and is useful for accessing the field via an interface.
+func (v *SlackChannel) GetName() string { return v.Name }
 
-func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) UnmarshalJSON(b []byte) error {
+// GetUrl returns SlackChannel.Url, and is useful for accessing the field via an interface.
+func (v *SlackChannel) GetUrl() string { return v.Url }
 
-	if string(b) == "null" {
-		return nil
-	}
+// Slack notification channel parameters
+type SlackChannelInput struct {
+	Name string `json:"name"`
+	Url  string `json:"url"`
+}
 
-	var firstPass struct {
-		*updateKeyServiceServiceMutationRenameKeyGraphApiKey
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.updateKeyServiceServiceMutationRenameKeyGraphApiKey = v
+// GetName returns SlackChannelInput.Name, and is useful for accessing the field via an interface.
+func (v *SlackChannelInput) GetName() string { return v.Name }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetUrl returns SlackChannelInput.Url, and is useful for accessing the field via an interface.
+func (v *SlackChannelInput) GetUrl() string { return v.Url }
 
-	err = json.Unmarshal(
-		b, &v.Key)
-	if err != nil {
-		return err
-	}
-	return nil
+// Subgraph includes the GraphQL fields of FederatedImplementingService requested by the fragment Subgraph.
+// The GraphQL type's documentation follows.
+//
+// A single subgraph in a supergraph. Every supergraph managed by Apollo Studio
+// includes at least one subgraph. See
+// https://www.apollographql.com/docs/federation/managed-federation/overview/ for
+// more information.
+type Subgraph struct {
+	// The subgraph's name.
+	Name string `json:"name"`
+	// The URL of the subgraph's GraphQL endpoint.
+	Url *string `json:"url"`
+	// The current user-provided version/edition of the subgraph. Typically a Git SHA or docker image ID.
+	Revision string `json:"revision"`
+	// The ID of the graph this subgraph belongs to.
+	GraphID string `json:"graphID"`
+	// The name of the graph variant this subgraph belongs to.
+	GraphVariant string `json:"graphVariant"`
+	// The subgraph's current active schema, used in supergraph composition for the the associated variant.
+	ActivePartialSchema SubgraphActivePartialSchema `json:"activePartialSchema"`
 }
 
-type __premarshalupdateKeyServiceServiceMutationRenameKeyGraphApiKey struct {
-	Id string `json:"id"`
+// GetName returns Subgraph.Name, and is useful for accessing the field via an interface.
+func (v *Subgraph) GetName() string { return v.Name }
 
-	KeyName string `json:"keyName"`
+// GetUrl returns Subgraph.Url, and is useful for accessing the field via an interface.
+func (v *Subgraph) GetUrl() *string { return v.Url }
 
-	Role string `json:"role"`
+// GetRevision returns Subgraph.Revision, and is useful for accessing the field via an interface.
+func (v *Subgraph) GetRevision() string { return v.Revision }
 
-	Token string `json:"token"`
-}
+// GetGraphID returns Subgraph.GraphID, and is useful for accessing the field via an interface.
+func (v *Subgraph) GetGraphID() string { return v.GraphID }
 
-func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetGraphVariant returns Subgraph.GraphVariant, and is useful for accessing the field via an interface.
+func (v *Subgraph) GetGraphVariant() string { return v.GraphVariant }
+
+// GetActivePartialSchema returns Subgraph.ActivePartialSchema, and is useful for accessing the field via an interface.
+func (v *Subgraph) GetActivePartialSchema() SubgraphActivePartialSchema { return v.ActivePartialSchema }
+
+// SubgraphActivePartialSchema includes the requested fields of the GraphQL type PartialSchema.
+// The GraphQL type's documentation follows.
+//
+// The schema for a single published subgraph in Studio.
+type SubgraphActivePartialSchema struct {
+	// The subgraph schema document as SDL.
+	Sdl string `json:"sdl"`
 }
 
-func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) __premarshalJSON() (*__premarshalupdateKeyServiceServiceMutationRenameKeyGraphApiKey, error) {
-	var retval __premarshalupdateKeyServiceServiceMutationRenameKeyGraphApiKey
+// GetSdl returns SubgraphActivePartialSchema.Sdl, and is useful for accessing the field via an interface.
+func (v *SubgraphActivePartialSchema) GetSdl() string { return v.Sdl }
 
-	retval.Id = v.Key.Id
-	retval.KeyName = v.Key.KeyName
-	retval.Role = v.Key.Role
-	retval.Token = v.Key.Token
-	return &retval, nil
+// SubgraphComposition includes the GraphQL fields of CompositionAndUpsertResult requested by the fragment SubgraphComposition.
+// The GraphQL type's documentation follows.
+//
+// The result of supergraph composition that Studio performed in response to an attempted publish of a subgraph.
+type SubgraphComposition struct {
+	// A list of errors that occurred during composition. Errors mean that Apollo was
+	// unable to compose the graph variant's subgraphs into a supergraph schema. If
+	// any errors are present, gateways / routers are not updated.
+	Errors []SubgraphCompositionErrorsSchemaCompositionError `json:"errors"`
+	// The generated composition config, or null if any errors occurred.
+	CompositionConfig *SubgraphCompositionCompositionConfig `json:"compositionConfig"`
+	// The Launch result part of this subgraph publish.
+	Launch *SubgraphCompositionLaunch `json:"launch"`
 }
 
-// updateServiceDescriptionResponse is returned by updateServiceDescription on success.
-type updateServiceDescriptionResponse struct {
-	Service updateServiceDescriptionServiceServiceMutation `json:"service"`
+// GetErrors returns SubgraphComposition.Errors, and is useful for accessing the field via an interface.
+func (v *SubgraphComposition) GetErrors() []SubgraphCompositionErrorsSchemaCompositionError {
+	return v.Errors
 }
 
-// GetService returns updateServiceDescriptionResponse.Service, and is useful for accessing the field via an interface.
-func (v *updateServiceDescriptionResponse) GetService() updateServiceDescriptionServiceServiceMutation {
-	return v.Service
+// GetCompositionConfig returns SubgraphComposition.CompositionConfig, and is useful for accessing the field via an interface.
+func (v *SubgraphComposition) GetCompositionConfig() *SubgraphCompositionCompositionConfig {
+	return v.CompositionConfig
 }
 
-// updateServiceDescriptionServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// GetLaunch returns SubgraphComposition.Launch, and is useful for accessing the field via an interface.
+func (v *SubgraphComposition) GetLaunch() *SubgraphCompositionLaunch { return v.Launch }
+
+// SubgraphCompositionCompositionConfig includes the requested fields of the GraphQL type CompositionConfig.
 // The GraphQL type's documentation follows.
 //
-// Provides access to mutation fields for managing Studio graphs and subgraphs.
-type updateServiceDescriptionServiceServiceMutation struct {
-	UpdateDescription updateServiceDescriptionServiceServiceMutationUpdateDescriptionService `json:"updateDescription"`
+// Composition configuration exposed to the gateway.
+type SubgraphCompositionCompositionConfig struct {
+	// The resulting API schema's SHA256 hash, represented as a hexadecimal string.
+	SchemaHash string `json:"schemaHash"`
 }
 
-// GetUpdateDescription returns updateServiceDescriptionServiceServiceMutation.UpdateDescription, and is useful for accessing the field via an interface.
-func (v *updateServiceDescriptionServiceServiceMutation) GetUpdateDescription() updateServiceDescriptionServiceServiceMutationUpdateDescriptionService {
-	return v.UpdateDescription
-}
+// GetSchemaHash returns SubgraphCompositionCompositionConfig.SchemaHash, and is useful for accessing the field via an interface.
+func (v *SubgraphCompositionCompositionConfig) GetSchemaHash() string { return v.SchemaHash }
 
-// updateServiceDescriptionServiceServiceMutationUpdateDescriptionService includes the requested fields of the GraphQL type Service.
+// SubgraphCompositionErrorsSchemaCompositionError includes the requested fields of the GraphQL type SchemaCompositionError.
 // The GraphQL type's documentation follows.
 //
-// A graph in Apollo Studio represents a graph in your organization.
-// Each graph has one or more variants, which correspond to the different
-// environments where that graph runs (such as staging and production).
-// Each variant has its own GraphQL schema, which means schemas can differ between environments.
-type updateServiceDescriptionServiceServiceMutationUpdateDescriptionService struct {
-	Service `json:"-"`
+// An error that occurred while running schema composition on a set of subgraph schemas.
+type SubgraphCompositionErrorsSchemaCompositionError struct {
+	// A human-readable message describing the error.
+	Message string `json:"message"`
 }
 
-// GetId returns updateServiceDescriptionServiceServiceMutationUpdateDescriptionService.Id, and is useful for accessing the field via an interface.
-func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) GetId() string {
-	return v.Service.Id
-}
+// GetMessage returns SubgraphCompositionErrorsSchemaCompositionError.Message, and is useful for accessing the field via an interface.
+func (v *SubgraphCompositionErrorsSchemaCompositionError) GetMessage() string { return v.Message }
 
-// GetTitle returns updateServiceDescriptionServiceServiceMutationUpdateDescriptionService.Title, and is useful for accessing the field via an interface.
-func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) GetTitle() string {
-	return v.Service.Title
+// SubgraphCompositionLaunch includes the requested fields of the GraphQL type Launch.
+// The GraphQL type's documentation follows.
+//
+// Represents the complete process of making a set of updates to a deployed graph variant.
+type SubgraphCompositionLaunch struct {
+	// The unique identifier for this launch.
+	Id string `json:"id"`
 }
 
-// GetOnboardingArchitecture returns updateServiceDescriptionServiceServiceMutationUpdateDescriptionService.OnboardingArchitecture, and is useful for accessing the field via an interface.
-func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) GetOnboardingArchitecture() string {
-	return v.Service.OnboardingArchitecture
-}
+// GetId returns SubgraphCompositionLaunch.Id, and is useful for accessing the field via an interface.
+func (v *SubgraphCompositionLaunch) GetId() string { return v.Id }
 
-// GetAccountId returns updateServiceDescriptionServiceServiceMutationUpdateDescriptionService.AccountId, and is useful for accessing the field via an interface.
-func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) GetAccountId() string {
-	return v.Service.AccountId
+type SubscriptionOptionsInput struct {
+	// Enables notifications for schema updates
+	SchemaUpdates bool `json:"schemaUpdates"`
 }
 
-// GetDescription returns updateServiceDescriptionServiceServiceMutationUpdateDescriptionService.Description, and is useful for accessing the field via an interface.
-func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) GetDescription() string {
-	return v.Service.Description
+// GetSchemaUpdates returns SubscriptionOptionsInput.SchemaUpdates, and is useful for accessing the field via an interface.
+func (v *SubscriptionOptionsInput) GetSchemaUpdates() bool { return v.SchemaUpdates }
+
+// Variant includes the GraphQL fields of GraphVariant requested by the fragment Variant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type Variant struct {
+	// The variant's global identifier in the form `graphID@variant`.
+	Id string `json:"id"`
+	// The variant's name (e.g., `staging`).
+	Name     string `json:"name"`
+	IsPublic bool   `json:"isPublic"`
+	// Represents whether this variant should be listed in the public variants
+	// directory. This can only be true if the variant is also public.
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+	// If the variant is protected
+	IsProtected bool `json:"isProtected"`
+	// The URL of the variant's GraphQL endpoint for query and mutation operations.
+	// For subscription operations, use `subscriptionUrl`.
+	Url *string `json:"url"`
+	// The URL of the variant's GraphQL endpoint for subscription operations.
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+	// If the graphql endpoint is set up to accept cookies.
+	SendCookies *bool `json:"sendCookies"`
+	// Explorer setting for preflight script to run before the actual GraphQL operations is run.
+	PreflightScript *string `json:"preflightScript"`
+	// Explorer setting for postflight script to run before the actual GraphQL operations is run.
+	PostflightScript *string `json:"postflightScript"`
+	// Explorer setting for shared headers for a graph
+	SharedHeaders *string                `json:"sharedHeaders"`
+	Readme        VariantReadme          `json:"readme"`
+	Links         []VariantLinksLinkInfo `json:"links"`
+	// Graph ID of the variant. Prefer using graph { id } when feasible.
+	GraphId string `json:"graphId"`
 }
 
-func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) UnmarshalJSON(b []byte) error {
+// GetId returns Variant.Id, and is useful for accessing the field via an interface.
+func (v *Variant) GetId() string { return v.Id }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetName returns Variant.Name, and is useful for accessing the field via an interface.
+func (v *Variant) GetName() string { return v.Name }
 
-	var firstPass struct {
-		*updateServiceDescriptionServiceServiceMutationUpdateDescriptionService
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.updateServiceDescriptionServiceServiceMutationUpdateDescriptionService = v
+// GetIsPublic returns Variant.IsPublic, and is useful for accessing the field via an interface.
+func (v *Variant) GetIsPublic() bool { return v.IsPublic }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetIsPubliclyListed returns Variant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *Variant) GetIsPubliclyListed() bool { return v.IsPubliclyListed }
 
-	err = json.Unmarshal(
-		b, &v.Service)
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// GetIsProtected returns Variant.IsProtected, and is useful for accessing the field via an interface.
+func (v *Variant) GetIsProtected() bool { return v.IsProtected }
 
-type __premarshalupdateServiceDescriptionServiceServiceMutationUpdateDescriptionService struct {
-	Id string `json:"id"`
+// GetUrl returns Variant.Url, and is useful for accessing the field via an interface.
+func (v *Variant) GetUrl() *string { return v.Url }
 
-	Title string `json:"title"`
+// GetSubscriptionUrl returns Variant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *Variant) GetSubscriptionUrl() *string { return v.SubscriptionUrl }
 
-	OnboardingArchitecture string `json:"onboardingArchitecture"`
+// GetSendCookies returns Variant.SendCookies, and is useful for accessing the field via an interface.
+func (v *Variant) GetSendCookies() *bool { return v.SendCookies }
 
-	AccountId string `json:"accountId"`
+// GetPreflightScript returns Variant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *Variant) GetPreflightScript() *string { return v.PreflightScript }
 
-	Description string `json:"description"`
+// GetPostflightScript returns Variant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *Variant) GetPostflightScript() *string { return v.PostflightScript }
+
+// GetSharedHeaders returns Variant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *Variant) GetSharedHeaders() *string { return v.SharedHeaders }
+
+// GetReadme returns Variant.Readme, and is useful for accessing the field via an interface.
+func (v *Variant) GetReadme() VariantReadme { return v.Readme }
+
+// GetLinks returns Variant.Links, and is useful for accessing the field via an interface.
+func (v *Variant) GetLinks() []VariantLinksLinkInfo { return v.Links }
+
+// GetGraphId returns Variant.GraphId, and is useful for accessing the field via an interface.
+func (v *Variant) GetGraphId() string { return v.GraphId }
+
+// VariantCheckConfiguration includes the GraphQL fields of VariantCheckConfiguration requested by the fragment VariantCheckConfiguration.
+// The GraphQL type's documentation follows.
+//
+// Variant-level configuration of checks.
+type VariantCheckConfiguration struct {
+	// Whether operations checks are enabled.
+	OperationsChecksEnabled bool `json:"operationsChecksEnabled"`
+	// Operation checks configuration for time range and associated thresholds.
+	TimeRangeConfig VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange `json:"timeRangeConfig"`
+	// Operation checks configuration for which variants' metrics data to include.
+	IncludedVariantsConfig VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants `json:"includedVariantsConfig"`
+	// Operation checks configuration for which clients to ignore.
+	ExcludedClientsConfig VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients `json:"excludedClientsConfig"`
+	// Operation checks configuration for which operation to ignore.
+	ExcludedOperationsConfig VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations `json:"excludedOperationsConfig"`
+	// Operation checks configuration that allows associated checks to be downgraded from failure to passing.
+	DowngradeChecksConfig VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks `json:"downgradeChecksConfig"`
 }
 
-func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetOperationsChecksEnabled returns VariantCheckConfiguration.OperationsChecksEnabled, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfiguration) GetOperationsChecksEnabled() bool {
+	return v.OperationsChecksEnabled
 }
 
-func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) __premarshalJSON() (*__premarshalupdateServiceDescriptionServiceServiceMutationUpdateDescriptionService, error) {
-	var retval __premarshalupdateServiceDescriptionServiceServiceMutationUpdateDescriptionService
+// GetTimeRangeConfig returns VariantCheckConfiguration.TimeRangeConfig, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfiguration) GetTimeRangeConfig() VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange {
+	return v.TimeRangeConfig
+}
 
-	retval.Id = v.Service.Id
-	retval.Title = v.Service.Title
-	retval.OnboardingArchitecture = v.Service.OnboardingArchitecture
-	retval.AccountId = v.Service.AccountId
-	retval.Description = v.Service.Description
-	return &retval, nil
+// GetIncludedVariantsConfig returns VariantCheckConfiguration.IncludedVariantsConfig, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfiguration) GetIncludedVariantsConfig() VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants {
+	return v.IncludedVariantsConfig
 }
 
-// updateServiceTitleResponse is returned by updateServiceTitle on success.
-type updateServiceTitleResponse struct {
-	Service updateServiceTitleServiceServiceMutation `json:"service"`
+// GetExcludedClientsConfig returns VariantCheckConfiguration.ExcludedClientsConfig, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfiguration) GetExcludedClientsConfig() VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients {
+	return v.ExcludedClientsConfig
 }
 
-// GetService returns updateServiceTitleResponse.Service, and is useful for accessing the field via an interface.
-func (v *updateServiceTitleResponse) GetService() updateServiceTitleServiceServiceMutation {
-	return v.Service
+// GetExcludedOperationsConfig returns VariantCheckConfiguration.ExcludedOperationsConfig, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfiguration) GetExcludedOperationsConfig() VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations {
+	return v.ExcludedOperationsConfig
 }
 
-// updateServiceTitleServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
-// The GraphQL type's documentation follows.
-//
-// Provides access to mutation fields for managing Studio graphs and subgraphs.
-type updateServiceTitleServiceServiceMutation struct {
-	UpdateTitle updateServiceTitleServiceServiceMutationUpdateTitleService `json:"updateTitle"`
+// GetDowngradeChecksConfig returns VariantCheckConfiguration.DowngradeChecksConfig, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfiguration) GetDowngradeChecksConfig() VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks {
+	return v.DowngradeChecksConfig
 }
 
-// GetUpdateTitle returns updateServiceTitleServiceServiceMutation.UpdateTitle, and is useful for accessing the field via an interface.
-func (v *updateServiceTitleServiceServiceMutation) GetUpdateTitle() updateServiceTitleServiceServiceMutationUpdateTitleService {
-	return v.UpdateTitle
+// VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks includes the requested fields of the GraphQL type VariantCheckConfigurationDowngradeChecks.
+type VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks struct {
+	// When true, indicates that graph-level configuration is used for this variant setting. The default
+	// at variant creation is true.
+	UseGraphSettings bool `json:"useGraphSettings"`
+	// During operation checks, if this option is enabled, it evaluates a check run against zero operations
+	// as a pass instead of a failure.
+	DowngradeStaticChecks *bool `json:"downgradeStaticChecks"`
+	// During operation checks, if this option is enabled, the check will not fail or mark any operations as
+	// broken/changed if the default value has changed, only if the default value is removed completely.
+	DowngradeDefaultValueChange *bool `json:"downgradeDefaultValueChange"`
 }
 
-// updateServiceTitleServiceServiceMutationUpdateTitleService includes the requested fields of the GraphQL type Service.
-// The GraphQL type's documentation follows.
-//
-// A graph in Apollo Studio represents a graph in your organization.
-// Each graph has one or more variants, which correspond to the different
-// environments where that graph runs (such as staging and production).
-// Each variant has its own GraphQL schema, which means schemas can differ between environments.
-type updateServiceTitleServiceServiceMutationUpdateTitleService struct {
-	Service `json:"-"`
+// GetUseGraphSettings returns VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks.UseGraphSettings, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks) GetUseGraphSettings() bool {
+	return v.UseGraphSettings
 }
 
-// GetId returns updateServiceTitleServiceServiceMutationUpdateTitleService.Id, and is useful for accessing the field via an interface.
-func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) GetId() string {
-	return v.Service.Id
+// GetDowngradeStaticChecks returns VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks.DowngradeStaticChecks, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks) GetDowngradeStaticChecks() *bool {
+	return v.DowngradeStaticChecks
 }
 
-// GetTitle returns updateServiceTitleServiceServiceMutationUpdateTitleService.Title, and is useful for accessing the field via an interface.
-func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) GetTitle() string {
-	return v.Service.Title
+// GetDowngradeDefaultValueChange returns VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks.DowngradeDefaultValueChange, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks) GetDowngradeDefaultValueChange() *bool {
+	return v.DowngradeDefaultValueChange
 }
 
-// GetOnboardingArchitecture returns updateServiceTitleServiceServiceMutationUpdateTitleService.OnboardingArchitecture, and is useful for accessing the field via an interface.
-func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) GetOnboardingArchitecture() string {
-	return v.Service.OnboardingArchitecture
+// VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients includes the requested fields of the GraphQL type VariantCheckConfigurationExcludedClients.
+type VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients struct {
+	// When true, indicates that graph-level configuration is appended to the variant-level
+	// configuration. The default at variant creation is true.
+	AppendGraphSettings bool `json:"appendGraphSettings"`
+	// During operation checks, ignore clients matching any of the <excludedClients> filters. The
+	// default at variant creation is the empty list.
+	ExcludedClients []VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClientsExcludedClientsClientFilter `json:"excludedClients"`
 }
 
-// GetAccountId returns updateServiceTitleServiceServiceMutationUpdateTitleService.AccountId, and is useful for accessing the field via an interface.
-func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) GetAccountId() string {
-	return v.Service.AccountId
+// GetAppendGraphSettings returns VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients.AppendGraphSettings, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients) GetAppendGraphSettings() bool {
+	return v.AppendGraphSettings
 }
 
-// GetDescription returns updateServiceTitleServiceServiceMutationUpdateTitleService.Description, and is useful for accessing the field via an interface.
-func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) GetDescription() string {
-	return v.Service.Description
+// GetExcludedClients returns VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients.ExcludedClients, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients) GetExcludedClients() []VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClientsExcludedClientsClientFilter {
+	return v.ExcludedClients
 }
 
-func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) UnmarshalJSON(b []byte) error {
+// VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClientsExcludedClientsClientFilter includes the requested fields of the GraphQL type ClientFilter.
+// The GraphQL type's documentation follows.
+//
+// A client to be filtered.
+type VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClientsExcludedClientsClientFilter struct {
+	// Name of the client is required.
+	Name string `json:"name"`
+	// Version string of the client.
+	Version *string `json:"version"`
+}
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetName returns VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClientsExcludedClientsClientFilter.Name, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClientsExcludedClientsClientFilter) GetName() string {
+	return v.Name
+}
 
-	var firstPass struct {
-		*updateServiceTitleServiceServiceMutationUpdateTitleService
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.updateServiceTitleServiceServiceMutationUpdateTitleService = v
+// GetVersion returns VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClientsExcludedClientsClientFilter.Version, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClientsExcludedClientsClientFilter) GetVersion() *string {
+	return v.Version
+}
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations includes the requested fields of the GraphQL type VariantCheckConfigurationExcludedOperations.
+type VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations struct {
+	// When true, indicates that graph-level configuration is appended to the variant-level
+	// configuration. The default at variant creation is true.
+	AppendGraphSettings bool `json:"appendGraphSettings"`
+	// During operation checks, ignore operations matching any of the <excludedOperations> filters. The
+	// default at variant creation is the empty list.
+	ExcludedOperations []VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperationsExcludedOperationsOperationInfoFilter `json:"excludedOperations"`
+}
 
-	err = json.Unmarshal(
-		b, &v.Service)
-	if err != nil {
-		return err
-	}
-	return nil
+// GetAppendGraphSettings returns VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations.AppendGraphSettings, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations) GetAppendGraphSettings() bool {
+	return v.AppendGraphSettings
 }
 
-type __premarshalupdateServiceTitleServiceServiceMutationUpdateTitleService struct {
-	Id string `json:"id"`
+// GetExcludedOperations returns VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations.ExcludedOperations, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations) GetExcludedOperations() []VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperationsExcludedOperationsOperationInfoFilter {
+	return v.ExcludedOperations
+}
 
-	Title string `json:"title"`
+// VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperationsExcludedOperationsOperationInfoFilter includes the requested fields of the GraphQL type OperationInfoFilter.
+type VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperationsExcludedOperationsOperationInfoFilter struct {
+	Id string `json:"id"`
+}
 
-	OnboardingArchitecture string `json:"onboardingArchitecture"`
+// GetId returns VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperationsExcludedOperationsOperationInfoFilter.Id, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperationsExcludedOperationsOperationInfoFilter) GetId() string {
+	return v.Id
+}
 
-	AccountId string `json:"accountId"`
+// VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants includes the requested fields of the GraphQL type VariantCheckConfigurationIncludedVariants.
+type VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants struct {
+	// When true, indicates that graph-level configuration is used for this variant setting. The default
+	// at variant creation is true.
+	UseGraphSettings bool `json:"useGraphSettings"`
+	// During operation checks, fetch operations from the metrics data for <includedVariants> variants.
+	// Non-null if useGraphSettings is false and is otherwise null.
+	IncludedVariants []string `json:"includedVariants"`
+}
 
-	Description string `json:"description"`
+// GetUseGraphSettings returns VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants.UseGraphSettings, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants) GetUseGraphSettings() bool {
+	return v.UseGraphSettings
 }
 
-func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
+// GetIncludedVariants returns VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants.IncludedVariants, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants) GetIncludedVariants() []string {
+	return v.IncludedVariants
 }
 
-func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) __premarshalJSON() (*__premarshalupdateServiceTitleServiceServiceMutationUpdateTitleService, error) {
-	var retval __premarshalupdateServiceTitleServiceServiceMutationUpdateTitleService
+// VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange includes the requested fields of the GraphQL type VariantCheckConfigurationTimeRange.
+type VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange struct {
+	// When true, indicates that graph-level configuration is used for this variant setting. The default
+	// at variant creation is true.
+	UseGraphSettings bool `json:"useGraphSettings"`
+	// During operation checks, fetch operations from the last <timeRangeSeconds> seconds. Non-null if
+	// useGraphSettings is false and is otherwise null.
+	TimeRangeSeconds *int64 `json:"timeRangeSeconds"`
+	// During operation checks, ignore operations that executed less than <operationCountThreshold>
+	// times in the time range. Non-null if useGraphSettings is false and is otherwise null.
+	OperationCountThreshold *int `json:"operationCountThreshold"`
+	// Duration operation checks, ignore operations that constituted less than
+	// <operationCountThresholdPercentage>% of the operations in the time range. Expected values are
+	// between 0% and 5%. Non-null if useGraphSettings is false and is otherwise null.
+	OperationCountThresholdPercentage *float64 `json:"operationCountThresholdPercentage"`
+}
 
-	retval.Id = v.Service.Id
-	retval.Title = v.Service.Title
-	retval.OnboardingArchitecture = v.Service.OnboardingArchitecture
-	retval.AccountId = v.Service.AccountId
-	retval.Description = v.Service.Description
-	return &retval, nil
+// GetUseGraphSettings returns VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange.UseGraphSettings, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange) GetUseGraphSettings() bool {
+	return v.UseGraphSettings
 }
 
-// updateVariantIsPublicResponse is returned by updateVariantIsPublic on success.
-type updateVariantIsPublicResponse struct {
-	Service updateVariantIsPublicServiceServiceMutation `json:"service"`
+// GetTimeRangeSeconds returns VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange.TimeRangeSeconds, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange) GetTimeRangeSeconds() *int64 {
+	return v.TimeRangeSeconds
 }
 
-// GetService returns updateVariantIsPublicResponse.Service, and is useful for accessing the field via an interface.
-func (v *updateVariantIsPublicResponse) GetService() updateVariantIsPublicServiceServiceMutation {
-	return v.Service
+// GetOperationCountThreshold returns VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange.OperationCountThreshold, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange) GetOperationCountThreshold() *int {
+	return v.OperationCountThreshold
 }
 
-// updateVariantIsPublicServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
-// The GraphQL type's documentation follows.
-//
-// Provides access to mutation fields for managing Studio graphs and subgraphs.
-type updateVariantIsPublicServiceServiceMutation struct {
-	// Make changes to a graph variant.
-	Variant updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+// GetOperationCountThresholdPercentage returns VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange.OperationCountThresholdPercentage, and is useful for accessing the field via an interface.
+func (v *VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange) GetOperationCountThresholdPercentage() *float64 {
+	return v.OperationCountThresholdPercentage
 }
 
-// GetVariant returns updateVariantIsPublicServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
-func (v *updateVariantIsPublicServiceServiceMutation) GetVariant() updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutation {
-	return v.Variant
+// VariantLinksLinkInfo includes the requested fields of the GraphQL type LinkInfo.
+type VariantLinksLinkInfo struct {
+	Id    string       `json:"id"`
+	Title *string      `json:"title"`
+	Type  LinkInfoType `json:"type"`
+	Url   string       `json:"url"`
 }
 
-// updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// GetId returns VariantLinksLinkInfo.Id, and is useful for accessing the field via an interface.
+func (v *VariantLinksLinkInfo) GetId() string { return v.Id }
+
+// GetTitle returns VariantLinksLinkInfo.Title, and is useful for accessing the field via an interface.
+func (v *VariantLinksLinkInfo) GetTitle() *string { return v.Title }
+
+// GetType returns VariantLinksLinkInfo.Type, and is useful for accessing the field via an interface.
+func (v *VariantLinksLinkInfo) GetType() LinkInfoType { return v.Type }
+
+// GetUrl returns VariantLinksLinkInfo.Url, and is useful for accessing the field via an interface.
+func (v *VariantLinksLinkInfo) GetUrl() string { return v.Url }
+
+// VariantReadme includes the requested fields of the GraphQL type Readme.
 // The GraphQL type's documentation follows.
 //
-// Modifies a variant of a graph, also called a schema tag in parts of our product.
-type updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutation struct {
-	UpdateVariantIsPublic updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant `json:"updateVariantIsPublic"`
+// The README documentation for a graph variant, which is displayed in Studio.
+type VariantReadme struct {
+	// The contents of the README in plaintext.
+	Content string `json:"content"`
 }
 
-// GetUpdateVariantIsPublic returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutation.UpdateVariantIsPublic, and is useful for accessing the field via an interface.
-func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutation) GetUpdateVariantIsPublic() updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant {
-	return v.UpdateVariantIsPublic
-}
+// GetContent returns VariantReadme.Content, and is useful for accessing the field via an interface.
+func (v *VariantReadme) GetContent() string { return v.Content }
 
-// updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// WebhookChannel includes the GraphQL fields of WebhookChannel requested by the fragment WebhookChannel.
 // The GraphQL type's documentation follows.
 //
-// A graph variant
-type updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant struct {
-	Variant `json:"-"`
+// Webhook notification channel
+type WebhookChannel struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	Url         string  `json:"url"`
+	SecretToken *string `json:"secretToken"`
 }
 
-// GetId returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.Id, and is useful for accessing the field via an interface.
-func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetId() string {
-	return v.Variant.Id
-}
+// GetId returns WebhookChannel.Id, and is useful for accessing the field via an interface.
+func (v *WebhookChannel) GetId() string { return v.Id }
 
-// GetName returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.Name, and is useful for accessing the field via an interface.
-func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetName() string {
-	return v.Variant.Name
-}
+// GetName returns WebhookChannel.Name, and is useful for accessing the field via an interface.
+func (v *WebhookChannel) GetName() string { return v.Name }
 
-// GetIsPublic returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.IsPublic, and is useful for accessing the field via an interface.
-func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetIsPublic() bool {
-	return v.Variant.IsPublic
-}
+// GetUrl returns WebhookChannel.Url, and is useful for accessing the field via an interface.
+func (v *WebhookChannel) GetUrl() string { return v.Url }
 
-// GetUrl returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.Url, and is useful for accessing the field via an interface.
-func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetUrl() *string {
-	return v.Variant.Url
-}
+// GetSecretToken returns WebhookChannel.SecretToken, and is useful for accessing the field via an interface.
+func (v *WebhookChannel) GetSecretToken() *string { return v.SecretToken }
 
-// GetGraphId returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.GraphId, and is useful for accessing the field via an interface.
-func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetGraphId() string {
-	return v.Variant.GraphId
+// __addVariantLinkInput is used internally by genqlient
+type __addVariantLinkInput struct {
+	ServiceId   string       `json:"serviceId"`
+	VariantName string       `json:"variantName"`
+	Title       *string      `json:"title"`
+	LinkType    LinkInfoType `json:"linkType"`
+	Url         string       `json:"url"`
 }
 
-func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) UnmarshalJSON(b []byte) error {
+// GetServiceId returns __addVariantLinkInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__addVariantLinkInput) GetServiceId() string { return v.ServiceId }
 
-	if string(b) == "null" {
-		return nil
-	}
+// GetVariantName returns __addVariantLinkInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__addVariantLinkInput) GetVariantName() string { return v.VariantName }
 
-	var firstPass struct {
-		*updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant
-		graphql.NoUnmarshalJSON
-	}
-	firstPass.updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant = v
+// GetTitle returns __addVariantLinkInput.Title, and is useful for accessing the field via an interface.
+func (v *__addVariantLinkInput) GetTitle() *string { return v.Title }
 
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
-	}
+// GetLinkType returns __addVariantLinkInput.LinkType, and is useful for accessing the field via an interface.
+func (v *__addVariantLinkInput) GetLinkType() LinkInfoType { return v.LinkType }
 
-	err = json.Unmarshal(
-		b, &v.Variant)
-	if err != nil {
-		return err
-	}
-	return nil
-}
+// GetUrl returns __addVariantLinkInput.Url, and is useful for accessing the field via an interface.
+func (v *__addVariantLinkInput) GetUrl() string { return v.Url }
 
-type __premarshalupdateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant struct {
-	Id string `json:"id"`
+// __createKeyInput is used internally by genqlient
+type __createKeyInput struct {
+	ServiceId string `json:"serviceId"`
+	KeyName   string `json:"keyName"`
+	Role      string `json:"role"`
+}
 
-	Name string `json:"name"`
+// GetServiceId returns __createKeyInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__createKeyInput) GetServiceId() string { return v.ServiceId }
 
-	IsPublic bool `json:"isPublic"`
+// GetKeyName returns __createKeyInput.KeyName, and is useful for accessing the field via an interface.
+func (v *__createKeyInput) GetKeyName() string { return v.KeyName }
 
-	Url *string `json:"url"`
+// GetRole returns __createKeyInput.Role, and is useful for accessing the field via an interface.
+func (v *__createKeyInput) GetRole() string { return v.Role }
 
-	GraphId string `json:"graphId"`
+// __createOrganizationInvitationInput is used internally by genqlient
+type __createOrganizationInvitationInput struct {
+	AccountId string `json:"accountId"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
 }
 
-func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
-	}
-	return json.Marshal(premarshaled)
-}
+// GetAccountId returns __createOrganizationInvitationInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__createOrganizationInvitationInput) GetAccountId() string { return v.AccountId }
 
-func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) __premarshalJSON() (*__premarshalupdateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant, error) {
-	var retval __premarshalupdateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant
+// GetEmail returns __createOrganizationInvitationInput.Email, and is useful for accessing the field via an interface.
+func (v *__createOrganizationInvitationInput) GetEmail() string { return v.Email }
 
-	retval.Id = v.Variant.Id
-	retval.Name = v.Variant.Name
-	retval.IsPublic = v.Variant.IsPublic
-	retval.Url = v.Variant.Url
-	retval.GraphId = v.Variant.GraphId
-	return &retval, nil
-}
+// GetRole returns __createOrganizationInvitationInput.Role, and is useful for accessing the field via an interface.
+func (v *__createOrganizationInvitationInput) GetRole() string { return v.Role }
 
-// updateVariantURLResponse is returned by updateVariantURL on success.
-type updateVariantURLResponse struct {
-	Service updateVariantURLServiceServiceMutation `json:"service"`
+// __createOrganizationInviteLinkInput is used internally by genqlient
+type __createOrganizationInviteLinkInput struct {
+	AccountId string `json:"accountId"`
+	Role      string `json:"role"`
 }
 
-// GetService returns updateVariantURLResponse.Service, and is useful for accessing the field via an interface.
-func (v *updateVariantURLResponse) GetService() updateVariantURLServiceServiceMutation {
-	return v.Service
-}
+// GetAccountId returns __createOrganizationInviteLinkInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__createOrganizationInviteLinkInput) GetAccountId() string { return v.AccountId }
 
-// updateVariantURLServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
-// The GraphQL type's documentation follows.
-//
-// Provides access to mutation fields for managing Studio graphs and subgraphs.
-type updateVariantURLServiceServiceMutation struct {
-	// Make changes to a graph variant.
-	Variant updateVariantURLServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
-}
+// GetRole returns __createOrganizationInviteLinkInput.Role, and is useful for accessing the field via an interface.
+func (v *__createOrganizationInviteLinkInput) GetRole() string { return v.Role }
 
-// GetVariant returns updateVariantURLServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
-func (v *updateVariantURLServiceServiceMutation) GetVariant() updateVariantURLServiceServiceMutationVariantGraphVariantMutation {
-	return v.Variant
+// __createPersistedQueryListInput is used internally by genqlient
+type __createPersistedQueryListInput struct {
+	ServiceId   string `json:"serviceId"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
 }
 
-// updateVariantURLServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
-// The GraphQL type's documentation follows.
-//
+// GetServiceId returns __createPersistedQueryListInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__createPersistedQueryListInput) GetServiceId() string { return v.ServiceId }
+
+// GetName returns __createPersistedQueryListInput.Name, and is useful for accessing the field via an interface.
+func (v *__createPersistedQueryListInput) GetName() string { return v.Name }
+
+// GetDescription returns __createPersistedQueryListInput.Description, and is useful for accessing the field via an interface.
+func (v *__createPersistedQueryListInput) GetDescription() string { return v.Description }
+
+// __createServiceInput is used internally by genqlient
+type __createServiceInput struct {
+	Id                                        string `json:"id"`
+	Title                                     string `json:"title"`
+	OnboardingArchitecture                    string `json:"onboardingArchitecture"`
+	AccountId                                 string `json:"accountId"`
+	Description                               string `json:"description"`
+	HiddenFromUninvitedNonAdminAccountMembers bool   `json:"hiddenFromUninvitedNonAdminAccountMembers"`
+}
+
+// GetId returns __createServiceInput.Id, and is useful for accessing the field via an interface.
+func (v *__createServiceInput) GetId() string { return v.Id }
+
+// GetTitle returns __createServiceInput.Title, and is useful for accessing the field via an interface.
+func (v *__createServiceInput) GetTitle() string { return v.Title }
+
+// GetOnboardingArchitecture returns __createServiceInput.OnboardingArchitecture, and is useful for accessing the field via an interface.
+func (v *__createServiceInput) GetOnboardingArchitecture() string { return v.OnboardingArchitecture }
+
+// GetAccountId returns __createServiceInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__createServiceInput) GetAccountId() string { return v.AccountId }
+
+// GetDescription returns __createServiceInput.Description, and is useful for accessing the field via an interface.
+func (v *__createServiceInput) GetDescription() string { return v.Description }
+
+// GetHiddenFromUninvitedNonAdminAccountMembers returns __createServiceInput.HiddenFromUninvitedNonAdminAccountMembers, and is useful for accessing the field via an interface.
+func (v *__createServiceInput) GetHiddenFromUninvitedNonAdminAccountMembers() bool {
+	return v.HiddenFromUninvitedNonAdminAccountMembers
+}
+
+// __createVariantInput is used internally by genqlient
+type __createVariantInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+}
+
+// GetServiceId returns __createVariantInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__createVariantInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __createVariantInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__createVariantInput) GetVariantName() string { return v.VariantName }
+
+// __deleteChannelInput is used internally by genqlient
+type __deleteChannelInput struct {
+	ServiceId string `json:"serviceId"`
+	Id        string `json:"id"`
+}
+
+// GetServiceId returns __deleteChannelInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__deleteChannelInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __deleteChannelInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteChannelInput) GetId() string { return v.Id }
+
+// __deleteKeyInput is used internally by genqlient
+type __deleteKeyInput struct {
+	ServiceId string `json:"serviceId"`
+	KeyId     string `json:"keyId"`
+}
+
+// GetServiceId returns __deleteKeyInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__deleteKeyInput) GetServiceId() string { return v.ServiceId }
+
+// GetKeyId returns __deleteKeyInput.KeyId, and is useful for accessing the field via an interface.
+func (v *__deleteKeyInput) GetKeyId() string { return v.KeyId }
+
+// __deleteOrganizationInvitationInput is used internally by genqlient
+type __deleteOrganizationInvitationInput struct {
+	AccountId string `json:"accountId"`
+	Id        string `json:"id"`
+}
+
+// GetAccountId returns __deleteOrganizationInvitationInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__deleteOrganizationInvitationInput) GetAccountId() string { return v.AccountId }
+
+// GetId returns __deleteOrganizationInvitationInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteOrganizationInvitationInput) GetId() string { return v.Id }
+
+// __deleteOrganizationInviteLinkInput is used internally by genqlient
+type __deleteOrganizationInviteLinkInput struct {
+	AccountId string `json:"accountId"`
+	JoinToken string `json:"joinToken"`
+}
+
+// GetAccountId returns __deleteOrganizationInviteLinkInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__deleteOrganizationInviteLinkInput) GetAccountId() string { return v.AccountId }
+
+// GetJoinToken returns __deleteOrganizationInviteLinkInput.JoinToken, and is useful for accessing the field via an interface.
+func (v *__deleteOrganizationInviteLinkInput) GetJoinToken() string { return v.JoinToken }
+
+// __deleteOrganizationMemberInput is used internally by genqlient
+type __deleteOrganizationMemberInput struct {
+	AccountId string `json:"accountId"`
+	UserId    string `json:"userId"`
+}
+
+// GetAccountId returns __deleteOrganizationMemberInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__deleteOrganizationMemberInput) GetAccountId() string { return v.AccountId }
+
+// GetUserId returns __deleteOrganizationMemberInput.UserId, and is useful for accessing the field via an interface.
+func (v *__deleteOrganizationMemberInput) GetUserId() string { return v.UserId }
+
+// __deletePersistedQueryListInput is used internally by genqlient
+type __deletePersistedQueryListInput struct {
+	ServiceId string `json:"serviceId"`
+	Id        string `json:"id"`
+}
+
+// GetServiceId returns __deletePersistedQueryListInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__deletePersistedQueryListInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __deletePersistedQueryListInput.Id, and is useful for accessing the field via an interface.
+func (v *__deletePersistedQueryListInput) GetId() string { return v.Id }
+
+// __deleteQueryTriggerInput is used internally by genqlient
+type __deleteQueryTriggerInput struct {
+	ServiceId string `json:"serviceId"`
+	Id        string `json:"id"`
+}
+
+// GetServiceId returns __deleteQueryTriggerInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__deleteQueryTriggerInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __deleteQueryTriggerInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteQueryTriggerInput) GetId() string { return v.Id }
+
+// __deleteRegistrySubscriptionInput is used internally by genqlient
+type __deleteRegistrySubscriptionInput struct {
+	ServiceId string `json:"serviceId"`
+	Id        string `json:"id"`
+}
+
+// GetServiceId returns __deleteRegistrySubscriptionInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__deleteRegistrySubscriptionInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __deleteRegistrySubscriptionInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteRegistrySubscriptionInput) GetId() string { return v.Id }
+
+// __deleteScheduledSummaryInput is used internally by genqlient
+type __deleteScheduledSummaryInput struct {
+	ServiceId string `json:"serviceId"`
+	Id        string `json:"id"`
+}
+
+// GetServiceId returns __deleteScheduledSummaryInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__deleteScheduledSummaryInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __deleteScheduledSummaryInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteScheduledSummaryInput) GetId() string { return v.Id }
+
+// __deleteServiceInput is used internally by genqlient
+type __deleteServiceInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __deleteServiceInput.Id, and is useful for accessing the field via an interface.
+func (v *__deleteServiceInput) GetId() string { return v.Id }
+
+// __deleteSubgraphInput is used internally by genqlient
+type __deleteSubgraphInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+	Name        string `json:"name"`
+}
+
+// GetServiceId returns __deleteSubgraphInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__deleteSubgraphInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __deleteSubgraphInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__deleteSubgraphInput) GetVariantName() string { return v.VariantName }
+
+// GetName returns __deleteSubgraphInput.Name, and is useful for accessing the field via an interface.
+func (v *__deleteSubgraphInput) GetName() string { return v.Name }
+
+// __deleteVariantInput is used internally by genqlient
+type __deleteVariantInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+}
+
+// GetServiceId returns __deleteVariantInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__deleteVariantInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __deleteVariantInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__deleteVariantInput) GetVariantName() string { return v.VariantName }
+
+// __getCheckConfigurationInput is used internally by genqlient
+type __getCheckConfigurationInput struct {
+	ServiceId string `json:"serviceId"`
+}
+
+// GetServiceId returns __getCheckConfigurationInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getCheckConfigurationInput) GetServiceId() string { return v.ServiceId }
+
+// __getContractVariantInput is used internally by genqlient
+type __getContractVariantInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+}
+
+// GetServiceId returns __getContractVariantInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getContractVariantInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __getContractVariantInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__getContractVariantInput) GetVariantName() string { return v.VariantName }
+
+// __getGraphUserPermissionsInput is used internally by genqlient
+type __getGraphUserPermissionsInput struct {
+	ServiceId string `json:"serviceId"`
+}
+
+// GetServiceId returns __getGraphUserPermissionsInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getGraphUserPermissionsInput) GetServiceId() string { return v.ServiceId }
+
+// __getLinterConfigurationInput is used internally by genqlient
+type __getLinterConfigurationInput struct {
+	ServiceId string `json:"serviceId"`
+}
+
+// GetServiceId returns __getLinterConfigurationInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getLinterConfigurationInput) GetServiceId() string { return v.ServiceId }
+
+// __getOrganizationInvitationsInput is used internally by genqlient
+type __getOrganizationInvitationsInput struct {
+	AccountId string `json:"accountId"`
+}
+
+// GetAccountId returns __getOrganizationInvitationsInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__getOrganizationInvitationsInput) GetAccountId() string { return v.AccountId }
+
+// __getOrganizationInviteLinksInput is used internally by genqlient
+type __getOrganizationInviteLinksInput struct {
+	AccountId string `json:"accountId"`
+}
+
+// GetAccountId returns __getOrganizationInviteLinksInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__getOrganizationInviteLinksInput) GetAccountId() string { return v.AccountId }
+
+// __getOrganizationMembersInput is used internally by genqlient
+type __getOrganizationMembersInput struct {
+	AccountId string `json:"accountId"`
+}
+
+// GetAccountId returns __getOrganizationMembersInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__getOrganizationMembersInput) GetAccountId() string { return v.AccountId }
+
+// __getPagerDutyChannelInput is used internally by genqlient
+type __getPagerDutyChannelInput struct {
+	ServiceId string `json:"serviceId"`
+	Id        string `json:"id"`
+}
+
+// GetServiceId returns __getPagerDutyChannelInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getPagerDutyChannelInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __getPagerDutyChannelInput.Id, and is useful for accessing the field via an interface.
+func (v *__getPagerDutyChannelInput) GetId() string { return v.Id }
+
+// __getPersistedQueryListInput is used internally by genqlient
+type __getPersistedQueryListInput struct {
+	ServiceId string `json:"serviceId"`
+	Id        string `json:"id"`
+}
+
+// GetServiceId returns __getPersistedQueryListInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getPersistedQueryListInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __getPersistedQueryListInput.Id, and is useful for accessing the field via an interface.
+func (v *__getPersistedQueryListInput) GetId() string { return v.Id }
+
+// __getPersistedQueryListOperationsInput is used internally by genqlient
+type __getPersistedQueryListOperationsInput struct {
+	ServiceId string  `json:"serviceId"`
+	Id        string  `json:"id"`
+	After     *string `json:"after"`
+}
+
+// GetServiceId returns __getPersistedQueryListOperationsInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getPersistedQueryListOperationsInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __getPersistedQueryListOperationsInput.Id, and is useful for accessing the field via an interface.
+func (v *__getPersistedQueryListOperationsInput) GetId() string { return v.Id }
+
+// GetAfter returns __getPersistedQueryListOperationsInput.After, and is useful for accessing the field via an interface.
+func (v *__getPersistedQueryListOperationsInput) GetAfter() *string { return v.After }
+
+// __getQueryTriggersInput is used internally by genqlient
+type __getQueryTriggersInput struct {
+	ServiceId string `json:"serviceId"`
+}
+
+// GetServiceId returns __getQueryTriggersInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getQueryTriggersInput) GetServiceId() string { return v.ServiceId }
+
+// __getRegistrySubscriptionsInput is used internally by genqlient
+type __getRegistrySubscriptionsInput struct {
+	ServiceId string `json:"serviceId"`
+}
+
+// GetServiceId returns __getRegistrySubscriptionsInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getRegistrySubscriptionsInput) GetServiceId() string { return v.ServiceId }
+
+// __getScheduledSummariesInput is used internally by genqlient
+type __getScheduledSummariesInput struct {
+	ServiceId string `json:"serviceId"`
+}
+
+// GetServiceId returns __getScheduledSummariesInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getScheduledSummariesInput) GetServiceId() string { return v.ServiceId }
+
+// __getServiceInput is used internally by genqlient
+type __getServiceInput struct {
+	Id string `json:"id"`
+}
+
+// GetId returns __getServiceInput.Id, and is useful for accessing the field via an interface.
+func (v *__getServiceInput) GetId() string { return v.Id }
+
+// __getSlackChannelInput is used internally by genqlient
+type __getSlackChannelInput struct {
+	ServiceId string `json:"serviceId"`
+	Id        string `json:"id"`
+}
+
+// GetServiceId returns __getSlackChannelInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getSlackChannelInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __getSlackChannelInput.Id, and is useful for accessing the field via an interface.
+func (v *__getSlackChannelInput) GetId() string { return v.Id }
+
+// __getSubgraphInput is used internally by genqlient
+type __getSubgraphInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+	Name        string `json:"name"`
+}
+
+// GetServiceId returns __getSubgraphInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getSubgraphInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __getSubgraphInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__getSubgraphInput) GetVariantName() string { return v.VariantName }
+
+// GetName returns __getSubgraphInput.Name, and is useful for accessing the field via an interface.
+func (v *__getSubgraphInput) GetName() string { return v.Name }
+
+// __getVariantCheckConfigurationInput is used internally by genqlient
+type __getVariantCheckConfigurationInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+}
+
+// GetServiceId returns __getVariantCheckConfigurationInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getVariantCheckConfigurationInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __getVariantCheckConfigurationInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__getVariantCheckConfigurationInput) GetVariantName() string { return v.VariantName }
+
+// __getVariantInput is used internally by genqlient
+type __getVariantInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+}
+
+// GetServiceId returns __getVariantInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getVariantInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __getVariantInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__getVariantInput) GetVariantName() string { return v.VariantName }
+
+// __getVariantPersistedQueryListInput is used internally by genqlient
+type __getVariantPersistedQueryListInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+}
+
+// GetServiceId returns __getVariantPersistedQueryListInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getVariantPersistedQueryListInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __getVariantPersistedQueryListInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__getVariantPersistedQueryListInput) GetVariantName() string { return v.VariantName }
+
+// __getWebhookChannelInput is used internally by genqlient
+type __getWebhookChannelInput struct {
+	ServiceId string `json:"serviceId"`
+	Id        string `json:"id"`
+}
+
+// GetServiceId returns __getWebhookChannelInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__getWebhookChannelInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __getWebhookChannelInput.Id, and is useful for accessing the field via an interface.
+func (v *__getWebhookChannelInput) GetId() string { return v.Id }
+
+// __linkPersistedQueryListInput is used internally by genqlient
+type __linkPersistedQueryListInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+	Id          string `json:"id"`
+}
+
+// GetServiceId returns __linkPersistedQueryListInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__linkPersistedQueryListInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __linkPersistedQueryListInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__linkPersistedQueryListInput) GetVariantName() string { return v.VariantName }
+
+// GetId returns __linkPersistedQueryListInput.Id, and is useful for accessing the field via an interface.
+func (v *__linkPersistedQueryListInput) GetId() string { return v.Id }
+
+// __listKeysInput is used internally by genqlient
+type __listKeysInput struct {
+	ServiceId string `json:"serviceId"`
+}
+
+// GetServiceId returns __listKeysInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__listKeysInput) GetServiceId() string { return v.ServiceId }
+
+// __publishOperationsInput is used internally by genqlient
+type __publishOperationsInput struct {
+	ServiceId        string                `json:"serviceId"`
+	Id               string                `json:"id"`
+	Operations       []PersistedQueryInput `json:"operations"`
+	RemoveOperations []string              `json:"removeOperations"`
+}
+
+// GetServiceId returns __publishOperationsInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__publishOperationsInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __publishOperationsInput.Id, and is useful for accessing the field via an interface.
+func (v *__publishOperationsInput) GetId() string { return v.Id }
+
+// GetOperations returns __publishOperationsInput.Operations, and is useful for accessing the field via an interface.
+func (v *__publishOperationsInput) GetOperations() []PersistedQueryInput { return v.Operations }
+
+// GetRemoveOperations returns __publishOperationsInput.RemoveOperations, and is useful for accessing the field via an interface.
+func (v *__publishOperationsInput) GetRemoveOperations() []string { return v.RemoveOperations }
+
+// __publishSubgraphInput is used internally by genqlient
+type __publishSubgraphInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+	Name        string `json:"name"`
+	Url         string `json:"url"`
+	Revision    string `json:"revision"`
+	Schema      string `json:"schema"`
+}
+
+// GetServiceId returns __publishSubgraphInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__publishSubgraphInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __publishSubgraphInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__publishSubgraphInput) GetVariantName() string { return v.VariantName }
+
+// GetName returns __publishSubgraphInput.Name, and is useful for accessing the field via an interface.
+func (v *__publishSubgraphInput) GetName() string { return v.Name }
+
+// GetUrl returns __publishSubgraphInput.Url, and is useful for accessing the field via an interface.
+func (v *__publishSubgraphInput) GetUrl() string { return v.Url }
+
+// GetRevision returns __publishSubgraphInput.Revision, and is useful for accessing the field via an interface.
+func (v *__publishSubgraphInput) GetRevision() string { return v.Revision }
+
+// GetSchema returns __publishSubgraphInput.Schema, and is useful for accessing the field via an interface.
+func (v *__publishSubgraphInput) GetSchema() string { return v.Schema }
+
+// __removeVariantLinkInput is used internally by genqlient
+type __removeVariantLinkInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+	LinkInfoId  string `json:"linkInfoId"`
+}
+
+// GetServiceId returns __removeVariantLinkInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__removeVariantLinkInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __removeVariantLinkInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__removeVariantLinkInput) GetVariantName() string { return v.VariantName }
+
+// GetLinkInfoId returns __removeVariantLinkInput.LinkInfoId, and is useful for accessing the field via an interface.
+func (v *__removeVariantLinkInput) GetLinkInfoId() string { return v.LinkInfoId }
+
+// __resendOrganizationInvitationInput is used internally by genqlient
+type __resendOrganizationInvitationInput struct {
+	AccountId string `json:"accountId"`
+	Id        string `json:"id"`
+}
+
+// GetAccountId returns __resendOrganizationInvitationInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__resendOrganizationInvitationInput) GetAccountId() string { return v.AccountId }
+
+// GetId returns __resendOrganizationInvitationInput.Id, and is useful for accessing the field via an interface.
+func (v *__resendOrganizationInvitationInput) GetId() string { return v.Id }
+
+// __transferServiceInput is used internally by genqlient
+type __transferServiceInput struct {
+	Id string `json:"id"`
+	To string `json:"to"`
+}
+
+// GetId returns __transferServiceInput.Id, and is useful for accessing the field via an interface.
+func (v *__transferServiceInput) GetId() string { return v.Id }
+
+// GetTo returns __transferServiceInput.To, and is useful for accessing the field via an interface.
+func (v *__transferServiceInput) GetTo() string { return v.To }
+
+// __unlinkPersistedQueryListInput is used internally by genqlient
+type __unlinkPersistedQueryListInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+}
+
+// GetServiceId returns __unlinkPersistedQueryListInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__unlinkPersistedQueryListInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __unlinkPersistedQueryListInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__unlinkPersistedQueryListInput) GetVariantName() string { return v.VariantName }
+
+// __updateCheckConfigurationInput is used internally by genqlient
+type __updateCheckConfigurationInput struct {
+	ServiceId                         string                   `json:"serviceId"`
+	TimeRangeSeconds                  *int64                   `json:"timeRangeSeconds"`
+	OperationCountThreshold           *int                     `json:"operationCountThreshold"`
+	OperationCountThresholdPercentage *float64                 `json:"operationCountThresholdPercentage"`
+	IncludeBaseVariant                *bool                    `json:"includeBaseVariant"`
+	IncludedVariants                  []string                 `json:"includedVariants"`
+	ExcludedClients                   []ClientFilterInput      `json:"excludedClients"`
+	ExcludedOperations                []ExcludedOperationInput `json:"excludedOperations"`
+	DowngradeStaticChecks             *bool                    `json:"downgradeStaticChecks"`
+	DowngradeDefaultValueChange       *bool                    `json:"downgradeDefaultValueChange"`
+}
+
+// GetServiceId returns __updateCheckConfigurationInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateCheckConfigurationInput) GetServiceId() string { return v.ServiceId }
+
+// GetTimeRangeSeconds returns __updateCheckConfigurationInput.TimeRangeSeconds, and is useful for accessing the field via an interface.
+func (v *__updateCheckConfigurationInput) GetTimeRangeSeconds() *int64 { return v.TimeRangeSeconds }
+
+// GetOperationCountThreshold returns __updateCheckConfigurationInput.OperationCountThreshold, and is useful for accessing the field via an interface.
+func (v *__updateCheckConfigurationInput) GetOperationCountThreshold() *int {
+	return v.OperationCountThreshold
+}
+
+// GetOperationCountThresholdPercentage returns __updateCheckConfigurationInput.OperationCountThresholdPercentage, and is useful for accessing the field via an interface.
+func (v *__updateCheckConfigurationInput) GetOperationCountThresholdPercentage() *float64 {
+	return v.OperationCountThresholdPercentage
+}
+
+// GetIncludeBaseVariant returns __updateCheckConfigurationInput.IncludeBaseVariant, and is useful for accessing the field via an interface.
+func (v *__updateCheckConfigurationInput) GetIncludeBaseVariant() *bool { return v.IncludeBaseVariant }
+
+// GetIncludedVariants returns __updateCheckConfigurationInput.IncludedVariants, and is useful for accessing the field via an interface.
+func (v *__updateCheckConfigurationInput) GetIncludedVariants() []string { return v.IncludedVariants }
+
+// GetExcludedClients returns __updateCheckConfigurationInput.ExcludedClients, and is useful for accessing the field via an interface.
+func (v *__updateCheckConfigurationInput) GetExcludedClients() []ClientFilterInput {
+	return v.ExcludedClients
+}
+
+// GetExcludedOperations returns __updateCheckConfigurationInput.ExcludedOperations, and is useful for accessing the field via an interface.
+func (v *__updateCheckConfigurationInput) GetExcludedOperations() []ExcludedOperationInput {
+	return v.ExcludedOperations
+}
+
+// GetDowngradeStaticChecks returns __updateCheckConfigurationInput.DowngradeStaticChecks, and is useful for accessing the field via an interface.
+func (v *__updateCheckConfigurationInput) GetDowngradeStaticChecks() *bool {
+	return v.DowngradeStaticChecks
+}
+
+// GetDowngradeDefaultValueChange returns __updateCheckConfigurationInput.DowngradeDefaultValueChange, and is useful for accessing the field via an interface.
+func (v *__updateCheckConfigurationInput) GetDowngradeDefaultValueChange() *bool {
+	return v.DowngradeDefaultValueChange
+}
+
+// __updateGraphUserPermissionInput is used internally by genqlient
+type __updateGraphUserPermissionInput struct {
+	ServiceId  string  `json:"serviceId"`
+	UserId     string  `json:"userId"`
+	Permission *string `json:"permission"`
+}
+
+// GetServiceId returns __updateGraphUserPermissionInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateGraphUserPermissionInput) GetServiceId() string { return v.ServiceId }
+
+// GetUserId returns __updateGraphUserPermissionInput.UserId, and is useful for accessing the field via an interface.
+func (v *__updateGraphUserPermissionInput) GetUserId() string { return v.UserId }
+
+// GetPermission returns __updateGraphUserPermissionInput.Permission, and is useful for accessing the field via an interface.
+func (v *__updateGraphUserPermissionInput) GetPermission() *string { return v.Permission }
+
+// __updateKeyInput is used internally by genqlient
+type __updateKeyInput struct {
+	ServiceId string `json:"serviceId"`
+	KeyId     string `json:"keyId"`
+	KeyName   string `json:"keyName"`
+}
+
+// GetServiceId returns __updateKeyInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateKeyInput) GetServiceId() string { return v.ServiceId }
+
+// GetKeyId returns __updateKeyInput.KeyId, and is useful for accessing the field via an interface.
+func (v *__updateKeyInput) GetKeyId() string { return v.KeyId }
+
+// GetKeyName returns __updateKeyInput.KeyName, and is useful for accessing the field via an interface.
+func (v *__updateKeyInput) GetKeyName() string { return v.KeyName }
+
+// __updateLinterConfigurationInput is used internally by genqlient
+type __updateLinterConfigurationInput struct {
+	ServiceId string                               `json:"serviceId"`
+	Changes   GraphLinterConfigurationChangesInput `json:"changes"`
+}
+
+// GetServiceId returns __updateLinterConfigurationInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateLinterConfigurationInput) GetServiceId() string { return v.ServiceId }
+
+// GetChanges returns __updateLinterConfigurationInput.Changes, and is useful for accessing the field via an interface.
+func (v *__updateLinterConfigurationInput) GetChanges() GraphLinterConfigurationChangesInput {
+	return v.Changes
+}
+
+// __updateOrganizationMemberInput is used internally by genqlient
+type __updateOrganizationMemberInput struct {
+	AccountId  string `json:"accountId"`
+	UserId     string `json:"userId"`
+	Permission string `json:"permission"`
+}
+
+// GetAccountId returns __updateOrganizationMemberInput.AccountId, and is useful for accessing the field via an interface.
+func (v *__updateOrganizationMemberInput) GetAccountId() string { return v.AccountId }
+
+// GetUserId returns __updateOrganizationMemberInput.UserId, and is useful for accessing the field via an interface.
+func (v *__updateOrganizationMemberInput) GetUserId() string { return v.UserId }
+
+// GetPermission returns __updateOrganizationMemberInput.Permission, and is useful for accessing the field via an interface.
+func (v *__updateOrganizationMemberInput) GetPermission() string { return v.Permission }
+
+// __updatePersistedQueryListInput is used internally by genqlient
+type __updatePersistedQueryListInput struct {
+	ServiceId   string `json:"serviceId"`
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// GetServiceId returns __updatePersistedQueryListInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updatePersistedQueryListInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __updatePersistedQueryListInput.Id, and is useful for accessing the field via an interface.
+func (v *__updatePersistedQueryListInput) GetId() string { return v.Id }
+
+// GetName returns __updatePersistedQueryListInput.Name, and is useful for accessing the field via an interface.
+func (v *__updatePersistedQueryListInput) GetName() string { return v.Name }
+
+// GetDescription returns __updatePersistedQueryListInput.Description, and is useful for accessing the field via an interface.
+func (v *__updatePersistedQueryListInput) GetDescription() string { return v.Description }
+
+// __updateServiceDescriptionInput is used internally by genqlient
+type __updateServiceDescriptionInput struct {
+	Id          string `json:"id"`
+	Description string `json:"description"`
+}
+
+// GetId returns __updateServiceDescriptionInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateServiceDescriptionInput) GetId() string { return v.Id }
+
+// GetDescription returns __updateServiceDescriptionInput.Description, and is useful for accessing the field via an interface.
+func (v *__updateServiceDescriptionInput) GetDescription() string { return v.Description }
+
+// __updateServiceHiddenFromUninvitedNonAdminAccountMembersInput is used internally by genqlient
+type __updateServiceHiddenFromUninvitedNonAdminAccountMembersInput struct {
+	Id                                        string `json:"id"`
+	HiddenFromUninvitedNonAdminAccountMembers bool   `json:"hiddenFromUninvitedNonAdminAccountMembers"`
+}
+
+// GetId returns __updateServiceHiddenFromUninvitedNonAdminAccountMembersInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateServiceHiddenFromUninvitedNonAdminAccountMembersInput) GetId() string { return v.Id }
+
+// GetHiddenFromUninvitedNonAdminAccountMembers returns __updateServiceHiddenFromUninvitedNonAdminAccountMembersInput.HiddenFromUninvitedNonAdminAccountMembers, and is useful for accessing the field via an interface.
+func (v *__updateServiceHiddenFromUninvitedNonAdminAccountMembersInput) GetHiddenFromUninvitedNonAdminAccountMembers() bool {
+	return v.HiddenFromUninvitedNonAdminAccountMembers
+}
+
+// __updateServiceReadmeInput is used internally by genqlient
+type __updateServiceReadmeInput struct {
+	Id     string `json:"id"`
+	Readme string `json:"readme"`
+}
+
+// GetId returns __updateServiceReadmeInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateServiceReadmeInput) GetId() string { return v.Id }
+
+// GetReadme returns __updateServiceReadmeInput.Readme, and is useful for accessing the field via an interface.
+func (v *__updateServiceReadmeInput) GetReadme() string { return v.Readme }
+
+// __updateServiceTitleInput is used internally by genqlient
+type __updateServiceTitleInput struct {
+	Id    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// GetId returns __updateServiceTitleInput.Id, and is useful for accessing the field via an interface.
+func (v *__updateServiceTitleInput) GetId() string { return v.Id }
+
+// GetTitle returns __updateServiceTitleInput.Title, and is useful for accessing the field via an interface.
+func (v *__updateServiceTitleInput) GetTitle() string { return v.Title }
+
+// __updateVariantCheckConfigurationDowngradeChecksInput is used internally by genqlient
+type __updateVariantCheckConfigurationDowngradeChecksInput struct {
+	ServiceId                   string `json:"serviceId"`
+	VariantName                 string `json:"variantName"`
+	UseGraphSettings            bool   `json:"useGraphSettings"`
+	DowngradeStaticChecks       *bool  `json:"downgradeStaticChecks"`
+	DowngradeDefaultValueChange *bool  `json:"downgradeDefaultValueChange"`
+}
+
+// GetServiceId returns __updateVariantCheckConfigurationDowngradeChecksInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationDowngradeChecksInput) GetServiceId() string {
+	return v.ServiceId
+}
+
+// GetVariantName returns __updateVariantCheckConfigurationDowngradeChecksInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationDowngradeChecksInput) GetVariantName() string {
+	return v.VariantName
+}
+
+// GetUseGraphSettings returns __updateVariantCheckConfigurationDowngradeChecksInput.UseGraphSettings, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationDowngradeChecksInput) GetUseGraphSettings() bool {
+	return v.UseGraphSettings
+}
+
+// GetDowngradeStaticChecks returns __updateVariantCheckConfigurationDowngradeChecksInput.DowngradeStaticChecks, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationDowngradeChecksInput) GetDowngradeStaticChecks() *bool {
+	return v.DowngradeStaticChecks
+}
+
+// GetDowngradeDefaultValueChange returns __updateVariantCheckConfigurationDowngradeChecksInput.DowngradeDefaultValueChange, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationDowngradeChecksInput) GetDowngradeDefaultValueChange() *bool {
+	return v.DowngradeDefaultValueChange
+}
+
+// __updateVariantCheckConfigurationEnableOperationsCheckInput is used internally by genqlient
+type __updateVariantCheckConfigurationEnableOperationsCheckInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// GetServiceId returns __updateVariantCheckConfigurationEnableOperationsCheckInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationEnableOperationsCheckInput) GetServiceId() string {
+	return v.ServiceId
+}
+
+// GetVariantName returns __updateVariantCheckConfigurationEnableOperationsCheckInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationEnableOperationsCheckInput) GetVariantName() string {
+	return v.VariantName
+}
+
+// GetEnabled returns __updateVariantCheckConfigurationEnableOperationsCheckInput.Enabled, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationEnableOperationsCheckInput) GetEnabled() bool {
+	return v.Enabled
+}
+
+// __updateVariantCheckConfigurationExcludedClientsInput is used internally by genqlient
+type __updateVariantCheckConfigurationExcludedClientsInput struct {
+	ServiceId           string              `json:"serviceId"`
+	VariantName         string              `json:"variantName"`
+	AppendGraphSettings bool                `json:"appendGraphSettings"`
+	ExcludedClients     []ClientFilterInput `json:"excludedClients"`
+}
+
+// GetServiceId returns __updateVariantCheckConfigurationExcludedClientsInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationExcludedClientsInput) GetServiceId() string {
+	return v.ServiceId
+}
+
+// GetVariantName returns __updateVariantCheckConfigurationExcludedClientsInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationExcludedClientsInput) GetVariantName() string {
+	return v.VariantName
+}
+
+// GetAppendGraphSettings returns __updateVariantCheckConfigurationExcludedClientsInput.AppendGraphSettings, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationExcludedClientsInput) GetAppendGraphSettings() bool {
+	return v.AppendGraphSettings
+}
+
+// GetExcludedClients returns __updateVariantCheckConfigurationExcludedClientsInput.ExcludedClients, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationExcludedClientsInput) GetExcludedClients() []ClientFilterInput {
+	return v.ExcludedClients
+}
+
+// __updateVariantCheckConfigurationExcludedOperationsInput is used internally by genqlient
+type __updateVariantCheckConfigurationExcludedOperationsInput struct {
+	ServiceId           string                     `json:"serviceId"`
+	VariantName         string                     `json:"variantName"`
+	AppendGraphSettings bool                       `json:"appendGraphSettings"`
+	ExcludedOperations  []OperationInfoFilterInput `json:"excludedOperations"`
+}
+
+// GetServiceId returns __updateVariantCheckConfigurationExcludedOperationsInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationExcludedOperationsInput) GetServiceId() string {
+	return v.ServiceId
+}
+
+// GetVariantName returns __updateVariantCheckConfigurationExcludedOperationsInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationExcludedOperationsInput) GetVariantName() string {
+	return v.VariantName
+}
+
+// GetAppendGraphSettings returns __updateVariantCheckConfigurationExcludedOperationsInput.AppendGraphSettings, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationExcludedOperationsInput) GetAppendGraphSettings() bool {
+	return v.AppendGraphSettings
+}
+
+// GetExcludedOperations returns __updateVariantCheckConfigurationExcludedOperationsInput.ExcludedOperations, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationExcludedOperationsInput) GetExcludedOperations() []OperationInfoFilterInput {
+	return v.ExcludedOperations
+}
+
+// __updateVariantCheckConfigurationIncludedVariantsInput is used internally by genqlient
+type __updateVariantCheckConfigurationIncludedVariantsInput struct {
+	ServiceId        string   `json:"serviceId"`
+	VariantName      string   `json:"variantName"`
+	UseGraphSettings bool     `json:"useGraphSettings"`
+	IncludedVariants []string `json:"includedVariants"`
+}
+
+// GetServiceId returns __updateVariantCheckConfigurationIncludedVariantsInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationIncludedVariantsInput) GetServiceId() string {
+	return v.ServiceId
+}
+
+// GetVariantName returns __updateVariantCheckConfigurationIncludedVariantsInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationIncludedVariantsInput) GetVariantName() string {
+	return v.VariantName
+}
+
+// GetUseGraphSettings returns __updateVariantCheckConfigurationIncludedVariantsInput.UseGraphSettings, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationIncludedVariantsInput) GetUseGraphSettings() bool {
+	return v.UseGraphSettings
+}
+
+// GetIncludedVariants returns __updateVariantCheckConfigurationIncludedVariantsInput.IncludedVariants, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationIncludedVariantsInput) GetIncludedVariants() []string {
+	return v.IncludedVariants
+}
+
+// __updateVariantCheckConfigurationTimeRangeInput is used internally by genqlient
+type __updateVariantCheckConfigurationTimeRangeInput struct {
+	ServiceId                         string   `json:"serviceId"`
+	VariantName                       string   `json:"variantName"`
+	UseGraphSettings                  bool     `json:"useGraphSettings"`
+	TimeRangeSeconds                  *int64   `json:"timeRangeSeconds"`
+	OperationCountThreshold           *int     `json:"operationCountThreshold"`
+	OperationCountThresholdPercentage *float64 `json:"operationCountThresholdPercentage"`
+}
+
+// GetServiceId returns __updateVariantCheckConfigurationTimeRangeInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationTimeRangeInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __updateVariantCheckConfigurationTimeRangeInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationTimeRangeInput) GetVariantName() string {
+	return v.VariantName
+}
+
+// GetUseGraphSettings returns __updateVariantCheckConfigurationTimeRangeInput.UseGraphSettings, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationTimeRangeInput) GetUseGraphSettings() bool {
+	return v.UseGraphSettings
+}
+
+// GetTimeRangeSeconds returns __updateVariantCheckConfigurationTimeRangeInput.TimeRangeSeconds, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationTimeRangeInput) GetTimeRangeSeconds() *int64 {
+	return v.TimeRangeSeconds
+}
+
+// GetOperationCountThreshold returns __updateVariantCheckConfigurationTimeRangeInput.OperationCountThreshold, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationTimeRangeInput) GetOperationCountThreshold() *int {
+	return v.OperationCountThreshold
+}
+
+// GetOperationCountThresholdPercentage returns __updateVariantCheckConfigurationTimeRangeInput.OperationCountThresholdPercentage, and is useful for accessing the field via an interface.
+func (v *__updateVariantCheckConfigurationTimeRangeInput) GetOperationCountThresholdPercentage() *float64 {
+	return v.OperationCountThresholdPercentage
+}
+
+// __updateVariantIsProtectedInput is used internally by genqlient
+type __updateVariantIsProtectedInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+	IsProtected bool   `json:"isProtected"`
+}
+
+// GetServiceId returns __updateVariantIsProtectedInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantIsProtectedInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __updateVariantIsProtectedInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantIsProtectedInput) GetVariantName() string { return v.VariantName }
+
+// GetIsProtected returns __updateVariantIsProtectedInput.IsProtected, and is useful for accessing the field via an interface.
+func (v *__updateVariantIsProtectedInput) GetIsProtected() bool { return v.IsProtected }
+
+// __updateVariantIsPublicInput is used internally by genqlient
+type __updateVariantIsPublicInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+	IsPublic    bool   `json:"isPublic"`
+}
+
+// GetServiceId returns __updateVariantIsPublicInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantIsPublicInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __updateVariantIsPublicInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantIsPublicInput) GetVariantName() string { return v.VariantName }
+
+// GetIsPublic returns __updateVariantIsPublicInput.IsPublic, and is useful for accessing the field via an interface.
+func (v *__updateVariantIsPublicInput) GetIsPublic() bool { return v.IsPublic }
+
+// __updateVariantIsPubliclyListedInput is used internally by genqlient
+type __updateVariantIsPubliclyListedInput struct {
+	ServiceId        string `json:"serviceId"`
+	VariantName      string `json:"variantName"`
+	IsPubliclyListed bool   `json:"isPubliclyListed"`
+}
+
+// GetServiceId returns __updateVariantIsPubliclyListedInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantIsPubliclyListedInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __updateVariantIsPubliclyListedInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantIsPubliclyListedInput) GetVariantName() string { return v.VariantName }
+
+// GetIsPubliclyListed returns __updateVariantIsPubliclyListedInput.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *__updateVariantIsPubliclyListedInput) GetIsPubliclyListed() bool { return v.IsPubliclyListed }
+
+// __updateVariantPostflightScriptInput is used internally by genqlient
+type __updateVariantPostflightScriptInput struct {
+	ServiceId        string  `json:"serviceId"`
+	VariantName      string  `json:"variantName"`
+	PostflightScript *string `json:"postflightScript"`
+}
+
+// GetServiceId returns __updateVariantPostflightScriptInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantPostflightScriptInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __updateVariantPostflightScriptInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantPostflightScriptInput) GetVariantName() string { return v.VariantName }
+
+// GetPostflightScript returns __updateVariantPostflightScriptInput.PostflightScript, and is useful for accessing the field via an interface.
+func (v *__updateVariantPostflightScriptInput) GetPostflightScript() *string {
+	return v.PostflightScript
+}
+
+// __updateVariantPreflightScriptInput is used internally by genqlient
+type __updateVariantPreflightScriptInput struct {
+	ServiceId       string  `json:"serviceId"`
+	VariantName     string  `json:"variantName"`
+	PreflightScript *string `json:"preflightScript"`
+}
+
+// GetServiceId returns __updateVariantPreflightScriptInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantPreflightScriptInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __updateVariantPreflightScriptInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantPreflightScriptInput) GetVariantName() string { return v.VariantName }
+
+// GetPreflightScript returns __updateVariantPreflightScriptInput.PreflightScript, and is useful for accessing the field via an interface.
+func (v *__updateVariantPreflightScriptInput) GetPreflightScript() *string { return v.PreflightScript }
+
+// __updateVariantReadmeInput is used internally by genqlient
+type __updateVariantReadmeInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+	Readme      string `json:"readme"`
+}
+
+// GetServiceId returns __updateVariantReadmeInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantReadmeInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __updateVariantReadmeInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantReadmeInput) GetVariantName() string { return v.VariantName }
+
+// GetReadme returns __updateVariantReadmeInput.Readme, and is useful for accessing the field via an interface.
+func (v *__updateVariantReadmeInput) GetReadme() string { return v.Readme }
+
+// __updateVariantSendCookiesInput is used internally by genqlient
+type __updateVariantSendCookiesInput struct {
+	ServiceId   string `json:"serviceId"`
+	VariantName string `json:"variantName"`
+	SendCookies bool   `json:"sendCookies"`
+}
+
+// GetServiceId returns __updateVariantSendCookiesInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantSendCookiesInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __updateVariantSendCookiesInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantSendCookiesInput) GetVariantName() string { return v.VariantName }
+
+// GetSendCookies returns __updateVariantSendCookiesInput.SendCookies, and is useful for accessing the field via an interface.
+func (v *__updateVariantSendCookiesInput) GetSendCookies() bool { return v.SendCookies }
+
+// __updateVariantSharedHeadersInput is used internally by genqlient
+type __updateVariantSharedHeadersInput struct {
+	ServiceId     string  `json:"serviceId"`
+	VariantName   string  `json:"variantName"`
+	SharedHeaders *string `json:"sharedHeaders"`
+}
+
+// GetServiceId returns __updateVariantSharedHeadersInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantSharedHeadersInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __updateVariantSharedHeadersInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantSharedHeadersInput) GetVariantName() string { return v.VariantName }
+
+// GetSharedHeaders returns __updateVariantSharedHeadersInput.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *__updateVariantSharedHeadersInput) GetSharedHeaders() *string { return v.SharedHeaders }
+
+// __updateVariantSubscriptionURLInput is used internally by genqlient
+type __updateVariantSubscriptionURLInput struct {
+	ServiceId       string  `json:"serviceId"`
+	VariantName     string  `json:"variantName"`
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+}
+
+// GetServiceId returns __updateVariantSubscriptionURLInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantSubscriptionURLInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __updateVariantSubscriptionURLInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantSubscriptionURLInput) GetVariantName() string { return v.VariantName }
+
+// GetSubscriptionUrl returns __updateVariantSubscriptionURLInput.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *__updateVariantSubscriptionURLInput) GetSubscriptionUrl() *string { return v.SubscriptionUrl }
+
+// __updateVariantURLInput is used internally by genqlient
+type __updateVariantURLInput struct {
+	ServiceId   string  `json:"serviceId"`
+	VariantName string  `json:"variantName"`
+	Url         *string `json:"url"`
+}
+
+// GetServiceId returns __updateVariantURLInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__updateVariantURLInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __updateVariantURLInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__updateVariantURLInput) GetVariantName() string { return v.VariantName }
+
+// GetUrl returns __updateVariantURLInput.Url, and is useful for accessing the field via an interface.
+func (v *__updateVariantURLInput) GetUrl() *string { return v.Url }
+
+// __upsertContractVariantInput is used internally by genqlient
+type __upsertContractVariantInput struct {
+	ServiceId            string   `json:"serviceId"`
+	VariantName          string   `json:"variantName"`
+	SourceVariant        string   `json:"sourceVariant"`
+	Include              []string `json:"include"`
+	Exclude              []string `json:"exclude"`
+	HideUnreachableTypes bool     `json:"hideUnreachableTypes"`
+}
+
+// GetServiceId returns __upsertContractVariantInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__upsertContractVariantInput) GetServiceId() string { return v.ServiceId }
+
+// GetVariantName returns __upsertContractVariantInput.VariantName, and is useful for accessing the field via an interface.
+func (v *__upsertContractVariantInput) GetVariantName() string { return v.VariantName }
+
+// GetSourceVariant returns __upsertContractVariantInput.SourceVariant, and is useful for accessing the field via an interface.
+func (v *__upsertContractVariantInput) GetSourceVariant() string { return v.SourceVariant }
+
+// GetInclude returns __upsertContractVariantInput.Include, and is useful for accessing the field via an interface.
+func (v *__upsertContractVariantInput) GetInclude() []string { return v.Include }
+
+// GetExclude returns __upsertContractVariantInput.Exclude, and is useful for accessing the field via an interface.
+func (v *__upsertContractVariantInput) GetExclude() []string { return v.Exclude }
+
+// GetHideUnreachableTypes returns __upsertContractVariantInput.HideUnreachableTypes, and is useful for accessing the field via an interface.
+func (v *__upsertContractVariantInput) GetHideUnreachableTypes() bool { return v.HideUnreachableTypes }
+
+// __upsertPagerDutyChannelInput is used internally by genqlient
+type __upsertPagerDutyChannelInput struct {
+	ServiceId string                `json:"serviceId"`
+	Id        *string               `json:"id"`
+	Channel   PagerDutyChannelInput `json:"channel"`
+}
+
+// GetServiceId returns __upsertPagerDutyChannelInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__upsertPagerDutyChannelInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __upsertPagerDutyChannelInput.Id, and is useful for accessing the field via an interface.
+func (v *__upsertPagerDutyChannelInput) GetId() *string { return v.Id }
+
+// GetChannel returns __upsertPagerDutyChannelInput.Channel, and is useful for accessing the field via an interface.
+func (v *__upsertPagerDutyChannelInput) GetChannel() PagerDutyChannelInput { return v.Channel }
+
+// __upsertQueryTriggerInput is used internally by genqlient
+type __upsertQueryTriggerInput struct {
+	ServiceId string            `json:"serviceId"`
+	Id        *string           `json:"id"`
+	Trigger   QueryTriggerInput `json:"trigger"`
+}
+
+// GetServiceId returns __upsertQueryTriggerInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__upsertQueryTriggerInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __upsertQueryTriggerInput.Id, and is useful for accessing the field via an interface.
+func (v *__upsertQueryTriggerInput) GetId() *string { return v.Id }
+
+// GetTrigger returns __upsertQueryTriggerInput.Trigger, and is useful for accessing the field via an interface.
+func (v *__upsertQueryTriggerInput) GetTrigger() QueryTriggerInput { return v.Trigger }
+
+// __upsertRegistrySubscriptionInput is used internally by genqlient
+type __upsertRegistrySubscriptionInput struct {
+	ServiceId string                   `json:"serviceId"`
+	Id        *string                  `json:"id"`
+	ChannelId string                   `json:"channelId"`
+	Variant   string                   `json:"variant"`
+	Options   SubscriptionOptionsInput `json:"options"`
+}
+
+// GetServiceId returns __upsertRegistrySubscriptionInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__upsertRegistrySubscriptionInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __upsertRegistrySubscriptionInput.Id, and is useful for accessing the field via an interface.
+func (v *__upsertRegistrySubscriptionInput) GetId() *string { return v.Id }
+
+// GetChannelId returns __upsertRegistrySubscriptionInput.ChannelId, and is useful for accessing the field via an interface.
+func (v *__upsertRegistrySubscriptionInput) GetChannelId() string { return v.ChannelId }
+
+// GetVariant returns __upsertRegistrySubscriptionInput.Variant, and is useful for accessing the field via an interface.
+func (v *__upsertRegistrySubscriptionInput) GetVariant() string { return v.Variant }
+
+// GetOptions returns __upsertRegistrySubscriptionInput.Options, and is useful for accessing the field via an interface.
+func (v *__upsertRegistrySubscriptionInput) GetOptions() SubscriptionOptionsInput { return v.Options }
+
+// __upsertScheduledSummaryInput is used internally by genqlient
+type __upsertScheduledSummaryInput struct {
+	ServiceId string  `json:"serviceId"`
+	Id        *string `json:"id"`
+	ChannelId string  `json:"channelId"`
+	Variant   string  `json:"variant"`
+	Timezone  string  `json:"timezone"`
+	Enabled   bool    `json:"enabled"`
+}
+
+// GetServiceId returns __upsertScheduledSummaryInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__upsertScheduledSummaryInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __upsertScheduledSummaryInput.Id, and is useful for accessing the field via an interface.
+func (v *__upsertScheduledSummaryInput) GetId() *string { return v.Id }
+
+// GetChannelId returns __upsertScheduledSummaryInput.ChannelId, and is useful for accessing the field via an interface.
+func (v *__upsertScheduledSummaryInput) GetChannelId() string { return v.ChannelId }
+
+// GetVariant returns __upsertScheduledSummaryInput.Variant, and is useful for accessing the field via an interface.
+func (v *__upsertScheduledSummaryInput) GetVariant() string { return v.Variant }
+
+// GetTimezone returns __upsertScheduledSummaryInput.Timezone, and is useful for accessing the field via an interface.
+func (v *__upsertScheduledSummaryInput) GetTimezone() string { return v.Timezone }
+
+// GetEnabled returns __upsertScheduledSummaryInput.Enabled, and is useful for accessing the field via an interface.
+func (v *__upsertScheduledSummaryInput) GetEnabled() bool { return v.Enabled }
+
+// __upsertSlackChannelInput is used internally by genqlient
+type __upsertSlackChannelInput struct {
+	ServiceId string            `json:"serviceId"`
+	Id        *string           `json:"id"`
+	Channel   SlackChannelInput `json:"channel"`
+}
+
+// GetServiceId returns __upsertSlackChannelInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__upsertSlackChannelInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __upsertSlackChannelInput.Id, and is useful for accessing the field via an interface.
+func (v *__upsertSlackChannelInput) GetId() *string { return v.Id }
+
+// GetChannel returns __upsertSlackChannelInput.Channel, and is useful for accessing the field via an interface.
+func (v *__upsertSlackChannelInput) GetChannel() SlackChannelInput { return v.Channel }
+
+// __upsertWebhookChannelInput is used internally by genqlient
+type __upsertWebhookChannelInput struct {
+	ServiceId   string  `json:"serviceId"`
+	Id          *string `json:"id"`
+	Name        string  `json:"name"`
+	SecretToken *string `json:"secretToken"`
+	Url         string  `json:"url"`
+}
+
+// GetServiceId returns __upsertWebhookChannelInput.ServiceId, and is useful for accessing the field via an interface.
+func (v *__upsertWebhookChannelInput) GetServiceId() string { return v.ServiceId }
+
+// GetId returns __upsertWebhookChannelInput.Id, and is useful for accessing the field via an interface.
+func (v *__upsertWebhookChannelInput) GetId() *string { return v.Id }
+
+// GetName returns __upsertWebhookChannelInput.Name, and is useful for accessing the field via an interface.
+func (v *__upsertWebhookChannelInput) GetName() string { return v.Name }
+
+// GetSecretToken returns __upsertWebhookChannelInput.SecretToken, and is useful for accessing the field via an interface.
+func (v *__upsertWebhookChannelInput) GetSecretToken() *string { return v.SecretToken }
+
+// GetUrl returns __upsertWebhookChannelInput.Url, and is useful for accessing the field via an interface.
+func (v *__upsertWebhookChannelInput) GetUrl() string { return v.Url }
+
+// addVariantLinkResponse is returned by addVariantLink on success.
+type addVariantLinkResponse struct {
+	Service addVariantLinkServiceServiceMutation `json:"service"`
+}
+
+// GetService returns addVariantLinkResponse.Service, and is useful for accessing the field via an interface.
+func (v *addVariantLinkResponse) GetService() addVariantLinkServiceServiceMutation { return v.Service }
+
+// addVariantLinkServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type addVariantLinkServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant addVariantLinkServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns addVariantLinkServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutation) GetVariant() addVariantLinkServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// addVariantLinkServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type addVariantLinkServiceServiceMutationVariantGraphVariantMutation struct {
+	AddLinkToVariant addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant `json:"addLinkToVariant"`
+}
+
+// GetAddLinkToVariant returns addVariantLinkServiceServiceMutationVariantGraphVariantMutation.AddLinkToVariant, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutation) GetAddLinkToVariant() addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant {
+	return v.AddLinkToVariant
+}
+
+// addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetId() string {
+	return v.Variant.Id
+}
+
+// GetName returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetName() string {
+	return v.Variant.Name
+}
+
+// GetIsPublic returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetIsPublic() bool {
+	return v.Variant.IsPublic
+}
+
+// GetIsPubliclyListed returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetIsProtected() bool {
+	return v.Variant.IsProtected
+}
+
+// GetUrl returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetUrl() *string {
+	return v.Variant.Url
+}
+
+// GetSubscriptionUrl returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetSendCookies() *bool {
+	return v.Variant.SendCookies
+}
+
+// GetPreflightScript returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetReadme() VariantReadme {
+	return v.Variant.Readme
+}
+
+// GetLinks returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) GetGraphId() string {
+	return v.Variant.GraphId
+}
+
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshaladdVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *addVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant) __premarshalJSON() (*__premarshaladdVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant, error) {
+	var retval __premarshaladdVariantLinkServiceServiceMutationVariantGraphVariantMutationAddLinkToVariantGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// createKeyResponse is returned by createKey on success.
+type createKeyResponse struct {
+	Service createKeyServiceServiceMutation `json:"service"`
+}
+
+// GetService returns createKeyResponse.Service, and is useful for accessing the field via an interface.
+func (v *createKeyResponse) GetService() createKeyServiceServiceMutation { return v.Service }
+
+// createKeyServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type createKeyServiceServiceMutation struct {
+	// Generates a new graph API key for this graph with the specified permission level.
+	NewKey createKeyServiceServiceMutationNewKeyGraphApiKey `json:"newKey"`
+}
+
+// GetNewKey returns createKeyServiceServiceMutation.NewKey, and is useful for accessing the field via an interface.
+func (v *createKeyServiceServiceMutation) GetNewKey() createKeyServiceServiceMutationNewKeyGraphApiKey {
+	return v.NewKey
+}
+
+// createKeyServiceServiceMutationNewKeyGraphApiKey includes the requested fields of the GraphQL type GraphApiKey.
+// The GraphQL type's documentation follows.
+//
+// Represents a graph API key, which has permissions scoped to a
+// user role for a single Apollo graph.
+type createKeyServiceServiceMutationNewKeyGraphApiKey struct {
+	Key `json:"-"`
+}
+
+// GetId returns createKeyServiceServiceMutationNewKeyGraphApiKey.Id, and is useful for accessing the field via an interface.
+func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) GetId() string { return v.Key.Id }
+
+// GetKeyName returns createKeyServiceServiceMutationNewKeyGraphApiKey.KeyName, and is useful for accessing the field via an interface.
+func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) GetKeyName() string { return v.Key.KeyName }
+
+// GetRole returns createKeyServiceServiceMutationNewKeyGraphApiKey.Role, and is useful for accessing the field via an interface.
+func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) GetRole() string { return v.Key.Role }
+
+// GetToken returns createKeyServiceServiceMutationNewKeyGraphApiKey.Token, and is useful for accessing the field via an interface.
+func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) GetToken() string { return v.Key.Token }
+
+func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createKeyServiceServiceMutationNewKeyGraphApiKey
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createKeyServiceServiceMutationNewKeyGraphApiKey = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Key)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateKeyServiceServiceMutationNewKeyGraphApiKey struct {
+	Id string `json:"id"`
+
+	KeyName string `json:"keyName"`
+
+	Role string `json:"role"`
+
+	Token string `json:"token"`
+}
+
+func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createKeyServiceServiceMutationNewKeyGraphApiKey) __premarshalJSON() (*__premarshalcreateKeyServiceServiceMutationNewKeyGraphApiKey, error) {
+	var retval __premarshalcreateKeyServiceServiceMutationNewKeyGraphApiKey
+
+	retval.Id = v.Key.Id
+	retval.KeyName = v.Key.KeyName
+	retval.Role = v.Key.Role
+	retval.Token = v.Key.Token
+	return &retval, nil
+}
+
+// createOrganizationInvitationAccountAccountMutation includes the requested fields of the GraphQL type AccountMutation.
+type createOrganizationInvitationAccountAccountMutation struct {
+	// Send an invitation to join the account by E-mail
+	Invite *createOrganizationInvitationAccountAccountMutationInviteAccountInvitation `json:"invite"`
+}
+
+// GetInvite returns createOrganizationInvitationAccountAccountMutation.Invite, and is useful for accessing the field via an interface.
+func (v *createOrganizationInvitationAccountAccountMutation) GetInvite() *createOrganizationInvitationAccountAccountMutationInviteAccountInvitation {
+	return v.Invite
+}
+
+// createOrganizationInvitationAccountAccountMutationInviteAccountInvitation includes the requested fields of the GraphQL type AccountInvitation.
+type createOrganizationInvitationAccountAccountMutationInviteAccountInvitation struct {
+	OrganizationInvitation `json:"-"`
+}
+
+// GetId returns createOrganizationInvitationAccountAccountMutationInviteAccountInvitation.Id, and is useful for accessing the field via an interface.
+func (v *createOrganizationInvitationAccountAccountMutationInviteAccountInvitation) GetId() string {
+	return v.OrganizationInvitation.Id
+}
+
+// GetEmail returns createOrganizationInvitationAccountAccountMutationInviteAccountInvitation.Email, and is useful for accessing the field via an interface.
+func (v *createOrganizationInvitationAccountAccountMutationInviteAccountInvitation) GetEmail() string {
+	return v.OrganizationInvitation.Email
+}
+
+// GetRole returns createOrganizationInvitationAccountAccountMutationInviteAccountInvitation.Role, and is useful for accessing the field via an interface.
+func (v *createOrganizationInvitationAccountAccountMutationInviteAccountInvitation) GetRole() string {
+	return v.OrganizationInvitation.Role
+}
+
+// GetAcceptedAt returns createOrganizationInvitationAccountAccountMutationInviteAccountInvitation.AcceptedAt, and is useful for accessing the field via an interface.
+func (v *createOrganizationInvitationAccountAccountMutationInviteAccountInvitation) GetAcceptedAt() *time.Time {
+	return v.OrganizationInvitation.AcceptedAt
+}
+
+func (v *createOrganizationInvitationAccountAccountMutationInviteAccountInvitation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createOrganizationInvitationAccountAccountMutationInviteAccountInvitation
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createOrganizationInvitationAccountAccountMutationInviteAccountInvitation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationInvitation)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateOrganizationInvitationAccountAccountMutationInviteAccountInvitation struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Role string `json:"role"`
+
+	AcceptedAt *time.Time `json:"acceptedAt"`
+}
+
+func (v *createOrganizationInvitationAccountAccountMutationInviteAccountInvitation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createOrganizationInvitationAccountAccountMutationInviteAccountInvitation) __premarshalJSON() (*__premarshalcreateOrganizationInvitationAccountAccountMutationInviteAccountInvitation, error) {
+	var retval __premarshalcreateOrganizationInvitationAccountAccountMutationInviteAccountInvitation
+
+	retval.Id = v.OrganizationInvitation.Id
+	retval.Email = v.OrganizationInvitation.Email
+	retval.Role = v.OrganizationInvitation.Role
+	retval.AcceptedAt = v.OrganizationInvitation.AcceptedAt
+	return &retval, nil
+}
+
+// createOrganizationInvitationResponse is returned by createOrganizationInvitation on success.
+type createOrganizationInvitationResponse struct {
+	Account createOrganizationInvitationAccountAccountMutation `json:"account"`
+}
+
+// GetAccount returns createOrganizationInvitationResponse.Account, and is useful for accessing the field via an interface.
+func (v *createOrganizationInvitationResponse) GetAccount() createOrganizationInvitationAccountAccountMutation {
+	return v.Account
+}
+
+// createOrganizationInviteLinkAccountAccountMutation includes the requested fields of the GraphQL type AccountMutation.
+type createOrganizationInviteLinkAccountAccountMutation struct {
+	CreateStaticInvitation *createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink `json:"createStaticInvitation"`
+}
+
+// GetCreateStaticInvitation returns createOrganizationInviteLinkAccountAccountMutation.CreateStaticInvitation, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteLinkAccountAccountMutation) GetCreateStaticInvitation() *createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink {
+	return v.CreateStaticInvitation
+}
+
+// createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink includes the requested fields of the GraphQL type OrganizationInviteLink.
+// The GraphQL type's documentation follows.
+//
+// A reusable invite link for an organization.
+type createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink struct {
+	OrganizationInviteLink `json:"-"`
+}
+
+// GetJoinToken returns createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink.JoinToken, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink) GetJoinToken() string {
+	return v.OrganizationInviteLink.JoinToken
+}
+
+// GetRole returns createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink.Role, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink) GetRole() string {
+	return v.OrganizationInviteLink.Role
+}
+
+// GetCreatedAt returns createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink.CreatedAt, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink) GetCreatedAt() time.Time {
+	return v.OrganizationInviteLink.CreatedAt
+}
+
+func (v *createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationInviteLink)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink struct {
+	JoinToken string `json:"joinToken"`
+
+	Role string `json:"role"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink) __premarshalJSON() (*__premarshalcreateOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink, error) {
+	var retval __premarshalcreateOrganizationInviteLinkAccountAccountMutationCreateStaticInvitationOrganizationInviteLink
+
+	retval.JoinToken = v.OrganizationInviteLink.JoinToken
+	retval.Role = v.OrganizationInviteLink.Role
+	retval.CreatedAt = v.OrganizationInviteLink.CreatedAt
+	return &retval, nil
+}
+
+// createOrganizationInviteLinkResponse is returned by createOrganizationInviteLink on success.
+type createOrganizationInviteLinkResponse struct {
+	Account createOrganizationInviteLinkAccountAccountMutation `json:"account"`
+}
+
+// GetAccount returns createOrganizationInviteLinkResponse.Account, and is useful for accessing the field via an interface.
+func (v *createOrganizationInviteLinkResponse) GetAccount() createOrganizationInviteLinkAccountAccountMutation {
+	return v.Account
+}
+
+// createPersistedQueryListResponse is returned by createPersistedQueryList on success.
+type createPersistedQueryListResponse struct {
+	Service createPersistedQueryListServiceServiceMutation `json:"service"`
+}
+
+// GetService returns createPersistedQueryListResponse.Service, and is useful for accessing the field via an interface.
+func (v *createPersistedQueryListResponse) GetService() createPersistedQueryListServiceServiceMutation {
+	return v.Service
+}
+
+// createPersistedQueryListServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type createPersistedQueryListServiceServiceMutation struct {
+	CreatePersistedQueryList createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError `json:"-"`
+}
+
+// GetCreatePersistedQueryList returns createPersistedQueryListServiceServiceMutation.CreatePersistedQueryList, and is useful for accessing the field via an interface.
+func (v *createPersistedQueryListServiceServiceMutation) GetCreatePersistedQueryList() createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError {
+	return v.CreatePersistedQueryList
+}
+
+func (v *createPersistedQueryListServiceServiceMutation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createPersistedQueryListServiceServiceMutation
+		CreatePersistedQueryList json.RawMessage `json:"createPersistedQueryList"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createPersistedQueryListServiceServiceMutation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.CreatePersistedQueryList
+		src := firstPass.CreatePersistedQueryList
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalcreatePersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal createPersistedQueryListServiceServiceMutation.CreatePersistedQueryList: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalcreatePersistedQueryListServiceServiceMutation struct {
+	CreatePersistedQueryList json.RawMessage `json:"createPersistedQueryList"`
+}
+
+func (v *createPersistedQueryListServiceServiceMutation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createPersistedQueryListServiceServiceMutation) __premarshalJSON() (*__premarshalcreatePersistedQueryListServiceServiceMutation, error) {
+	var retval __premarshalcreatePersistedQueryListServiceServiceMutation
+
+	{
+
+		dst := &retval.CreatePersistedQueryList
+		src := v.CreatePersistedQueryList
+		var err error
+		*dst, err = __marshalcreatePersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal createPersistedQueryListServiceServiceMutation.CreatePersistedQueryList: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResult includes the requested fields of the GraphQL type CreatePersistedQueryListResult.
+type createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResult struct {
+	Typename           string                                                                                                                 `json:"__typename"`
+	PersistedQueryList createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList `json:"persistedQueryList"`
+}
+
+// GetTypename returns createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResult.Typename, and is useful for accessing the field via an interface.
+func (v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResult) GetTypename() string {
+	return v.Typename
+}
+
+// GetPersistedQueryList returns createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResult.PersistedQueryList, and is useful for accessing the field via an interface.
+func (v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResult) GetPersistedQueryList() createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList {
+	return v.PersistedQueryList
+}
+
+// createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError includes the requested fields of the GraphQL interface CreatePersistedQueryListResultOrError.
+//
+// createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError is implemented by the following types:
+// createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResult
+// createPersistedQueryListServiceServiceMutationCreatePersistedQueryListPermissionError
+type createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError interface {
+	implementsGraphQLInterfacecreatePersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResult) implementsGraphQLInterfacecreatePersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError() {
+}
+func (v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListPermissionError) implementsGraphQLInterfacecreatePersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError() {
+}
+
+func __unmarshalcreatePersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError(b []byte, v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CreatePersistedQueryListResult":
+		*v = new(createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResult)
+		return json.Unmarshal(b, *v)
+	case "PermissionError":
+		*v = new(createPersistedQueryListServiceServiceMutationCreatePersistedQueryListPermissionError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CreatePersistedQueryListResultOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalcreatePersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError(v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResult:
+		typename = "CreatePersistedQueryListResult"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResult
+		}{typename, v}
+		return json.Marshal(result)
+	case *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListPermissionError:
+		typename = "PermissionError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*createPersistedQueryListServiceServiceMutationCreatePersistedQueryListPermissionError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultOrError: "%T"`, v)
+	}
+}
+
+// createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList includes the requested fields of the GraphQL type PersistedQueryList.
+// The GraphQL type's documentation follows.
+//
+// TODO
+type createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList struct {
+	PersistedQueryList `json:"-"`
+}
+
+// GetId returns createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList.Id, and is useful for accessing the field via an interface.
+func (v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList) GetId() string {
+	return v.PersistedQueryList.Id
+}
+
+// GetName returns createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList.Name, and is useful for accessing the field via an interface.
+func (v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList) GetName() string {
+	return v.PersistedQueryList.Name
+}
+
+// GetDescription returns createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList.Description, and is useful for accessing the field via an interface.
+func (v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList) GetDescription() string {
+	return v.PersistedQueryList.Description
+}
+
+func (v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PersistedQueryList)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreatePersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+}
+
+func (v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList) __premarshalJSON() (*__premarshalcreatePersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList, error) {
+	var retval __premarshalcreatePersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResultPersistedQueryList
+
+	retval.Id = v.PersistedQueryList.Id
+	retval.Name = v.PersistedQueryList.Name
+	retval.Description = v.PersistedQueryList.Description
+	return &retval, nil
+}
+
+// createPersistedQueryListServiceServiceMutationCreatePersistedQueryListPermissionError includes the requested fields of the GraphQL type PermissionError.
+// The GraphQL type's documentation follows.
+//
+// An error that's returned when the current user doesn't have sufficient permissions to perform an action.
+type createPersistedQueryListServiceServiceMutationCreatePersistedQueryListPermissionError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns createPersistedQueryListServiceServiceMutationCreatePersistedQueryListPermissionError.Typename, and is useful for accessing the field via an interface.
+func (v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListPermissionError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns createPersistedQueryListServiceServiceMutationCreatePersistedQueryListPermissionError.Message, and is useful for accessing the field via an interface.
+func (v *createPersistedQueryListServiceServiceMutationCreatePersistedQueryListPermissionError) GetMessage() string {
+	return v.Message
+}
+
+// createServiceNewService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type createServiceNewService struct {
+	Service `json:"-"`
+}
+
+// GetId returns createServiceNewService.Id, and is useful for accessing the field via an interface.
+func (v *createServiceNewService) GetId() string { return v.Service.Id }
+
+// GetTitle returns createServiceNewService.Title, and is useful for accessing the field via an interface.
+func (v *createServiceNewService) GetTitle() string { return v.Service.Title }
+
+// GetOnboardingArchitecture returns createServiceNewService.OnboardingArchitecture, and is useful for accessing the field via an interface.
+func (v *createServiceNewService) GetOnboardingArchitecture() string {
+	return v.Service.OnboardingArchitecture
+}
+
+// GetAccountId returns createServiceNewService.AccountId, and is useful for accessing the field via an interface.
+func (v *createServiceNewService) GetAccountId() string { return v.Service.AccountId }
+
+// GetDescription returns createServiceNewService.Description, and is useful for accessing the field via an interface.
+func (v *createServiceNewService) GetDescription() string { return v.Service.Description }
+
+// GetHiddenFromUninvitedNonAdminAccountMembers returns createServiceNewService.HiddenFromUninvitedNonAdminAccountMembers, and is useful for accessing the field via an interface.
+func (v *createServiceNewService) GetHiddenFromUninvitedNonAdminAccountMembers() bool {
+	return v.Service.HiddenFromUninvitedNonAdminAccountMembers
+}
+
+// GetReadme returns createServiceNewService.Readme, and is useful for accessing the field via an interface.
+func (v *createServiceNewService) GetReadme() *ServiceReadme { return v.Service.Readme }
+
+func (v *createServiceNewService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*createServiceNewService
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.createServiceNewService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Service)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalcreateServiceNewService struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	OnboardingArchitecture string `json:"onboardingArchitecture"`
+
+	AccountId string `json:"accountId"`
+
+	Description string `json:"description"`
+
+	HiddenFromUninvitedNonAdminAccountMembers bool `json:"hiddenFromUninvitedNonAdminAccountMembers"`
+
+	Readme *ServiceReadme `json:"readme"`
+}
+
+func (v *createServiceNewService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *createServiceNewService) __premarshalJSON() (*__premarshalcreateServiceNewService, error) {
+	var retval __premarshalcreateServiceNewService
+
+	retval.Id = v.Service.Id
+	retval.Title = v.Service.Title
+	retval.OnboardingArchitecture = v.Service.OnboardingArchitecture
+	retval.AccountId = v.Service.AccountId
+	retval.Description = v.Service.Description
+	retval.HiddenFromUninvitedNonAdminAccountMembers = v.Service.HiddenFromUninvitedNonAdminAccountMembers
+	retval.Readme = v.Service.Readme
+	return &retval, nil
+}
+
+// createServiceResponse is returned by createService on success.
+type createServiceResponse struct {
+	NewService createServiceNewService `json:"newService"`
+}
+
+// GetNewService returns createServiceResponse.NewService, and is useful for accessing the field via an interface.
+func (v *createServiceResponse) GetNewService() createServiceNewService { return v.NewService }
+
+// createVariantResponse is returned by createVariant on success.
+type createVariantResponse struct {
+	Service createVariantServiceServiceMutation `json:"service"`
+}
+
+// GetService returns createVariantResponse.Service, and is useful for accessing the field via an interface.
+func (v *createVariantResponse) GetService() createVariantServiceServiceMutation { return v.Service }
+
+// createVariantServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type createVariantServiceServiceMutation struct {
+	// Publish a schema to this variant, either via a document or an introspection query result.
+	UploadSchema createVariantServiceServiceMutationUploadSchemaUploadSchemaMutationResponse `json:"uploadSchema"`
+}
+
+// GetUploadSchema returns createVariantServiceServiceMutation.UploadSchema, and is useful for accessing the field via an interface.
+func (v *createVariantServiceServiceMutation) GetUploadSchema() createVariantServiceServiceMutationUploadSchemaUploadSchemaMutationResponse {
+	return v.UploadSchema
+}
+
+// createVariantServiceServiceMutationUploadSchemaUploadSchemaMutationResponse includes the requested fields of the GraphQL type UploadSchemaMutationResponse.
+// The GraphQL type's documentation follows.
+//
+// Describes the result of publishing a schema to a graph variant.
+type createVariantServiceServiceMutationUploadSchemaUploadSchemaMutationResponse struct {
+	// Whether the schema publish operation succeeded (`true`) or encountered errors (`false`).
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns createVariantServiceServiceMutationUploadSchemaUploadSchemaMutationResponse.Success, and is useful for accessing the field via an interface.
+func (v *createVariantServiceServiceMutationUploadSchemaUploadSchemaMutationResponse) GetSuccess() bool {
+	return v.Success
+}
+
+// deleteChannelResponse is returned by deleteChannel on success.
+type deleteChannelResponse struct {
+	Service deleteChannelServiceServiceMutation `json:"service"`
+}
+
+// GetService returns deleteChannelResponse.Service, and is useful for accessing the field via an interface.
+func (v *deleteChannelResponse) GetService() deleteChannelServiceServiceMutation { return v.Service }
+
+// deleteChannelServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type deleteChannelServiceServiceMutation struct {
+	// Delete an existing channel
+	DeleteChannel bool `json:"deleteChannel"`
+}
+
+// GetDeleteChannel returns deleteChannelServiceServiceMutation.DeleteChannel, and is useful for accessing the field via an interface.
+func (v *deleteChannelServiceServiceMutation) GetDeleteChannel() bool { return v.DeleteChannel }
+
+// deleteKeyResponse is returned by deleteKey on success.
+type deleteKeyResponse struct {
+	Service deleteKeyServiceServiceMutation `json:"service"`
+}
+
+// GetService returns deleteKeyResponse.Service, and is useful for accessing the field via an interface.
+func (v *deleteKeyResponse) GetService() deleteKeyServiceServiceMutation { return v.Service }
+
+// deleteKeyServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type deleteKeyServiceServiceMutation struct {
+	// Deletes the existing graph API key with the provided ID, if any.
+	RemoveKey interface{} `json:"removeKey"`
+}
+
+// GetRemoveKey returns deleteKeyServiceServiceMutation.RemoveKey, and is useful for accessing the field via an interface.
+func (v *deleteKeyServiceServiceMutation) GetRemoveKey() interface{} { return v.RemoveKey }
+
+// deleteOrganizationInvitationAccountAccountMutation includes the requested fields of the GraphQL type AccountMutation.
+type deleteOrganizationInvitationAccountAccountMutation struct {
+	// Delete an invitation
+	RemoveInvitation interface{} `json:"removeInvitation"`
+}
+
+// GetRemoveInvitation returns deleteOrganizationInvitationAccountAccountMutation.RemoveInvitation, and is useful for accessing the field via an interface.
+func (v *deleteOrganizationInvitationAccountAccountMutation) GetRemoveInvitation() interface{} {
+	return v.RemoveInvitation
+}
+
+// deleteOrganizationInvitationResponse is returned by deleteOrganizationInvitation on success.
+type deleteOrganizationInvitationResponse struct {
+	Account deleteOrganizationInvitationAccountAccountMutation `json:"account"`
+}
+
+// GetAccount returns deleteOrganizationInvitationResponse.Account, and is useful for accessing the field via an interface.
+func (v *deleteOrganizationInvitationResponse) GetAccount() deleteOrganizationInvitationAccountAccountMutation {
+	return v.Account
+}
+
+// deleteOrganizationInviteLinkAccountAccountMutation includes the requested fields of the GraphQL type AccountMutation.
+type deleteOrganizationInviteLinkAccountAccountMutation struct {
+	RevokeStaticInvitation *deleteOrganizationInviteLinkAccountAccountMutationRevokeStaticInvitationOrganizationInviteLink `json:"revokeStaticInvitation"`
+}
+
+// GetRevokeStaticInvitation returns deleteOrganizationInviteLinkAccountAccountMutation.RevokeStaticInvitation, and is useful for accessing the field via an interface.
+func (v *deleteOrganizationInviteLinkAccountAccountMutation) GetRevokeStaticInvitation() *deleteOrganizationInviteLinkAccountAccountMutationRevokeStaticInvitationOrganizationInviteLink {
+	return v.RevokeStaticInvitation
+}
+
+// deleteOrganizationInviteLinkAccountAccountMutationRevokeStaticInvitationOrganizationInviteLink includes the requested fields of the GraphQL type OrganizationInviteLink.
+// The GraphQL type's documentation follows.
+//
+// A reusable invite link for an organization.
+type deleteOrganizationInviteLinkAccountAccountMutationRevokeStaticInvitationOrganizationInviteLink struct {
+	// A joinToken that can be passed to Mutation.joinAccount to join the organization.
+	JoinToken string `json:"joinToken"`
+}
+
+// GetJoinToken returns deleteOrganizationInviteLinkAccountAccountMutationRevokeStaticInvitationOrganizationInviteLink.JoinToken, and is useful for accessing the field via an interface.
+func (v *deleteOrganizationInviteLinkAccountAccountMutationRevokeStaticInvitationOrganizationInviteLink) GetJoinToken() string {
+	return v.JoinToken
+}
+
+// deleteOrganizationInviteLinkResponse is returned by deleteOrganizationInviteLink on success.
+type deleteOrganizationInviteLinkResponse struct {
+	Account deleteOrganizationInviteLinkAccountAccountMutation `json:"account"`
+}
+
+// GetAccount returns deleteOrganizationInviteLinkResponse.Account, and is useful for accessing the field via an interface.
+func (v *deleteOrganizationInviteLinkResponse) GetAccount() deleteOrganizationInviteLinkAccountAccountMutation {
+	return v.Account
+}
+
+// deleteOrganizationMemberAccountAccountMutation includes the requested fields of the GraphQL type AccountMutation.
+type deleteOrganizationMemberAccountAccountMutation struct {
+	// Remove a member of the account
+	RemoveMember *deleteOrganizationMemberAccountAccountMutationRemoveMemberAccount `json:"removeMember"`
+}
+
+// GetRemoveMember returns deleteOrganizationMemberAccountAccountMutation.RemoveMember, and is useful for accessing the field via an interface.
+func (v *deleteOrganizationMemberAccountAccountMutation) GetRemoveMember() *deleteOrganizationMemberAccountAccountMutationRemoveMemberAccount {
+	return v.RemoveMember
+}
+
+// deleteOrganizationMemberAccountAccountMutationRemoveMemberAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// An organization in Apollo Studio. Can have multiple members and graphs.
+type deleteOrganizationMemberAccountAccountMutationRemoveMemberAccount struct {
+	// Globally unique identifier, which isn't guaranteed stable (can be changed by administrators).
+	Id string `json:"id"`
+}
+
+// GetId returns deleteOrganizationMemberAccountAccountMutationRemoveMemberAccount.Id, and is useful for accessing the field via an interface.
+func (v *deleteOrganizationMemberAccountAccountMutationRemoveMemberAccount) GetId() string {
+	return v.Id
+}
+
+// deleteOrganizationMemberResponse is returned by deleteOrganizationMember on success.
+type deleteOrganizationMemberResponse struct {
+	Account deleteOrganizationMemberAccountAccountMutation `json:"account"`
+}
+
+// GetAccount returns deleteOrganizationMemberResponse.Account, and is useful for accessing the field via an interface.
+func (v *deleteOrganizationMemberResponse) GetAccount() deleteOrganizationMemberAccountAccountMutation {
+	return v.Account
+}
+
+// deletePersistedQueryListResponse is returned by deletePersistedQueryList on success.
+type deletePersistedQueryListResponse struct {
+	Service deletePersistedQueryListServiceServiceMutation `json:"service"`
+}
+
+// GetService returns deletePersistedQueryListResponse.Service, and is useful for accessing the field via an interface.
+func (v *deletePersistedQueryListResponse) GetService() deletePersistedQueryListServiceServiceMutation {
+	return v.Service
+}
+
+// deletePersistedQueryListServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type deletePersistedQueryListServiceServiceMutation struct {
+	// Provides access to mutation fields for modifying a Persisted Query List with the provided ID.
+	PersistedQueryList deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation `json:"persistedQueryList"`
+}
+
+// GetPersistedQueryList returns deletePersistedQueryListServiceServiceMutation.PersistedQueryList, and is useful for accessing the field via an interface.
+func (v *deletePersistedQueryListServiceServiceMutation) GetPersistedQueryList() deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation {
+	return v.PersistedQueryList
+}
+
+// deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation includes the requested fields of the GraphQL type PersistedQueryListMutation.
+type deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation struct {
+	Delete deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError `json:"-"`
+}
+
+// GetDelete returns deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation.Delete, and is useful for accessing the field via an interface.
+func (v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation) GetDelete() deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError {
+	return v.Delete
+}
+
+func (v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation
+		Delete json.RawMessage `json:"delete"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Delete
+		src := firstPass.Delete
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshaldeletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation.Delete: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshaldeletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation struct {
+	Delete json.RawMessage `json:"delete"`
+}
+
+func (v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation) __premarshalJSON() (*__premarshaldeletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation, error) {
+	var retval __premarshaldeletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation
+
+	{
+
+		dst := &retval.Delete
+		src := v.Delete
+		var err error
+		*dst, err = __marshaldeletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation.Delete: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteCannotDeleteLinkedPersistedQueryListError includes the requested fields of the GraphQL type CannotDeleteLinkedPersistedQueryListError.
+type deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteCannotDeleteLinkedPersistedQueryListError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteCannotDeleteLinkedPersistedQueryListError.Typename, and is useful for accessing the field via an interface.
+func (v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteCannotDeleteLinkedPersistedQueryListError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteCannotDeleteLinkedPersistedQueryListError.Message, and is useful for accessing the field via an interface.
+func (v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteCannotDeleteLinkedPersistedQueryListError) GetMessage() string {
+	return v.Message
+}
+
+// deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResult includes the requested fields of the GraphQL type DeletePersistedQueryListResult.
+type deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResult struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResult.Typename, and is useful for accessing the field via an interface.
+func (v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResult) GetTypename() string {
+	return v.Typename
+}
+
+// deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError includes the requested fields of the GraphQL interface DeletePersistedQueryListResultOrError.
+//
+// deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError is implemented by the following types:
+// deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteCannotDeleteLinkedPersistedQueryListError
+// deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResult
+// deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeletePermissionError
+type deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError interface {
+	implementsGraphQLInterfacedeletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteCannotDeleteLinkedPersistedQueryListError) implementsGraphQLInterfacedeletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError() {
+}
+func (v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResult) implementsGraphQLInterfacedeletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError() {
+}
+func (v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeletePermissionError) implementsGraphQLInterfacedeletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError() {
+}
+
+func __unmarshaldeletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError(b []byte, v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CannotDeleteLinkedPersistedQueryListError":
+		*v = new(deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteCannotDeleteLinkedPersistedQueryListError)
+		return json.Unmarshal(b, *v)
+	case "DeletePersistedQueryListResult":
+		*v = new(deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResult)
+		return json.Unmarshal(b, *v)
+	case "PermissionError":
+		*v = new(deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeletePermissionError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing DeletePersistedQueryListResultOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshaldeletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError(v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteCannotDeleteLinkedPersistedQueryListError:
+		typename = "CannotDeleteLinkedPersistedQueryListError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteCannotDeleteLinkedPersistedQueryListError
+		}{typename, v}
+		return json.Marshal(result)
+	case *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResult:
+		typename = "DeletePersistedQueryListResult"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResult
+		}{typename, v}
+		return json.Marshal(result)
+	case *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeletePermissionError:
+		typename = "PermissionError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeletePermissionError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeleteDeletePersistedQueryListResultOrError: "%T"`, v)
+	}
+}
+
+// deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeletePermissionError includes the requested fields of the GraphQL type PermissionError.
+// The GraphQL type's documentation follows.
+//
+// An error that's returned when the current user doesn't have sufficient permissions to perform an action.
+type deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeletePermissionError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeletePermissionError.Typename, and is useful for accessing the field via an interface.
+func (v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeletePermissionError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeletePermissionError.Message, and is useful for accessing the field via an interface.
+func (v *deletePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationDeletePermissionError) GetMessage() string {
+	return v.Message
+}
+
+// deleteQueryTriggerResponse is returned by deleteQueryTrigger on success.
+type deleteQueryTriggerResponse struct {
+	Service deleteQueryTriggerServiceServiceMutation `json:"service"`
+}
+
+// GetService returns deleteQueryTriggerResponse.Service, and is useful for accessing the field via an interface.
+func (v *deleteQueryTriggerResponse) GetService() deleteQueryTriggerServiceServiceMutation {
+	return v.Service
+}
+
+// deleteQueryTriggerServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type deleteQueryTriggerServiceServiceMutation struct {
+	// Delete an existing query trigger
+	DeleteQueryTrigger bool `json:"deleteQueryTrigger"`
+}
+
+// GetDeleteQueryTrigger returns deleteQueryTriggerServiceServiceMutation.DeleteQueryTrigger, and is useful for accessing the field via an interface.
+func (v *deleteQueryTriggerServiceServiceMutation) GetDeleteQueryTrigger() bool {
+	return v.DeleteQueryTrigger
+}
+
+// deleteRegistrySubscriptionResponse is returned by deleteRegistrySubscription on success.
+type deleteRegistrySubscriptionResponse struct {
+	Service deleteRegistrySubscriptionServiceServiceMutation `json:"service"`
+}
+
+// GetService returns deleteRegistrySubscriptionResponse.Service, and is useful for accessing the field via an interface.
+func (v *deleteRegistrySubscriptionResponse) GetService() deleteRegistrySubscriptionServiceServiceMutation {
+	return v.Service
+}
+
+// deleteRegistrySubscriptionServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type deleteRegistrySubscriptionServiceServiceMutation struct {
+	// Deletes this service's current subscriptions specific to the ID, returns true if it existed
+	DeleteRegistrySubscription bool `json:"deleteRegistrySubscription"`
+}
+
+// GetDeleteRegistrySubscription returns deleteRegistrySubscriptionServiceServiceMutation.DeleteRegistrySubscription, and is useful for accessing the field via an interface.
+func (v *deleteRegistrySubscriptionServiceServiceMutation) GetDeleteRegistrySubscription() bool {
+	return v.DeleteRegistrySubscription
+}
+
+// deleteScheduledSummaryResponse is returned by deleteScheduledSummary on success.
+type deleteScheduledSummaryResponse struct {
+	Service deleteScheduledSummaryServiceServiceMutation `json:"service"`
+}
+
+// GetService returns deleteScheduledSummaryResponse.Service, and is useful for accessing the field via an interface.
+func (v *deleteScheduledSummaryResponse) GetService() deleteScheduledSummaryServiceServiceMutation {
+	return v.Service
+}
+
+// deleteScheduledSummaryServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type deleteScheduledSummaryServiceServiceMutation struct {
+	DeleteScheduledSummary bool `json:"deleteScheduledSummary"`
+}
+
+// GetDeleteScheduledSummary returns deleteScheduledSummaryServiceServiceMutation.DeleteScheduledSummary, and is useful for accessing the field via an interface.
+func (v *deleteScheduledSummaryServiceServiceMutation) GetDeleteScheduledSummary() bool {
+	return v.DeleteScheduledSummary
+}
+
+// deleteServiceResponse is returned by deleteService on success.
+type deleteServiceResponse struct {
+	Service deleteServiceServiceServiceMutation `json:"service"`
+}
+
+// GetService returns deleteServiceResponse.Service, and is useful for accessing the field via an interface.
+func (v *deleteServiceResponse) GetService() deleteServiceServiceServiceMutation { return v.Service }
+
+// deleteServiceServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type deleteServiceServiceServiceMutation struct {
+	// Soft delete a graph. Data associated with the graph is not permanently deleted; Apollo support can undo.
+	Delete interface{} `json:"delete"`
+}
+
+// GetDelete returns deleteServiceServiceServiceMutation.Delete, and is useful for accessing the field via an interface.
+func (v *deleteServiceServiceServiceMutation) GetDelete() interface{} { return v.Delete }
+
+// deleteSubgraphResponse is returned by deleteSubgraph on success.
+type deleteSubgraphResponse struct {
+	Service deleteSubgraphServiceServiceMutation `json:"service"`
+}
+
+// GetService returns deleteSubgraphResponse.Service, and is useful for accessing the field via an interface.
+func (v *deleteSubgraphResponse) GetService() deleteSubgraphServiceServiceMutation { return v.Service }
+
+// deleteSubgraphServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type deleteSubgraphServiceServiceMutation struct {
+	// Removes a subgraph. If composition is successful, this will update running routers.
+	RemoveImplementingServiceAndTriggerComposition deleteSubgraphServiceServiceMutationRemoveImplementingServiceAndTriggerCompositionCompositionAndRemoveResult `json:"removeImplementingServiceAndTriggerComposition"`
+}
+
+// GetRemoveImplementingServiceAndTriggerComposition returns deleteSubgraphServiceServiceMutation.RemoveImplementingServiceAndTriggerComposition, and is useful for accessing the field via an interface.
+func (v *deleteSubgraphServiceServiceMutation) GetRemoveImplementingServiceAndTriggerComposition() deleteSubgraphServiceServiceMutationRemoveImplementingServiceAndTriggerCompositionCompositionAndRemoveResult {
+	return v.RemoveImplementingServiceAndTriggerComposition
+}
+
+// deleteSubgraphServiceServiceMutationRemoveImplementingServiceAndTriggerCompositionCompositionAndRemoveResult includes the requested fields of the GraphQL type CompositionAndRemoveResult.
+// The GraphQL type's documentation follows.
+//
+// The result of supergraph composition that Studio performed in response to an attempted deletion of a subgraph.
+type deleteSubgraphServiceServiceMutationRemoveImplementingServiceAndTriggerCompositionCompositionAndRemoveResult struct {
+	// Whether the removed implementing service existed.
+	DidExist bool `json:"didExist"`
+}
+
+// GetDidExist returns deleteSubgraphServiceServiceMutationRemoveImplementingServiceAndTriggerCompositionCompositionAndRemoveResult.DidExist, and is useful for accessing the field via an interface.
+func (v *deleteSubgraphServiceServiceMutationRemoveImplementingServiceAndTriggerCompositionCompositionAndRemoveResult) GetDidExist() bool {
+	return v.DidExist
+}
+
+// deleteVariantResponse is returned by deleteVariant on success.
+type deleteVariantResponse struct {
+	Service deleteVariantServiceServiceMutation `json:"service"`
+}
+
+// GetService returns deleteVariantResponse.Service, and is useful for accessing the field via an interface.
+func (v *deleteVariantResponse) GetService() deleteVariantServiceServiceMutation { return v.Service }
+
+// deleteVariantServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type deleteVariantServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant deleteVariantServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns deleteVariantServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *deleteVariantServiceServiceMutation) GetVariant() deleteVariantServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// deleteVariantServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type deleteVariantServiceServiceMutationVariantGraphVariantMutation struct {
+	// Delete the variant.
+	Delete deleteVariantServiceServiceMutationVariantGraphVariantMutationDeleteDeleteSchemaTagResult `json:"delete"`
+}
+
+// GetDelete returns deleteVariantServiceServiceMutationVariantGraphVariantMutation.Delete, and is useful for accessing the field via an interface.
+func (v *deleteVariantServiceServiceMutationVariantGraphVariantMutation) GetDelete() deleteVariantServiceServiceMutationVariantGraphVariantMutationDeleteDeleteSchemaTagResult {
+	return v.Delete
+}
+
+// deleteVariantServiceServiceMutationVariantGraphVariantMutationDeleteDeleteSchemaTagResult includes the requested fields of the GraphQL type DeleteSchemaTagResult.
+// The GraphQL type's documentation follows.
+//
+// The result of attempting to delete a graph variant.
+type deleteVariantServiceServiceMutationVariantGraphVariantMutationDeleteDeleteSchemaTagResult struct {
+	// Whether the variant was deleted or not.
+	Deleted bool `json:"deleted"`
+}
+
+// GetDeleted returns deleteVariantServiceServiceMutationVariantGraphVariantMutationDeleteDeleteSchemaTagResult.Deleted, and is useful for accessing the field via an interface.
+func (v *deleteVariantServiceServiceMutationVariantGraphVariantMutationDeleteDeleteSchemaTagResult) GetDeleted() bool {
+	return v.Deleted
+}
+
+// getCheckConfigurationResponse is returned by getCheckConfiguration on success.
+type getCheckConfigurationResponse struct {
+	// Service by ID
+	Service *getCheckConfigurationService `json:"service"`
+}
+
+// GetService returns getCheckConfigurationResponse.Service, and is useful for accessing the field via an interface.
+func (v *getCheckConfigurationResponse) GetService() *getCheckConfigurationService { return v.Service }
+
+// getCheckConfigurationService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getCheckConfigurationService struct {
+	// Get check configuration for this graph.
+	CheckConfiguration *getCheckConfigurationServiceCheckConfiguration `json:"checkConfiguration"`
+}
+
+// GetCheckConfiguration returns getCheckConfigurationService.CheckConfiguration, and is useful for accessing the field via an interface.
+func (v *getCheckConfigurationService) GetCheckConfiguration() *getCheckConfigurationServiceCheckConfiguration {
+	return v.CheckConfiguration
+}
+
+// getCheckConfigurationServiceCheckConfiguration includes the requested fields of the GraphQL type CheckConfiguration.
+// The GraphQL type's documentation follows.
+//
+// Graph-level configuration of checks.
+type getCheckConfigurationServiceCheckConfiguration struct {
+	CheckConfiguration `json:"-"`
+}
+
+// GetTimeRangeSeconds returns getCheckConfigurationServiceCheckConfiguration.TimeRangeSeconds, and is useful for accessing the field via an interface.
+func (v *getCheckConfigurationServiceCheckConfiguration) GetTimeRangeSeconds() int64 {
+	return v.CheckConfiguration.TimeRangeSeconds
+}
+
+// GetOperationCountThreshold returns getCheckConfigurationServiceCheckConfiguration.OperationCountThreshold, and is useful for accessing the field via an interface.
+func (v *getCheckConfigurationServiceCheckConfiguration) GetOperationCountThreshold() int {
+	return v.CheckConfiguration.OperationCountThreshold
+}
+
+// GetOperationCountThresholdPercentage returns getCheckConfigurationServiceCheckConfiguration.OperationCountThresholdPercentage, and is useful for accessing the field via an interface.
+func (v *getCheckConfigurationServiceCheckConfiguration) GetOperationCountThresholdPercentage() float64 {
+	return v.CheckConfiguration.OperationCountThresholdPercentage
+}
+
+// GetIncludeBaseVariant returns getCheckConfigurationServiceCheckConfiguration.IncludeBaseVariant, and is useful for accessing the field via an interface.
+func (v *getCheckConfigurationServiceCheckConfiguration) GetIncludeBaseVariant() bool {
+	return v.CheckConfiguration.IncludeBaseVariant
+}
+
+// GetIncludedVariants returns getCheckConfigurationServiceCheckConfiguration.IncludedVariants, and is useful for accessing the field via an interface.
+func (v *getCheckConfigurationServiceCheckConfiguration) GetIncludedVariants() []string {
+	return v.CheckConfiguration.IncludedVariants
+}
+
+// GetExcludedClients returns getCheckConfigurationServiceCheckConfiguration.ExcludedClients, and is useful for accessing the field via an interface.
+func (v *getCheckConfigurationServiceCheckConfiguration) GetExcludedClients() []CheckConfigurationExcludedClientsClientFilter {
+	return v.CheckConfiguration.ExcludedClients
+}
+
+// GetExcludedOperations returns getCheckConfigurationServiceCheckConfiguration.ExcludedOperations, and is useful for accessing the field via an interface.
+func (v *getCheckConfigurationServiceCheckConfiguration) GetExcludedOperations() []CheckConfigurationExcludedOperationsExcludedOperation {
+	return v.CheckConfiguration.ExcludedOperations
+}
+
+// GetDowngradeStaticChecks returns getCheckConfigurationServiceCheckConfiguration.DowngradeStaticChecks, and is useful for accessing the field via an interface.
+func (v *getCheckConfigurationServiceCheckConfiguration) GetDowngradeStaticChecks() bool {
+	return v.CheckConfiguration.DowngradeStaticChecks
+}
+
+// GetDowngradeDefaultValueChange returns getCheckConfigurationServiceCheckConfiguration.DowngradeDefaultValueChange, and is useful for accessing the field via an interface.
+func (v *getCheckConfigurationServiceCheckConfiguration) GetDowngradeDefaultValueChange() bool {
+	return v.CheckConfiguration.DowngradeDefaultValueChange
+}
+
+func (v *getCheckConfigurationServiceCheckConfiguration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getCheckConfigurationServiceCheckConfiguration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getCheckConfigurationServiceCheckConfiguration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CheckConfiguration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetCheckConfigurationServiceCheckConfiguration struct {
+	TimeRangeSeconds int64 `json:"timeRangeSeconds"`
+
+	OperationCountThreshold int `json:"operationCountThreshold"`
+
+	OperationCountThresholdPercentage float64 `json:"operationCountThresholdPercentage"`
+
+	IncludeBaseVariant bool `json:"includeBaseVariant"`
+
+	IncludedVariants []string `json:"includedVariants"`
+
+	ExcludedClients []CheckConfigurationExcludedClientsClientFilter `json:"excludedClients"`
+
+	ExcludedOperations []CheckConfigurationExcludedOperationsExcludedOperation `json:"excludedOperations"`
+
+	DowngradeStaticChecks bool `json:"downgradeStaticChecks"`
+
+	DowngradeDefaultValueChange bool `json:"downgradeDefaultValueChange"`
+}
+
+func (v *getCheckConfigurationServiceCheckConfiguration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getCheckConfigurationServiceCheckConfiguration) __premarshalJSON() (*__premarshalgetCheckConfigurationServiceCheckConfiguration, error) {
+	var retval __premarshalgetCheckConfigurationServiceCheckConfiguration
+
+	retval.TimeRangeSeconds = v.CheckConfiguration.TimeRangeSeconds
+	retval.OperationCountThreshold = v.CheckConfiguration.OperationCountThreshold
+	retval.OperationCountThresholdPercentage = v.CheckConfiguration.OperationCountThresholdPercentage
+	retval.IncludeBaseVariant = v.CheckConfiguration.IncludeBaseVariant
+	retval.IncludedVariants = v.CheckConfiguration.IncludedVariants
+	retval.ExcludedClients = v.CheckConfiguration.ExcludedClients
+	retval.ExcludedOperations = v.CheckConfiguration.ExcludedOperations
+	retval.DowngradeStaticChecks = v.CheckConfiguration.DowngradeStaticChecks
+	retval.DowngradeDefaultValueChange = v.CheckConfiguration.DowngradeDefaultValueChange
+	return &retval, nil
+}
+
+// getContractVariantResponse is returned by getContractVariant on success.
+type getContractVariantResponse struct {
+	// Service by ID
+	Service *getContractVariantService `json:"service"`
+}
+
+// GetService returns getContractVariantResponse.Service, and is useful for accessing the field via an interface.
+func (v *getContractVariantResponse) GetService() *getContractVariantService { return v.Service }
+
+// getContractVariantService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getContractVariantService struct {
+	// Provides details of the graph variant with the provided `name`, if a variant
+	// with that name exists for this graph. Otherwise, returns null.
+	//
+	// For a list of _all_ variants associated with a graph, use `Graph.variants` instead.
+	Variant *getContractVariantServiceVariantGraphVariant `json:"variant"`
+}
+
+// GetVariant returns getContractVariantService.Variant, and is useful for accessing the field via an interface.
+func (v *getContractVariantService) GetVariant() *getContractVariantServiceVariantGraphVariant {
+	return v.Variant
+}
+
+// getContractVariantServiceVariantGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type getContractVariantServiceVariantGraphVariant struct {
+	ContractVariant `json:"-"`
+}
+
+// GetId returns getContractVariantServiceVariantGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *getContractVariantServiceVariantGraphVariant) GetId() string { return v.ContractVariant.Id }
+
+// GetName returns getContractVariantServiceVariantGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *getContractVariantServiceVariantGraphVariant) GetName() string {
+	return v.ContractVariant.Name
+}
+
+// GetGraphId returns getContractVariantServiceVariantGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *getContractVariantServiceVariantGraphVariant) GetGraphId() string {
+	return v.ContractVariant.GraphId
+}
+
+// GetSourceVariant returns getContractVariantServiceVariantGraphVariant.SourceVariant, and is useful for accessing the field via an interface.
+func (v *getContractVariantServiceVariantGraphVariant) GetSourceVariant() *ContractVariantSourceVariantGraphVariant {
+	return v.ContractVariant.SourceVariant
+}
+
+// GetContractFilterConfig returns getContractVariantServiceVariantGraphVariant.ContractFilterConfig, and is useful for accessing the field via an interface.
+func (v *getContractVariantServiceVariantGraphVariant) GetContractFilterConfig() *ContractVariantContractFilterConfig {
+	return v.ContractVariant.ContractFilterConfig
+}
+
+// GetLatestLaunch returns getContractVariantServiceVariantGraphVariant.LatestLaunch, and is useful for accessing the field via an interface.
+func (v *getContractVariantServiceVariantGraphVariant) GetLatestLaunch() *ContractVariantLatestLaunch {
+	return v.ContractVariant.LatestLaunch
+}
+
+func (v *getContractVariantServiceVariantGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getContractVariantServiceVariantGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getContractVariantServiceVariantGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ContractVariant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetContractVariantServiceVariantGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	GraphId string `json:"graphId"`
+
+	SourceVariant *ContractVariantSourceVariantGraphVariant `json:"sourceVariant"`
+
+	ContractFilterConfig *ContractVariantContractFilterConfig `json:"contractFilterConfig"`
+
+	LatestLaunch *ContractVariantLatestLaunch `json:"latestLaunch"`
+}
+
+func (v *getContractVariantServiceVariantGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getContractVariantServiceVariantGraphVariant) __premarshalJSON() (*__premarshalgetContractVariantServiceVariantGraphVariant, error) {
+	var retval __premarshalgetContractVariantServiceVariantGraphVariant
+
+	retval.Id = v.ContractVariant.Id
+	retval.Name = v.ContractVariant.Name
+	retval.GraphId = v.ContractVariant.GraphId
+	retval.SourceVariant = v.ContractVariant.SourceVariant
+	retval.ContractFilterConfig = v.ContractVariant.ContractFilterConfig
+	retval.LatestLaunch = v.ContractVariant.LatestLaunch
+	return &retval, nil
+}
+
+// getGraphUserPermissionsResponse is returned by getGraphUserPermissions on success.
+type getGraphUserPermissionsResponse struct {
+	// Service by ID
+	Service *getGraphUserPermissionsService `json:"service"`
+}
+
+// GetService returns getGraphUserPermissionsResponse.Service, and is useful for accessing the field via an interface.
+func (v *getGraphUserPermissionsResponse) GetService() *getGraphUserPermissionsService {
+	return v.Service
+}
+
+// getGraphUserPermissionsService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getGraphUserPermissionsService struct {
+	// The list of members that can access this graph, accounting for graph role overrides
+	RoleOverrides []getGraphUserPermissionsServiceRoleOverridesRoleOverride `json:"roleOverrides"`
+}
+
+// GetRoleOverrides returns getGraphUserPermissionsService.RoleOverrides, and is useful for accessing the field via an interface.
+func (v *getGraphUserPermissionsService) GetRoleOverrides() []getGraphUserPermissionsServiceRoleOverridesRoleOverride {
+	return v.RoleOverrides
+}
+
+// getGraphUserPermissionsServiceRoleOverridesRoleOverride includes the requested fields of the GraphQL type RoleOverride.
+type getGraphUserPermissionsServiceRoleOverridesRoleOverride struct {
+	GraphUserPermission `json:"-"`
+}
+
+// GetUser returns getGraphUserPermissionsServiceRoleOverridesRoleOverride.User, and is useful for accessing the field via an interface.
+func (v *getGraphUserPermissionsServiceRoleOverridesRoleOverride) GetUser() GraphUserPermissionUser {
+	return v.GraphUserPermission.User
+}
+
+// GetRole returns getGraphUserPermissionsServiceRoleOverridesRoleOverride.Role, and is useful for accessing the field via an interface.
+func (v *getGraphUserPermissionsServiceRoleOverridesRoleOverride) GetRole() string {
+	return v.GraphUserPermission.Role
+}
+
+func (v *getGraphUserPermissionsServiceRoleOverridesRoleOverride) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getGraphUserPermissionsServiceRoleOverridesRoleOverride
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getGraphUserPermissionsServiceRoleOverridesRoleOverride = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.GraphUserPermission)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetGraphUserPermissionsServiceRoleOverridesRoleOverride struct {
+	User GraphUserPermissionUser `json:"user"`
+
+	Role string `json:"role"`
+}
+
+func (v *getGraphUserPermissionsServiceRoleOverridesRoleOverride) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getGraphUserPermissionsServiceRoleOverridesRoleOverride) __premarshalJSON() (*__premarshalgetGraphUserPermissionsServiceRoleOverridesRoleOverride, error) {
+	var retval __premarshalgetGraphUserPermissionsServiceRoleOverridesRoleOverride
+
+	retval.User = v.GraphUserPermission.User
+	retval.Role = v.GraphUserPermission.Role
+	return &retval, nil
+}
+
+// getLinterConfigurationResponse is returned by getLinterConfiguration on success.
+type getLinterConfigurationResponse struct {
+	// Service by ID
+	Service *getLinterConfigurationService `json:"service"`
+}
+
+// GetService returns getLinterConfigurationResponse.Service, and is useful for accessing the field via an interface.
+func (v *getLinterConfigurationResponse) GetService() *getLinterConfigurationService {
+	return v.Service
+}
+
+// getLinterConfigurationService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getLinterConfigurationService struct {
+	// Linter configuration for this graph.
+	LinterConfiguration getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration `json:"linterConfiguration"`
+}
+
+// GetLinterConfiguration returns getLinterConfigurationService.LinterConfiguration, and is useful for accessing the field via an interface.
+func (v *getLinterConfigurationService) GetLinterConfiguration() getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration {
+	return v.LinterConfiguration
+}
+
+// getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration includes the requested fields of the GraphQL type GraphLinterConfiguration.
+// The GraphQL type's documentation follows.
+//
+// The linter configuration for this graph.
+type getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration struct {
+	LinterConfiguration `json:"-"`
+}
+
+// GetAllowedTagNames returns getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration.AllowedTagNames, and is useful for accessing the field via an interface.
+func (v *getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration) GetAllowedTagNames() []string {
+	return v.LinterConfiguration.AllowedTagNames
+}
+
+// GetIgnoreDeprecated returns getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration.IgnoreDeprecated, and is useful for accessing the field via an interface.
+func (v *getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration) GetIgnoreDeprecated() bool {
+	return v.LinterConfiguration.IgnoreDeprecated
+}
+
+// GetIgnoreInaccessible returns getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration.IgnoreInaccessible, and is useful for accessing the field via an interface.
+func (v *getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration) GetIgnoreInaccessible() bool {
+	return v.LinterConfiguration.IgnoreInaccessible
+}
+
+// GetRules returns getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration.Rules, and is useful for accessing the field via an interface.
+func (v *getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration) GetRules() []LinterConfigurationRulesLinterRuleLevelConfiguration {
+	return v.LinterConfiguration.Rules
+}
+
+func (v *getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LinterConfiguration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration struct {
+	AllowedTagNames []string `json:"allowedTagNames"`
+
+	IgnoreDeprecated bool `json:"ignoreDeprecated"`
+
+	IgnoreInaccessible bool `json:"ignoreInaccessible"`
+
+	Rules []LinterConfigurationRulesLinterRuleLevelConfiguration `json:"rules"`
+}
+
+func (v *getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration) __premarshalJSON() (*__premarshalgetLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration, error) {
+	var retval __premarshalgetLinterConfigurationServiceLinterConfigurationGraphLinterConfiguration
+
+	retval.AllowedTagNames = v.LinterConfiguration.AllowedTagNames
+	retval.IgnoreDeprecated = v.LinterConfiguration.IgnoreDeprecated
+	retval.IgnoreInaccessible = v.LinterConfiguration.IgnoreInaccessible
+	retval.Rules = v.LinterConfiguration.Rules
+	return &retval, nil
+}
+
+// getOrganizationInvitationsAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// An organization in Apollo Studio. Can have multiple members and graphs.
+type getOrganizationInvitationsAccount struct {
+	Invitations []getOrganizationInvitationsAccountInvitationsAccountInvitation `json:"invitations"`
+}
+
+// GetInvitations returns getOrganizationInvitationsAccount.Invitations, and is useful for accessing the field via an interface.
+func (v *getOrganizationInvitationsAccount) GetInvitations() []getOrganizationInvitationsAccountInvitationsAccountInvitation {
+	return v.Invitations
+}
+
+// getOrganizationInvitationsAccountInvitationsAccountInvitation includes the requested fields of the GraphQL type AccountInvitation.
+type getOrganizationInvitationsAccountInvitationsAccountInvitation struct {
+	OrganizationInvitation `json:"-"`
+}
+
+// GetId returns getOrganizationInvitationsAccountInvitationsAccountInvitation.Id, and is useful for accessing the field via an interface.
+func (v *getOrganizationInvitationsAccountInvitationsAccountInvitation) GetId() string {
+	return v.OrganizationInvitation.Id
+}
+
+// GetEmail returns getOrganizationInvitationsAccountInvitationsAccountInvitation.Email, and is useful for accessing the field via an interface.
+func (v *getOrganizationInvitationsAccountInvitationsAccountInvitation) GetEmail() string {
+	return v.OrganizationInvitation.Email
+}
+
+// GetRole returns getOrganizationInvitationsAccountInvitationsAccountInvitation.Role, and is useful for accessing the field via an interface.
+func (v *getOrganizationInvitationsAccountInvitationsAccountInvitation) GetRole() string {
+	return v.OrganizationInvitation.Role
+}
+
+// GetAcceptedAt returns getOrganizationInvitationsAccountInvitationsAccountInvitation.AcceptedAt, and is useful for accessing the field via an interface.
+func (v *getOrganizationInvitationsAccountInvitationsAccountInvitation) GetAcceptedAt() *time.Time {
+	return v.OrganizationInvitation.AcceptedAt
+}
+
+func (v *getOrganizationInvitationsAccountInvitationsAccountInvitation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getOrganizationInvitationsAccountInvitationsAccountInvitation
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getOrganizationInvitationsAccountInvitationsAccountInvitation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationInvitation)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetOrganizationInvitationsAccountInvitationsAccountInvitation struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Role string `json:"role"`
+
+	AcceptedAt *time.Time `json:"acceptedAt"`
+}
+
+func (v *getOrganizationInvitationsAccountInvitationsAccountInvitation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getOrganizationInvitationsAccountInvitationsAccountInvitation) __premarshalJSON() (*__premarshalgetOrganizationInvitationsAccountInvitationsAccountInvitation, error) {
+	var retval __premarshalgetOrganizationInvitationsAccountInvitationsAccountInvitation
+
+	retval.Id = v.OrganizationInvitation.Id
+	retval.Email = v.OrganizationInvitation.Email
+	retval.Role = v.OrganizationInvitation.Role
+	retval.AcceptedAt = v.OrganizationInvitation.AcceptedAt
+	return &retval, nil
+}
+
+// getOrganizationInvitationsResponse is returned by getOrganizationInvitations on success.
+type getOrganizationInvitationsResponse struct {
+	// Account by ID
+	Account *getOrganizationInvitationsAccount `json:"account"`
+}
+
+// GetAccount returns getOrganizationInvitationsResponse.Account, and is useful for accessing the field via an interface.
+func (v *getOrganizationInvitationsResponse) GetAccount() *getOrganizationInvitationsAccount {
+	return v.Account
+}
+
+// getOrganizationInviteLinksAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// An organization in Apollo Studio. Can have multiple members and graphs.
+type getOrganizationInviteLinksAccount struct {
+	// A list of reusable invitations for the organization.
+	StaticInvitations []getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink `json:"staticInvitations"`
+}
+
+// GetStaticInvitations returns getOrganizationInviteLinksAccount.StaticInvitations, and is useful for accessing the field via an interface.
+func (v *getOrganizationInviteLinksAccount) GetStaticInvitations() []getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink {
+	return v.StaticInvitations
+}
+
+// getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink includes the requested fields of the GraphQL type OrganizationInviteLink.
+// The GraphQL type's documentation follows.
+//
+// A reusable invite link for an organization.
+type getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink struct {
+	OrganizationInviteLink `json:"-"`
+}
+
+// GetJoinToken returns getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink.JoinToken, and is useful for accessing the field via an interface.
+func (v *getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink) GetJoinToken() string {
+	return v.OrganizationInviteLink.JoinToken
+}
+
+// GetRole returns getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink.Role, and is useful for accessing the field via an interface.
+func (v *getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink) GetRole() string {
+	return v.OrganizationInviteLink.Role
+}
+
+// GetCreatedAt returns getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink.CreatedAt, and is useful for accessing the field via an interface.
+func (v *getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink) GetCreatedAt() time.Time {
+	return v.OrganizationInviteLink.CreatedAt
+}
+
+func (v *getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationInviteLink)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink struct {
+	JoinToken string `json:"joinToken"`
+
+	Role string `json:"role"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (v *getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink) __premarshalJSON() (*__premarshalgetOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink, error) {
+	var retval __premarshalgetOrganizationInviteLinksAccountStaticInvitationsOrganizationInviteLink
+
+	retval.JoinToken = v.OrganizationInviteLink.JoinToken
+	retval.Role = v.OrganizationInviteLink.Role
+	retval.CreatedAt = v.OrganizationInviteLink.CreatedAt
+	return &retval, nil
+}
+
+// getOrganizationInviteLinksResponse is returned by getOrganizationInviteLinks on success.
+type getOrganizationInviteLinksResponse struct {
+	// Account by ID
+	Account *getOrganizationInviteLinksAccount `json:"account"`
+}
+
+// GetAccount returns getOrganizationInviteLinksResponse.Account, and is useful for accessing the field via an interface.
+func (v *getOrganizationInviteLinksResponse) GetAccount() *getOrganizationInviteLinksAccount {
+	return v.Account
+}
+
+// getOrganizationMembersAccount includes the requested fields of the GraphQL type Account.
+// The GraphQL type's documentation follows.
+//
+// An organization in Apollo Studio. Can have multiple members and graphs.
+type getOrganizationMembersAccount struct {
+	Memberships []getOrganizationMembersAccountMembershipsAccountMembership `json:"memberships"`
+}
+
+// GetMemberships returns getOrganizationMembersAccount.Memberships, and is useful for accessing the field via an interface.
+func (v *getOrganizationMembersAccount) GetMemberships() []getOrganizationMembersAccountMembershipsAccountMembership {
+	return v.Memberships
+}
+
+// getOrganizationMembersAccountMembershipsAccountMembership includes the requested fields of the GraphQL type AccountMembership.
+type getOrganizationMembersAccountMembershipsAccountMembership struct {
+	OrganizationMember `json:"-"`
+}
+
+// GetUser returns getOrganizationMembersAccountMembershipsAccountMembership.User, and is useful for accessing the field via an interface.
+func (v *getOrganizationMembersAccountMembershipsAccountMembership) GetUser() OrganizationMemberUser {
+	return v.OrganizationMember.User
+}
+
+// GetPermission returns getOrganizationMembersAccountMembershipsAccountMembership.Permission, and is useful for accessing the field via an interface.
+func (v *getOrganizationMembersAccountMembershipsAccountMembership) GetPermission() string {
+	return v.OrganizationMember.Permission
+}
+
+func (v *getOrganizationMembersAccountMembershipsAccountMembership) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getOrganizationMembersAccountMembershipsAccountMembership
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getOrganizationMembersAccountMembershipsAccountMembership = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationMember)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetOrganizationMembersAccountMembershipsAccountMembership struct {
+	User OrganizationMemberUser `json:"user"`
+
+	Permission string `json:"permission"`
+}
+
+func (v *getOrganizationMembersAccountMembershipsAccountMembership) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getOrganizationMembersAccountMembershipsAccountMembership) __premarshalJSON() (*__premarshalgetOrganizationMembersAccountMembershipsAccountMembership, error) {
+	var retval __premarshalgetOrganizationMembersAccountMembershipsAccountMembership
+
+	retval.User = v.OrganizationMember.User
+	retval.Permission = v.OrganizationMember.Permission
+	return &retval, nil
+}
+
+// getOrganizationMembersResponse is returned by getOrganizationMembers on success.
+type getOrganizationMembersResponse struct {
+	// Account by ID
+	Account *getOrganizationMembersAccount `json:"account"`
+}
+
+// GetAccount returns getOrganizationMembersResponse.Account, and is useful for accessing the field via an interface.
+func (v *getOrganizationMembersResponse) GetAccount() *getOrganizationMembersAccount {
+	return v.Account
+}
+
+// getPagerDutyChannelResponse is returned by getPagerDutyChannel on success.
+type getPagerDutyChannelResponse struct {
+	// Service by ID
+	Service *getPagerDutyChannelService `json:"service"`
+}
+
+// GetService returns getPagerDutyChannelResponse.Service, and is useful for accessing the field via an interface.
+func (v *getPagerDutyChannelResponse) GetService() *getPagerDutyChannelService { return v.Service }
+
+// getPagerDutyChannelService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getPagerDutyChannelService struct {
+	// Get available notification endpoints
+	Channels []getPagerDutyChannelServiceChannelsChannel `json:"-"`
+}
+
+// GetChannels returns getPagerDutyChannelService.Channels, and is useful for accessing the field via an interface.
+func (v *getPagerDutyChannelService) GetChannels() []getPagerDutyChannelServiceChannelsChannel {
+	return v.Channels
+}
+
+func (v *getPagerDutyChannelService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getPagerDutyChannelService
+		Channels []json.RawMessage `json:"channels"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getPagerDutyChannelService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Channels
+		src := firstPass.Channels
+		*dst = make(
+			[]getPagerDutyChannelServiceChannelsChannel,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalgetPagerDutyChannelServiceChannelsChannel(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal getPagerDutyChannelService.Channels: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetPagerDutyChannelService struct {
+	Channels []json.RawMessage `json:"channels"`
+}
+
+func (v *getPagerDutyChannelService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getPagerDutyChannelService) __premarshalJSON() (*__premarshalgetPagerDutyChannelService, error) {
+	var retval __premarshalgetPagerDutyChannelService
+
+	{
+
+		dst := &retval.Channels
+		src := v.Channels
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalgetPagerDutyChannelServiceChannelsChannel(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal getPagerDutyChannelService.Channels: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// getPagerDutyChannelServiceChannelsChannel includes the requested fields of the GraphQL interface Channel.
+//
+// getPagerDutyChannelServiceChannelsChannel is implemented by the following types:
+// getPagerDutyChannelServiceChannelsPagerDutyChannel
+// getPagerDutyChannelServiceChannelsSlackChannel
+// getPagerDutyChannelServiceChannelsWebhookChannel
+// The GraphQL type's documentation follows.
+//
+// Destination for notifications
+type getPagerDutyChannelServiceChannelsChannel interface {
+	implementsGraphQLInterfacegetPagerDutyChannelServiceChannelsChannel()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getPagerDutyChannelServiceChannelsPagerDutyChannel) implementsGraphQLInterfacegetPagerDutyChannelServiceChannelsChannel() {
+}
+func (v *getPagerDutyChannelServiceChannelsSlackChannel) implementsGraphQLInterfacegetPagerDutyChannelServiceChannelsChannel() {
+}
+func (v *getPagerDutyChannelServiceChannelsWebhookChannel) implementsGraphQLInterfacegetPagerDutyChannelServiceChannelsChannel() {
+}
+
+func __unmarshalgetPagerDutyChannelServiceChannelsChannel(b []byte, v *getPagerDutyChannelServiceChannelsChannel) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "PagerDutyChannel":
+		*v = new(getPagerDutyChannelServiceChannelsPagerDutyChannel)
+		return json.Unmarshal(b, *v)
+	case "SlackChannel":
+		*v = new(getPagerDutyChannelServiceChannelsSlackChannel)
+		return json.Unmarshal(b, *v)
+	case "WebhookChannel":
+		*v = new(getPagerDutyChannelServiceChannelsWebhookChannel)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Channel.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getPagerDutyChannelServiceChannelsChannel: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetPagerDutyChannelServiceChannelsChannel(v *getPagerDutyChannelServiceChannelsChannel) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getPagerDutyChannelServiceChannelsPagerDutyChannel:
+		typename = "PagerDutyChannel"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalgetPagerDutyChannelServiceChannelsPagerDutyChannel
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *getPagerDutyChannelServiceChannelsSlackChannel:
+		typename = "SlackChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPagerDutyChannelServiceChannelsSlackChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case *getPagerDutyChannelServiceChannelsWebhookChannel:
+		typename = "WebhookChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getPagerDutyChannelServiceChannelsWebhookChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getPagerDutyChannelServiceChannelsChannel: "%T"`, v)
+	}
+}
+
+// getPagerDutyChannelServiceChannelsPagerDutyChannel includes the requested fields of the GraphQL type PagerDutyChannel.
+// The GraphQL type's documentation follows.
+//
+// PagerDuty notification channel
+type getPagerDutyChannelServiceChannelsPagerDutyChannel struct {
+	Typename         string `json:"__typename"`
+	PagerDutyChannel `json:"-"`
+}
+
+// GetTypename returns getPagerDutyChannelServiceChannelsPagerDutyChannel.Typename, and is useful for accessing the field via an interface.
+func (v *getPagerDutyChannelServiceChannelsPagerDutyChannel) GetTypename() string { return v.Typename }
+
+// GetId returns getPagerDutyChannelServiceChannelsPagerDutyChannel.Id, and is useful for accessing the field via an interface.
+func (v *getPagerDutyChannelServiceChannelsPagerDutyChannel) GetId() string {
+	return v.PagerDutyChannel.Id
+}
+
+// GetName returns getPagerDutyChannelServiceChannelsPagerDutyChannel.Name, and is useful for accessing the field via an interface.
+func (v *getPagerDutyChannelServiceChannelsPagerDutyChannel) GetName() string {
+	return v.PagerDutyChannel.Name
+}
+
+// GetRoutingKey returns getPagerDutyChannelServiceChannelsPagerDutyChannel.RoutingKey, and is useful for accessing the field via an interface.
+func (v *getPagerDutyChannelServiceChannelsPagerDutyChannel) GetRoutingKey() string {
+	return v.PagerDutyChannel.RoutingKey
+}
+
+func (v *getPagerDutyChannelServiceChannelsPagerDutyChannel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getPagerDutyChannelServiceChannelsPagerDutyChannel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getPagerDutyChannelServiceChannelsPagerDutyChannel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PagerDutyChannel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetPagerDutyChannelServiceChannelsPagerDutyChannel struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	RoutingKey string `json:"routingKey"`
+}
+
+func (v *getPagerDutyChannelServiceChannelsPagerDutyChannel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getPagerDutyChannelServiceChannelsPagerDutyChannel) __premarshalJSON() (*__premarshalgetPagerDutyChannelServiceChannelsPagerDutyChannel, error) {
+	var retval __premarshalgetPagerDutyChannelServiceChannelsPagerDutyChannel
+
+	retval.Typename = v.Typename
+	retval.Id = v.PagerDutyChannel.Id
+	retval.Name = v.PagerDutyChannel.Name
+	retval.RoutingKey = v.PagerDutyChannel.RoutingKey
+	return &retval, nil
+}
+
+// getPagerDutyChannelServiceChannelsSlackChannel includes the requested fields of the GraphQL type SlackChannel.
+// The GraphQL type's documentation follows.
+//
+// Slack notification channel
+type getPagerDutyChannelServiceChannelsSlackChannel struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPagerDutyChannelServiceChannelsSlackChannel.Typename, and is useful for accessing the field via an interface.
+func (v *getPagerDutyChannelServiceChannelsSlackChannel) GetTypename() string { return v.Typename }
+
+// getPagerDutyChannelServiceChannelsWebhookChannel includes the requested fields of the GraphQL type WebhookChannel.
+// The GraphQL type's documentation follows.
+//
+// Webhook notification channel
+type getPagerDutyChannelServiceChannelsWebhookChannel struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getPagerDutyChannelServiceChannelsWebhookChannel.Typename, and is useful for accessing the field via an interface.
+func (v *getPagerDutyChannelServiceChannelsWebhookChannel) GetTypename() string { return v.Typename }
+
+// getPersistedQueryListOperationsResponse is returned by getPersistedQueryListOperations on success.
+type getPersistedQueryListOperationsResponse struct {
+	// Service by ID
+	Service *getPersistedQueryListOperationsService `json:"service"`
+}
+
+// GetService returns getPersistedQueryListOperationsResponse.Service, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListOperationsResponse) GetService() *getPersistedQueryListOperationsService {
+	return v.Service
+}
+
+// getPersistedQueryListOperationsService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getPersistedQueryListOperationsService struct {
+	// The Persisted Query List associated with this graph with the given ID.
+	PersistedQueryList *getPersistedQueryListOperationsServicePersistedQueryList `json:"persistedQueryList"`
+}
+
+// GetPersistedQueryList returns getPersistedQueryListOperationsService.PersistedQueryList, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListOperationsService) GetPersistedQueryList() *getPersistedQueryListOperationsServicePersistedQueryList {
+	return v.PersistedQueryList
+}
+
+// getPersistedQueryListOperationsServicePersistedQueryList includes the requested fields of the GraphQL type PersistedQueryList.
+// The GraphQL type's documentation follows.
+//
+// TODO
+type getPersistedQueryListOperationsServicePersistedQueryList struct {
+	Operations getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnection `json:"operations"`
+}
+
+// GetOperations returns getPersistedQueryListOperationsServicePersistedQueryList.Operations, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListOperationsServicePersistedQueryList) GetOperations() getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnection {
+	return v.Operations
+}
+
+// getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnection includes the requested fields of the GraphQL type PersistedQueryConnection.
+type getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnection struct {
+	Edges    []getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionEdgesPersistedQueryEdge `json:"edges"`
+	PageInfo getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionPageInfo                  `json:"pageInfo"`
+}
+
+// GetEdges returns getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnection.Edges, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnection) GetEdges() []getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionEdgesPersistedQueryEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnection) GetPageInfo() getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionPageInfo {
+	return v.PageInfo
+}
+
+// getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionEdgesPersistedQueryEdge includes the requested fields of the GraphQL type PersistedQueryEdge.
+type getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionEdgesPersistedQueryEdge struct {
+	Node getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionEdgesPersistedQueryEdgeNodePersistedQuery `json:"node"`
+}
+
+// GetNode returns getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionEdgesPersistedQueryEdge.Node, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionEdgesPersistedQueryEdge) GetNode() getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionEdgesPersistedQueryEdgeNodePersistedQuery {
+	return v.Node
+}
+
+// getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionEdgesPersistedQueryEdgeNodePersistedQuery includes the requested fields of the GraphQL type PersistedQuery.
+type getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionEdgesPersistedQueryEdgeNodePersistedQuery struct {
+	Id string `json:"id"`
+}
+
+// GetId returns getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionEdgesPersistedQueryEdgeNodePersistedQuery.Id, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionEdgesPersistedQueryEdgeNodePersistedQuery) GetId() string {
+	return v.Id
+}
+
+// getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+// The GraphQL type's documentation follows.
+//
+// Information about pagination in a connection.
+type getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionPageInfo struct {
+	// When paginating forwards, the cursor to continue.
+	EndCursor *string `json:"endCursor"`
+	// When paginating forwards, are there more items?
+	HasNextPage bool `json:"hasNextPage"`
+}
+
+// GetEndCursor returns getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
+
+// GetHasNextPage returns getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListOperationsServicePersistedQueryListOperationsPersistedQueryConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// getPersistedQueryListResponse is returned by getPersistedQueryList on success.
+type getPersistedQueryListResponse struct {
+	// Service by ID
+	Service *getPersistedQueryListService `json:"service"`
+}
+
+// GetService returns getPersistedQueryListResponse.Service, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListResponse) GetService() *getPersistedQueryListService { return v.Service }
+
+// getPersistedQueryListService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getPersistedQueryListService struct {
+	// The Persisted Query List associated with this graph with the given ID.
+	PersistedQueryList *getPersistedQueryListServicePersistedQueryList `json:"persistedQueryList"`
+}
+
+// GetPersistedQueryList returns getPersistedQueryListService.PersistedQueryList, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListService) GetPersistedQueryList() *getPersistedQueryListServicePersistedQueryList {
+	return v.PersistedQueryList
+}
+
+// getPersistedQueryListServicePersistedQueryList includes the requested fields of the GraphQL type PersistedQueryList.
+// The GraphQL type's documentation follows.
+//
+// TODO
+type getPersistedQueryListServicePersistedQueryList struct {
+	PersistedQueryList `json:"-"`
+}
+
+// GetId returns getPersistedQueryListServicePersistedQueryList.Id, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListServicePersistedQueryList) GetId() string {
+	return v.PersistedQueryList.Id
+}
+
+// GetName returns getPersistedQueryListServicePersistedQueryList.Name, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListServicePersistedQueryList) GetName() string {
+	return v.PersistedQueryList.Name
+}
+
+// GetDescription returns getPersistedQueryListServicePersistedQueryList.Description, and is useful for accessing the field via an interface.
+func (v *getPersistedQueryListServicePersistedQueryList) GetDescription() string {
+	return v.PersistedQueryList.Description
+}
+
+func (v *getPersistedQueryListServicePersistedQueryList) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getPersistedQueryListServicePersistedQueryList
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getPersistedQueryListServicePersistedQueryList = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PersistedQueryList)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetPersistedQueryListServicePersistedQueryList struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+}
+
+func (v *getPersistedQueryListServicePersistedQueryList) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getPersistedQueryListServicePersistedQueryList) __premarshalJSON() (*__premarshalgetPersistedQueryListServicePersistedQueryList, error) {
+	var retval __premarshalgetPersistedQueryListServicePersistedQueryList
+
+	retval.Id = v.PersistedQueryList.Id
+	retval.Name = v.PersistedQueryList.Name
+	retval.Description = v.PersistedQueryList.Description
+	return &retval, nil
+}
+
+// getQueryTriggersResponse is returned by getQueryTriggers on success.
+type getQueryTriggersResponse struct {
+	// Service by ID
+	Service *getQueryTriggersService `json:"service"`
+}
+
+// GetService returns getQueryTriggersResponse.Service, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersResponse) GetService() *getQueryTriggersService { return v.Service }
+
+// getQueryTriggersService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getQueryTriggersService struct {
+	// Get query triggers for a given variant. If variant is null all the triggers for this service will be gotten.
+	QueryTriggers []getQueryTriggersServiceQueryTriggersQueryTrigger `json:"queryTriggers"`
+}
+
+// GetQueryTriggers returns getQueryTriggersService.QueryTriggers, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersService) GetQueryTriggers() []getQueryTriggersServiceQueryTriggersQueryTrigger {
+	return v.QueryTriggers
+}
+
+// getQueryTriggersServiceQueryTriggersQueryTrigger includes the requested fields of the GraphQL type QueryTrigger.
+// The GraphQL type's documentation follows.
+//
+// Query Trigger
+type getQueryTriggersServiceQueryTriggersQueryTrigger struct {
+	QueryTrigger `json:"-"`
+}
+
+// GetId returns getQueryTriggersServiceQueryTriggersQueryTrigger.Id, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) GetId() string { return v.QueryTrigger.Id }
+
+// GetVariant returns getQueryTriggersServiceQueryTriggersQueryTrigger.Variant, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) GetVariant() *string {
+	return v.QueryTrigger.Variant
+}
+
+// GetMetric returns getQueryTriggersServiceQueryTriggersQueryTrigger.Metric, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) GetMetric() QueryTriggerMetric {
+	return v.QueryTrigger.Metric
+}
+
+// GetComparisonOperator returns getQueryTriggersServiceQueryTriggersQueryTrigger.ComparisonOperator, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) GetComparisonOperator() ComparisonOperator {
+	return v.QueryTrigger.ComparisonOperator
+}
+
+// GetThreshold returns getQueryTriggersServiceQueryTriggersQueryTrigger.Threshold, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) GetThreshold() float64 {
+	return v.QueryTrigger.Threshold
+}
+
+// GetPercentile returns getQueryTriggersServiceQueryTriggersQueryTrigger.Percentile, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) GetPercentile() *float64 {
+	return v.QueryTrigger.Percentile
+}
+
+// GetWindow returns getQueryTriggersServiceQueryTriggersQueryTrigger.Window, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) GetWindow() QueryTriggerWindow {
+	return v.QueryTrigger.Window
+}
+
+// GetScope returns getQueryTriggersServiceQueryTriggersQueryTrigger.Scope, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) GetScope() QueryTriggerScope {
+	return v.QueryTrigger.Scope
+}
+
+// GetOperationNames returns getQueryTriggersServiceQueryTriggersQueryTrigger.OperationNames, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) GetOperationNames() []string {
+	return v.QueryTrigger.OperationNames
+}
+
+// GetExcludedOperationNames returns getQueryTriggersServiceQueryTriggersQueryTrigger.ExcludedOperationNames, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) GetExcludedOperationNames() []string {
+	return v.QueryTrigger.ExcludedOperationNames
+}
+
+// GetEnabled returns getQueryTriggersServiceQueryTriggersQueryTrigger.Enabled, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) GetEnabled() bool {
+	return v.QueryTrigger.Enabled
+}
+
+// GetChannels returns getQueryTriggersServiceQueryTriggersQueryTrigger.Channels, and is useful for accessing the field via an interface.
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) GetChannels() []QueryTriggerChannelsChannel {
+	return v.QueryTrigger.Channels
+}
+
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getQueryTriggersServiceQueryTriggersQueryTrigger
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getQueryTriggersServiceQueryTriggersQueryTrigger = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.QueryTrigger)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetQueryTriggersServiceQueryTriggersQueryTrigger struct {
+	Id string `json:"id"`
+
+	Variant *string `json:"variant"`
+
+	Metric QueryTriggerMetric `json:"metric"`
+
+	ComparisonOperator ComparisonOperator `json:"comparisonOperator"`
+
+	Threshold float64 `json:"threshold"`
+
+	Percentile *float64 `json:"percentile"`
+
+	Window QueryTriggerWindow `json:"window"`
+
+	Scope QueryTriggerScope `json:"scope"`
+
+	OperationNames []string `json:"operationNames"`
+
+	ExcludedOperationNames []string `json:"excludedOperationNames"`
+
+	Enabled bool `json:"enabled"`
+
+	Channels []json.RawMessage `json:"channels"`
+}
+
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getQueryTriggersServiceQueryTriggersQueryTrigger) __premarshalJSON() (*__premarshalgetQueryTriggersServiceQueryTriggersQueryTrigger, error) {
+	var retval __premarshalgetQueryTriggersServiceQueryTriggersQueryTrigger
+
+	retval.Id = v.QueryTrigger.Id
+	retval.Variant = v.QueryTrigger.Variant
+	retval.Metric = v.QueryTrigger.Metric
+	retval.ComparisonOperator = v.QueryTrigger.ComparisonOperator
+	retval.Threshold = v.QueryTrigger.Threshold
+	retval.Percentile = v.QueryTrigger.Percentile
+	retval.Window = v.QueryTrigger.Window
+	retval.Scope = v.QueryTrigger.Scope
+	retval.OperationNames = v.QueryTrigger.OperationNames
+	retval.ExcludedOperationNames = v.QueryTrigger.ExcludedOperationNames
+	retval.Enabled = v.QueryTrigger.Enabled
+	{
+
+		dst := &retval.Channels
+		src := v.QueryTrigger.Channels
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalQueryTriggerChannelsChannel(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal getQueryTriggersServiceQueryTriggersQueryTrigger.QueryTrigger.Channels: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// getRegistrySubscriptionsResponse is returned by getRegistrySubscriptions on success.
+type getRegistrySubscriptionsResponse struct {
+	// Service by ID
+	Service *getRegistrySubscriptionsService `json:"service"`
+}
+
+// GetService returns getRegistrySubscriptionsResponse.Service, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsResponse) GetService() *getRegistrySubscriptionsService {
+	return v.Service
+}
+
+// getRegistrySubscriptionsService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getRegistrySubscriptionsService struct {
+	// Get available notification endpoints
+	Channels []getRegistrySubscriptionsServiceChannelsChannel `json:"-"`
+}
+
+// GetChannels returns getRegistrySubscriptionsService.Channels, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsService) GetChannels() []getRegistrySubscriptionsServiceChannelsChannel {
+	return v.Channels
+}
+
+func (v *getRegistrySubscriptionsService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getRegistrySubscriptionsService
+		Channels []json.RawMessage `json:"channels"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getRegistrySubscriptionsService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Channels
+		src := firstPass.Channels
+		*dst = make(
+			[]getRegistrySubscriptionsServiceChannelsChannel,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalgetRegistrySubscriptionsServiceChannelsChannel(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal getRegistrySubscriptionsService.Channels: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetRegistrySubscriptionsService struct {
+	Channels []json.RawMessage `json:"channels"`
+}
+
+func (v *getRegistrySubscriptionsService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getRegistrySubscriptionsService) __premarshalJSON() (*__premarshalgetRegistrySubscriptionsService, error) {
+	var retval __premarshalgetRegistrySubscriptionsService
+
+	{
+
+		dst := &retval.Channels
+		src := v.Channels
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalgetRegistrySubscriptionsServiceChannelsChannel(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal getRegistrySubscriptionsService.Channels: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// getRegistrySubscriptionsServiceChannelsChannel includes the requested fields of the GraphQL interface Channel.
+//
+// getRegistrySubscriptionsServiceChannelsChannel is implemented by the following types:
+// getRegistrySubscriptionsServiceChannelsPagerDutyChannel
+// getRegistrySubscriptionsServiceChannelsSlackChannel
+// getRegistrySubscriptionsServiceChannelsWebhookChannel
+// The GraphQL type's documentation follows.
+//
+// Destination for notifications
+type getRegistrySubscriptionsServiceChannelsChannel interface {
+	implementsGraphQLInterfacegetRegistrySubscriptionsServiceChannelsChannel()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetSubscriptions returns the interface-field "subscriptions" from its implementation.
+	GetSubscriptions() []getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsPagerDutyChannel) implementsGraphQLInterfacegetRegistrySubscriptionsServiceChannelsChannel() {
+}
+func (v *getRegistrySubscriptionsServiceChannelsSlackChannel) implementsGraphQLInterfacegetRegistrySubscriptionsServiceChannelsChannel() {
+}
+func (v *getRegistrySubscriptionsServiceChannelsWebhookChannel) implementsGraphQLInterfacegetRegistrySubscriptionsServiceChannelsChannel() {
+}
+
+func __unmarshalgetRegistrySubscriptionsServiceChannelsChannel(b []byte, v *getRegistrySubscriptionsServiceChannelsChannel) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "PagerDutyChannel":
+		*v = new(getRegistrySubscriptionsServiceChannelsPagerDutyChannel)
+		return json.Unmarshal(b, *v)
+	case "SlackChannel":
+		*v = new(getRegistrySubscriptionsServiceChannelsSlackChannel)
+		return json.Unmarshal(b, *v)
+	case "WebhookChannel":
+		*v = new(getRegistrySubscriptionsServiceChannelsWebhookChannel)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Channel.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getRegistrySubscriptionsServiceChannelsChannel: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetRegistrySubscriptionsServiceChannelsChannel(v *getRegistrySubscriptionsServiceChannelsChannel) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getRegistrySubscriptionsServiceChannelsPagerDutyChannel:
+		typename = "PagerDutyChannel"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalgetRegistrySubscriptionsServiceChannelsPagerDutyChannel
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *getRegistrySubscriptionsServiceChannelsSlackChannel:
+		typename = "SlackChannel"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalgetRegistrySubscriptionsServiceChannelsSlackChannel
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *getRegistrySubscriptionsServiceChannelsWebhookChannel:
+		typename = "WebhookChannel"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalgetRegistrySubscriptionsServiceChannelsWebhookChannel
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getRegistrySubscriptionsServiceChannelsChannel: "%T"`, v)
+	}
+}
+
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription includes the requested fields of the GraphQL interface ChannelSubscription.
+//
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription is implemented by the following types:
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsCompositionStatusSubscription
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsProposalLifecycleSubscription
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsQueryTrigger
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsScheduledSummary
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsSchemaPublishSubscription
+type getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription interface {
+	implementsGraphQLInterfacegetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsCompositionStatusSubscription) implementsGraphQLInterfacegetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription() {
+}
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsProposalLifecycleSubscription) implementsGraphQLInterfacegetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription() {
+}
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsQueryTrigger) implementsGraphQLInterfacegetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription() {
+}
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription) implementsGraphQLInterfacegetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription() {
+}
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsScheduledSummary) implementsGraphQLInterfacegetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription() {
+}
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsSchemaPublishSubscription) implementsGraphQLInterfacegetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription() {
+}
+
+func __unmarshalgetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription(b []byte, v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CompositionStatusSubscription":
+		*v = new(getRegistrySubscriptionsServiceChannelsChannelSubscriptionsCompositionStatusSubscription)
+		return json.Unmarshal(b, *v)
+	case "ProposalLifecycleSubscription":
+		*v = new(getRegistrySubscriptionsServiceChannelsChannelSubscriptionsProposalLifecycleSubscription)
+		return json.Unmarshal(b, *v)
+	case "QueryTrigger":
+		*v = new(getRegistrySubscriptionsServiceChannelsChannelSubscriptionsQueryTrigger)
+		return json.Unmarshal(b, *v)
+	case "RegistrySubscription":
+		*v = new(getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription)
+		return json.Unmarshal(b, *v)
+	case "ScheduledSummary":
+		*v = new(getRegistrySubscriptionsServiceChannelsChannelSubscriptionsScheduledSummary)
+		return json.Unmarshal(b, *v)
+	case "SchemaPublishSubscription":
+		*v = new(getRegistrySubscriptionsServiceChannelsChannelSubscriptionsSchemaPublishSubscription)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing ChannelSubscription.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription(v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsCompositionStatusSubscription:
+		typename = "CompositionStatusSubscription"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getRegistrySubscriptionsServiceChannelsChannelSubscriptionsCompositionStatusSubscription
+		}{typename, v}
+		return json.Marshal(result)
+	case *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsProposalLifecycleSubscription:
+		typename = "ProposalLifecycleSubscription"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getRegistrySubscriptionsServiceChannelsChannelSubscriptionsProposalLifecycleSubscription
+		}{typename, v}
+		return json.Marshal(result)
+	case *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsQueryTrigger:
+		typename = "QueryTrigger"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getRegistrySubscriptionsServiceChannelsChannelSubscriptionsQueryTrigger
+		}{typename, v}
+		return json.Marshal(result)
+	case *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription:
+		typename = "RegistrySubscription"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalgetRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsScheduledSummary:
+		typename = "ScheduledSummary"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getRegistrySubscriptionsServiceChannelsChannelSubscriptionsScheduledSummary
+		}{typename, v}
+		return json.Marshal(result)
+	case *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsSchemaPublishSubscription:
+		typename = "SchemaPublishSubscription"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getRegistrySubscriptionsServiceChannelsChannelSubscriptionsSchemaPublishSubscription
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription: "%T"`, v)
+	}
+}
+
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsCompositionStatusSubscription includes the requested fields of the GraphQL type CompositionStatusSubscription.
+type getRegistrySubscriptionsServiceChannelsChannelSubscriptionsCompositionStatusSubscription struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getRegistrySubscriptionsServiceChannelsChannelSubscriptionsCompositionStatusSubscription.Typename, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsCompositionStatusSubscription) GetTypename() string {
+	return v.Typename
+}
+
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsProposalLifecycleSubscription includes the requested fields of the GraphQL type ProposalLifecycleSubscription.
+type getRegistrySubscriptionsServiceChannelsChannelSubscriptionsProposalLifecycleSubscription struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getRegistrySubscriptionsServiceChannelsChannelSubscriptionsProposalLifecycleSubscription.Typename, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsProposalLifecycleSubscription) GetTypename() string {
+	return v.Typename
+}
+
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsQueryTrigger includes the requested fields of the GraphQL type QueryTrigger.
+// The GraphQL type's documentation follows.
+//
+// Query Trigger
+type getRegistrySubscriptionsServiceChannelsChannelSubscriptionsQueryTrigger struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getRegistrySubscriptionsServiceChannelsChannelSubscriptionsQueryTrigger.Typename, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsQueryTrigger) GetTypename() string {
+	return v.Typename
+}
+
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription includes the requested fields of the GraphQL type RegistrySubscription.
+type getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription struct {
+	Typename             string `json:"__typename"`
+	RegistrySubscription `json:"-"`
+}
+
+// GetTypename returns getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription.Typename, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription.Id, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription) GetId() string {
+	return v.RegistrySubscription.Id
+}
+
+// GetVariant returns getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription.Variant, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription) GetVariant() *string {
+	return v.RegistrySubscription.Variant
+}
+
+// GetEnabled returns getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription.Enabled, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription) GetEnabled() bool {
+	return v.RegistrySubscription.Enabled
+}
+
+// GetChannel returns getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription.Channel, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription) GetChannel() RegistrySubscriptionChannel {
+	return v.RegistrySubscription.Channel
+}
+
+// GetOptions returns getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription.Options, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription) GetOptions() RegistrySubscriptionOptions {
+	return v.RegistrySubscription.Options
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.RegistrySubscription)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Variant *string `json:"variant"`
+
+	Enabled bool `json:"enabled"`
+
+	Channel json.RawMessage `json:"channel"`
+
+	Options RegistrySubscriptionOptions `json:"options"`
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription) __premarshalJSON() (*__premarshalgetRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription, error) {
+	var retval __premarshalgetRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription
+
+	retval.Typename = v.Typename
+	retval.Id = v.RegistrySubscription.Id
+	retval.Variant = v.RegistrySubscription.Variant
+	retval.Enabled = v.RegistrySubscription.Enabled
+	{
+
+		dst := &retval.Channel
+		src := v.RegistrySubscription.Channel
+		var err error
+		*dst, err = __marshalRegistrySubscriptionChannel(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription.RegistrySubscription.Channel: %w", err)
+		}
+	}
+	retval.Options = v.RegistrySubscription.Options
+	return &retval, nil
+}
+
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsScheduledSummary includes the requested fields of the GraphQL type ScheduledSummary.
+type getRegistrySubscriptionsServiceChannelsChannelSubscriptionsScheduledSummary struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getRegistrySubscriptionsServiceChannelsChannelSubscriptionsScheduledSummary.Typename, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsScheduledSummary) GetTypename() string {
+	return v.Typename
+}
+
+// getRegistrySubscriptionsServiceChannelsChannelSubscriptionsSchemaPublishSubscription includes the requested fields of the GraphQL type SchemaPublishSubscription.
+type getRegistrySubscriptionsServiceChannelsChannelSubscriptionsSchemaPublishSubscription struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getRegistrySubscriptionsServiceChannelsChannelSubscriptionsSchemaPublishSubscription.Typename, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsChannelSubscriptionsSchemaPublishSubscription) GetTypename() string {
+	return v.Typename
+}
+
+// getRegistrySubscriptionsServiceChannelsPagerDutyChannel includes the requested fields of the GraphQL type PagerDutyChannel.
+// The GraphQL type's documentation follows.
+//
+// PagerDuty notification channel
+type getRegistrySubscriptionsServiceChannelsPagerDutyChannel struct {
+	Typename      string                                                                           `json:"__typename"`
+	Subscriptions []getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription `json:"-"`
+}
+
+// GetTypename returns getRegistrySubscriptionsServiceChannelsPagerDutyChannel.Typename, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsPagerDutyChannel) GetTypename() string {
+	return v.Typename
+}
+
+// GetSubscriptions returns getRegistrySubscriptionsServiceChannelsPagerDutyChannel.Subscriptions, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsPagerDutyChannel) GetSubscriptions() []getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription {
+	return v.Subscriptions
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsPagerDutyChannel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getRegistrySubscriptionsServiceChannelsPagerDutyChannel
+		Subscriptions []json.RawMessage `json:"subscriptions"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getRegistrySubscriptionsServiceChannelsPagerDutyChannel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Subscriptions
+		src := firstPass.Subscriptions
+		*dst = make(
+			[]getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalgetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal getRegistrySubscriptionsServiceChannelsPagerDutyChannel.Subscriptions: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetRegistrySubscriptionsServiceChannelsPagerDutyChannel struct {
+	Typename string `json:"__typename"`
+
+	Subscriptions []json.RawMessage `json:"subscriptions"`
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsPagerDutyChannel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsPagerDutyChannel) __premarshalJSON() (*__premarshalgetRegistrySubscriptionsServiceChannelsPagerDutyChannel, error) {
+	var retval __premarshalgetRegistrySubscriptionsServiceChannelsPagerDutyChannel
+
+	retval.Typename = v.Typename
+	{
+
+		dst := &retval.Subscriptions
+		src := v.Subscriptions
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalgetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal getRegistrySubscriptionsServiceChannelsPagerDutyChannel.Subscriptions: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// getRegistrySubscriptionsServiceChannelsSlackChannel includes the requested fields of the GraphQL type SlackChannel.
+// The GraphQL type's documentation follows.
+//
+// Slack notification channel
+type getRegistrySubscriptionsServiceChannelsSlackChannel struct {
+	Typename      string                                                                           `json:"__typename"`
+	Subscriptions []getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription `json:"-"`
+}
+
+// GetTypename returns getRegistrySubscriptionsServiceChannelsSlackChannel.Typename, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsSlackChannel) GetTypename() string { return v.Typename }
+
+// GetSubscriptions returns getRegistrySubscriptionsServiceChannelsSlackChannel.Subscriptions, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsSlackChannel) GetSubscriptions() []getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription {
+	return v.Subscriptions
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsSlackChannel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getRegistrySubscriptionsServiceChannelsSlackChannel
+		Subscriptions []json.RawMessage `json:"subscriptions"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getRegistrySubscriptionsServiceChannelsSlackChannel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Subscriptions
+		src := firstPass.Subscriptions
+		*dst = make(
+			[]getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalgetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal getRegistrySubscriptionsServiceChannelsSlackChannel.Subscriptions: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetRegistrySubscriptionsServiceChannelsSlackChannel struct {
+	Typename string `json:"__typename"`
+
+	Subscriptions []json.RawMessage `json:"subscriptions"`
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsSlackChannel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsSlackChannel) __premarshalJSON() (*__premarshalgetRegistrySubscriptionsServiceChannelsSlackChannel, error) {
+	var retval __premarshalgetRegistrySubscriptionsServiceChannelsSlackChannel
+
+	retval.Typename = v.Typename
+	{
+
+		dst := &retval.Subscriptions
+		src := v.Subscriptions
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalgetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal getRegistrySubscriptionsServiceChannelsSlackChannel.Subscriptions: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// getRegistrySubscriptionsServiceChannelsWebhookChannel includes the requested fields of the GraphQL type WebhookChannel.
+// The GraphQL type's documentation follows.
+//
+// Webhook notification channel
+type getRegistrySubscriptionsServiceChannelsWebhookChannel struct {
+	Typename      string                                                                           `json:"__typename"`
+	Subscriptions []getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription `json:"-"`
+}
+
+// GetTypename returns getRegistrySubscriptionsServiceChannelsWebhookChannel.Typename, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsWebhookChannel) GetTypename() string {
+	return v.Typename
+}
+
+// GetSubscriptions returns getRegistrySubscriptionsServiceChannelsWebhookChannel.Subscriptions, and is useful for accessing the field via an interface.
+func (v *getRegistrySubscriptionsServiceChannelsWebhookChannel) GetSubscriptions() []getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription {
+	return v.Subscriptions
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsWebhookChannel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getRegistrySubscriptionsServiceChannelsWebhookChannel
+		Subscriptions []json.RawMessage `json:"subscriptions"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getRegistrySubscriptionsServiceChannelsWebhookChannel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Subscriptions
+		src := firstPass.Subscriptions
+		*dst = make(
+			[]getRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalgetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal getRegistrySubscriptionsServiceChannelsWebhookChannel.Subscriptions: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetRegistrySubscriptionsServiceChannelsWebhookChannel struct {
+	Typename string `json:"__typename"`
+
+	Subscriptions []json.RawMessage `json:"subscriptions"`
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsWebhookChannel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getRegistrySubscriptionsServiceChannelsWebhookChannel) __premarshalJSON() (*__premarshalgetRegistrySubscriptionsServiceChannelsWebhookChannel, error) {
+	var retval __premarshalgetRegistrySubscriptionsServiceChannelsWebhookChannel
+
+	retval.Typename = v.Typename
+	{
+
+		dst := &retval.Subscriptions
+		src := v.Subscriptions
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalgetRegistrySubscriptionsServiceChannelsChannelSubscriptionsChannelSubscription(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal getRegistrySubscriptionsServiceChannelsWebhookChannel.Subscriptions: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// getScheduledSummariesResponse is returned by getScheduledSummaries on success.
+type getScheduledSummariesResponse struct {
+	// Service by ID
+	Service *getScheduledSummariesService `json:"service"`
+}
+
+// GetService returns getScheduledSummariesResponse.Service, and is useful for accessing the field via an interface.
+func (v *getScheduledSummariesResponse) GetService() *getScheduledSummariesService { return v.Service }
+
+// getScheduledSummariesService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getScheduledSummariesService struct {
+	ScheduledSummaries []getScheduledSummariesServiceScheduledSummariesScheduledSummary `json:"scheduledSummaries"`
+}
+
+// GetScheduledSummaries returns getScheduledSummariesService.ScheduledSummaries, and is useful for accessing the field via an interface.
+func (v *getScheduledSummariesService) GetScheduledSummaries() []getScheduledSummariesServiceScheduledSummariesScheduledSummary {
+	return v.ScheduledSummaries
+}
+
+// getScheduledSummariesServiceScheduledSummariesScheduledSummary includes the requested fields of the GraphQL type ScheduledSummary.
+type getScheduledSummariesServiceScheduledSummariesScheduledSummary struct {
+	ScheduledSummary `json:"-"`
+}
+
+// GetId returns getScheduledSummariesServiceScheduledSummariesScheduledSummary.Id, and is useful for accessing the field via an interface.
+func (v *getScheduledSummariesServiceScheduledSummariesScheduledSummary) GetId() string {
+	return v.ScheduledSummary.Id
+}
+
+// GetVariant returns getScheduledSummariesServiceScheduledSummariesScheduledSummary.Variant, and is useful for accessing the field via an interface.
+func (v *getScheduledSummariesServiceScheduledSummariesScheduledSummary) GetVariant() string {
+	return v.ScheduledSummary.Variant
+}
+
+// GetTimezone returns getScheduledSummariesServiceScheduledSummariesScheduledSummary.Timezone, and is useful for accessing the field via an interface.
+func (v *getScheduledSummariesServiceScheduledSummariesScheduledSummary) GetTimezone() string {
+	return v.ScheduledSummary.Timezone
+}
+
+// GetEnabled returns getScheduledSummariesServiceScheduledSummariesScheduledSummary.Enabled, and is useful for accessing the field via an interface.
+func (v *getScheduledSummariesServiceScheduledSummariesScheduledSummary) GetEnabled() bool {
+	return v.ScheduledSummary.Enabled
+}
+
+// GetChannels returns getScheduledSummariesServiceScheduledSummariesScheduledSummary.Channels, and is useful for accessing the field via an interface.
+func (v *getScheduledSummariesServiceScheduledSummariesScheduledSummary) GetChannels() []ScheduledSummaryChannelsChannel {
+	return v.ScheduledSummary.Channels
+}
+
+func (v *getScheduledSummariesServiceScheduledSummariesScheduledSummary) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getScheduledSummariesServiceScheduledSummariesScheduledSummary
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getScheduledSummariesServiceScheduledSummariesScheduledSummary = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ScheduledSummary)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetScheduledSummariesServiceScheduledSummariesScheduledSummary struct {
+	Id string `json:"id"`
+
+	Variant string `json:"variant"`
+
+	Timezone string `json:"timezone"`
+
+	Enabled bool `json:"enabled"`
+
+	Channels []json.RawMessage `json:"channels"`
+}
+
+func (v *getScheduledSummariesServiceScheduledSummariesScheduledSummary) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getScheduledSummariesServiceScheduledSummariesScheduledSummary) __premarshalJSON() (*__premarshalgetScheduledSummariesServiceScheduledSummariesScheduledSummary, error) {
+	var retval __premarshalgetScheduledSummariesServiceScheduledSummariesScheduledSummary
+
+	retval.Id = v.ScheduledSummary.Id
+	retval.Variant = v.ScheduledSummary.Variant
+	retval.Timezone = v.ScheduledSummary.Timezone
+	retval.Enabled = v.ScheduledSummary.Enabled
+	{
+
+		dst := &retval.Channels
+		src := v.ScheduledSummary.Channels
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalScheduledSummaryChannelsChannel(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal getScheduledSummariesServiceScheduledSummariesScheduledSummary.ScheduledSummary.Channels: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// getServiceResponse is returned by getService on success.
+type getServiceResponse struct {
+	// Service by ID
+	Service *getServiceService `json:"service"`
+}
+
+// GetService returns getServiceResponse.Service, and is useful for accessing the field via an interface.
+func (v *getServiceResponse) GetService() *getServiceService { return v.Service }
+
+// getServiceService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getServiceService struct {
+	Service `json:"-"`
+}
+
+// GetId returns getServiceService.Id, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetId() string { return v.Service.Id }
+
+// GetTitle returns getServiceService.Title, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetTitle() string { return v.Service.Title }
+
+// GetOnboardingArchitecture returns getServiceService.OnboardingArchitecture, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetOnboardingArchitecture() string {
+	return v.Service.OnboardingArchitecture
+}
+
+// GetAccountId returns getServiceService.AccountId, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetAccountId() string { return v.Service.AccountId }
+
+// GetDescription returns getServiceService.Description, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetDescription() string { return v.Service.Description }
+
+// GetHiddenFromUninvitedNonAdminAccountMembers returns getServiceService.HiddenFromUninvitedNonAdminAccountMembers, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetHiddenFromUninvitedNonAdminAccountMembers() bool {
+	return v.Service.HiddenFromUninvitedNonAdminAccountMembers
+}
+
+// GetReadme returns getServiceService.Readme, and is useful for accessing the field via an interface.
+func (v *getServiceService) GetReadme() *ServiceReadme { return v.Service.Readme }
+
+func (v *getServiceService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getServiceService
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getServiceService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Service)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetServiceService struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	OnboardingArchitecture string `json:"onboardingArchitecture"`
+
+	AccountId string `json:"accountId"`
+
+	Description string `json:"description"`
+
+	HiddenFromUninvitedNonAdminAccountMembers bool `json:"hiddenFromUninvitedNonAdminAccountMembers"`
+
+	Readme *ServiceReadme `json:"readme"`
+}
+
+func (v *getServiceService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getServiceService) __premarshalJSON() (*__premarshalgetServiceService, error) {
+	var retval __premarshalgetServiceService
+
+	retval.Id = v.Service.Id
+	retval.Title = v.Service.Title
+	retval.OnboardingArchitecture = v.Service.OnboardingArchitecture
+	retval.AccountId = v.Service.AccountId
+	retval.Description = v.Service.Description
+	retval.HiddenFromUninvitedNonAdminAccountMembers = v.Service.HiddenFromUninvitedNonAdminAccountMembers
+	retval.Readme = v.Service.Readme
+	return &retval, nil
+}
+
+// getSlackChannelResponse is returned by getSlackChannel on success.
+type getSlackChannelResponse struct {
+	// Service by ID
+	Service *getSlackChannelService `json:"service"`
+}
+
+// GetService returns getSlackChannelResponse.Service, and is useful for accessing the field via an interface.
+func (v *getSlackChannelResponse) GetService() *getSlackChannelService { return v.Service }
+
+// getSlackChannelService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getSlackChannelService struct {
+	// Get available notification endpoints
+	Channels []getSlackChannelServiceChannelsChannel `json:"-"`
+}
+
+// GetChannels returns getSlackChannelService.Channels, and is useful for accessing the field via an interface.
+func (v *getSlackChannelService) GetChannels() []getSlackChannelServiceChannelsChannel {
+	return v.Channels
+}
+
+func (v *getSlackChannelService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getSlackChannelService
+		Channels []json.RawMessage `json:"channels"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getSlackChannelService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Channels
+		src := firstPass.Channels
+		*dst = make(
+			[]getSlackChannelServiceChannelsChannel,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalgetSlackChannelServiceChannelsChannel(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal getSlackChannelService.Channels: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetSlackChannelService struct {
+	Channels []json.RawMessage `json:"channels"`
+}
+
+func (v *getSlackChannelService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getSlackChannelService) __premarshalJSON() (*__premarshalgetSlackChannelService, error) {
+	var retval __premarshalgetSlackChannelService
+
+	{
+
+		dst := &retval.Channels
+		src := v.Channels
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalgetSlackChannelServiceChannelsChannel(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal getSlackChannelService.Channels: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// getSlackChannelServiceChannelsChannel includes the requested fields of the GraphQL interface Channel.
+//
+// getSlackChannelServiceChannelsChannel is implemented by the following types:
+// getSlackChannelServiceChannelsPagerDutyChannel
+// getSlackChannelServiceChannelsSlackChannel
+// getSlackChannelServiceChannelsWebhookChannel
+// The GraphQL type's documentation follows.
+//
+// Destination for notifications
+type getSlackChannelServiceChannelsChannel interface {
+	implementsGraphQLInterfacegetSlackChannelServiceChannelsChannel()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getSlackChannelServiceChannelsPagerDutyChannel) implementsGraphQLInterfacegetSlackChannelServiceChannelsChannel() {
+}
+func (v *getSlackChannelServiceChannelsSlackChannel) implementsGraphQLInterfacegetSlackChannelServiceChannelsChannel() {
+}
+func (v *getSlackChannelServiceChannelsWebhookChannel) implementsGraphQLInterfacegetSlackChannelServiceChannelsChannel() {
+}
+
+func __unmarshalgetSlackChannelServiceChannelsChannel(b []byte, v *getSlackChannelServiceChannelsChannel) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "PagerDutyChannel":
+		*v = new(getSlackChannelServiceChannelsPagerDutyChannel)
+		return json.Unmarshal(b, *v)
+	case "SlackChannel":
+		*v = new(getSlackChannelServiceChannelsSlackChannel)
+		return json.Unmarshal(b, *v)
+	case "WebhookChannel":
+		*v = new(getSlackChannelServiceChannelsWebhookChannel)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Channel.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getSlackChannelServiceChannelsChannel: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetSlackChannelServiceChannelsChannel(v *getSlackChannelServiceChannelsChannel) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getSlackChannelServiceChannelsPagerDutyChannel:
+		typename = "PagerDutyChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getSlackChannelServiceChannelsPagerDutyChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case *getSlackChannelServiceChannelsSlackChannel:
+		typename = "SlackChannel"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalgetSlackChannelServiceChannelsSlackChannel
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *getSlackChannelServiceChannelsWebhookChannel:
+		typename = "WebhookChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getSlackChannelServiceChannelsWebhookChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getSlackChannelServiceChannelsChannel: "%T"`, v)
+	}
+}
+
+// getSlackChannelServiceChannelsPagerDutyChannel includes the requested fields of the GraphQL type PagerDutyChannel.
+// The GraphQL type's documentation follows.
+//
+// PagerDuty notification channel
+type getSlackChannelServiceChannelsPagerDutyChannel struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getSlackChannelServiceChannelsPagerDutyChannel.Typename, and is useful for accessing the field via an interface.
+func (v *getSlackChannelServiceChannelsPagerDutyChannel) GetTypename() string { return v.Typename }
+
+// getSlackChannelServiceChannelsSlackChannel includes the requested fields of the GraphQL type SlackChannel.
+// The GraphQL type's documentation follows.
+//
+// Slack notification channel
+type getSlackChannelServiceChannelsSlackChannel struct {
+	Typename     string `json:"__typename"`
+	SlackChannel `json:"-"`
+}
+
+// GetTypename returns getSlackChannelServiceChannelsSlackChannel.Typename, and is useful for accessing the field via an interface.
+func (v *getSlackChannelServiceChannelsSlackChannel) GetTypename() string { return v.Typename }
+
+// GetId returns getSlackChannelServiceChannelsSlackChannel.Id, and is useful for accessing the field via an interface.
+func (v *getSlackChannelServiceChannelsSlackChannel) GetId() string { return v.SlackChannel.Id }
+
+// GetName returns getSlackChannelServiceChannelsSlackChannel.Name, and is useful for accessing the field via an interface.
+func (v *getSlackChannelServiceChannelsSlackChannel) GetName() string { return v.SlackChannel.Name }
+
+// GetUrl returns getSlackChannelServiceChannelsSlackChannel.Url, and is useful for accessing the field via an interface.
+func (v *getSlackChannelServiceChannelsSlackChannel) GetUrl() string { return v.SlackChannel.Url }
+
+func (v *getSlackChannelServiceChannelsSlackChannel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getSlackChannelServiceChannelsSlackChannel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getSlackChannelServiceChannelsSlackChannel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SlackChannel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetSlackChannelServiceChannelsSlackChannel struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Url string `json:"url"`
+}
+
+func (v *getSlackChannelServiceChannelsSlackChannel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getSlackChannelServiceChannelsSlackChannel) __premarshalJSON() (*__premarshalgetSlackChannelServiceChannelsSlackChannel, error) {
+	var retval __premarshalgetSlackChannelServiceChannelsSlackChannel
+
+	retval.Typename = v.Typename
+	retval.Id = v.SlackChannel.Id
+	retval.Name = v.SlackChannel.Name
+	retval.Url = v.SlackChannel.Url
+	return &retval, nil
+}
+
+// getSlackChannelServiceChannelsWebhookChannel includes the requested fields of the GraphQL type WebhookChannel.
+// The GraphQL type's documentation follows.
+//
+// Webhook notification channel
+type getSlackChannelServiceChannelsWebhookChannel struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getSlackChannelServiceChannelsWebhookChannel.Typename, and is useful for accessing the field via an interface.
+func (v *getSlackChannelServiceChannelsWebhookChannel) GetTypename() string { return v.Typename }
+
+// getSubgraphResponse is returned by getSubgraph on success.
+type getSubgraphResponse struct {
+	// Service by ID
+	Service *getSubgraphService `json:"service"`
+}
+
+// GetService returns getSubgraphResponse.Service, and is useful for accessing the field via an interface.
+func (v *getSubgraphResponse) GetService() *getSubgraphService { return v.Service }
+
+// getSubgraphService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getSubgraphService struct {
+	// Provides details of the graph variant with the provided `name`, if a variant
+	// with that name exists for this graph. Otherwise, returns null.
+	//
+	// For a list of _all_ variants associated with a graph, use `Graph.variants` instead.
+	Variant *getSubgraphServiceVariantGraphVariant `json:"variant"`
+}
+
+// GetVariant returns getSubgraphService.Variant, and is useful for accessing the field via an interface.
+func (v *getSubgraphService) GetVariant() *getSubgraphServiceVariantGraphVariant { return v.Variant }
+
+// getSubgraphServiceVariantGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type getSubgraphServiceVariantGraphVariant struct {
+	// Returns the details of the subgraph with the provided `name`, or null if this
+	// variant doesn't include a subgraph with that name.
+	Subgraph *getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService `json:"subgraph"`
+}
+
+// GetSubgraph returns getSubgraphServiceVariantGraphVariant.Subgraph, and is useful for accessing the field via an interface.
+func (v *getSubgraphServiceVariantGraphVariant) GetSubgraph() *getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService {
+	return v.Subgraph
+}
+
+// getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService includes the requested fields of the GraphQL type FederatedImplementingService.
+// The GraphQL type's documentation follows.
+//
+// A single subgraph in a supergraph. Every supergraph managed by Apollo Studio
+// includes at least one subgraph. See
+// https://www.apollographql.com/docs/federation/managed-federation/overview/ for
+// more information.
+type getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService struct {
+	Subgraph `json:"-"`
+}
+
+// GetName returns getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService.Name, and is useful for accessing the field via an interface.
+func (v *getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService) GetName() string {
+	return v.Subgraph.Name
+}
+
+// GetUrl returns getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService.Url, and is useful for accessing the field via an interface.
+func (v *getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService) GetUrl() *string {
+	return v.Subgraph.Url
+}
+
+// GetRevision returns getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService.Revision, and is useful for accessing the field via an interface.
+func (v *getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService) GetRevision() string {
+	return v.Subgraph.Revision
+}
+
+// GetGraphID returns getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService.GraphID, and is useful for accessing the field via an interface.
+func (v *getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService) GetGraphID() string {
+	return v.Subgraph.GraphID
+}
+
+// GetGraphVariant returns getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService.GraphVariant, and is useful for accessing the field via an interface.
+func (v *getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService) GetGraphVariant() string {
+	return v.Subgraph.GraphVariant
+}
+
+// GetActivePartialSchema returns getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService.ActivePartialSchema, and is useful for accessing the field via an interface.
+func (v *getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService) GetActivePartialSchema() SubgraphActivePartialSchema {
+	return v.Subgraph.ActivePartialSchema
+}
+
+func (v *getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Subgraph)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService struct {
+	Name string `json:"name"`
+
+	Url *string `json:"url"`
+
+	Revision string `json:"revision"`
+
+	GraphID string `json:"graphID"`
+
+	GraphVariant string `json:"graphVariant"`
+
+	ActivePartialSchema SubgraphActivePartialSchema `json:"activePartialSchema"`
+}
+
+func (v *getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService) __premarshalJSON() (*__premarshalgetSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService, error) {
+	var retval __premarshalgetSubgraphServiceVariantGraphVariantSubgraphFederatedImplementingService
+
+	retval.Name = v.Subgraph.Name
+	retval.Url = v.Subgraph.Url
+	retval.Revision = v.Subgraph.Revision
+	retval.GraphID = v.Subgraph.GraphID
+	retval.GraphVariant = v.Subgraph.GraphVariant
+	retval.ActivePartialSchema = v.Subgraph.ActivePartialSchema
+	return &retval, nil
+}
+
+// getVariantCheckConfigurationResponse is returned by getVariantCheckConfiguration on success.
+type getVariantCheckConfigurationResponse struct {
+	// Service by ID
+	Service *getVariantCheckConfigurationService `json:"service"`
+}
+
+// GetService returns getVariantCheckConfigurationResponse.Service, and is useful for accessing the field via an interface.
+func (v *getVariantCheckConfigurationResponse) GetService() *getVariantCheckConfigurationService {
+	return v.Service
+}
+
+// getVariantCheckConfigurationService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getVariantCheckConfigurationService struct {
+	// Provides details of the graph variant with the provided `name`, if a variant
+	// with that name exists for this graph. Otherwise, returns null.
+	//
+	// For a list of _all_ variants associated with a graph, use `Graph.variants` instead.
+	Variant *getVariantCheckConfigurationServiceVariantGraphVariant `json:"variant"`
+}
+
+// GetVariant returns getVariantCheckConfigurationService.Variant, and is useful for accessing the field via an interface.
+func (v *getVariantCheckConfigurationService) GetVariant() *getVariantCheckConfigurationServiceVariantGraphVariant {
+	return v.Variant
+}
+
+// getVariantCheckConfigurationServiceVariantGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type getVariantCheckConfigurationServiceVariantGraphVariant struct {
+	CheckConfiguration getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration `json:"checkConfiguration"`
+}
+
+// GetCheckConfiguration returns getVariantCheckConfigurationServiceVariantGraphVariant.CheckConfiguration, and is useful for accessing the field via an interface.
+func (v *getVariantCheckConfigurationServiceVariantGraphVariant) GetCheckConfiguration() getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration {
+	return v.CheckConfiguration
+}
+
+// getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration includes the requested fields of the GraphQL type VariantCheckConfiguration.
+// The GraphQL type's documentation follows.
+//
+// Variant-level configuration of checks.
+type getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration struct {
+	VariantCheckConfiguration `json:"-"`
+}
+
+// GetOperationsChecksEnabled returns getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration.OperationsChecksEnabled, and is useful for accessing the field via an interface.
+func (v *getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration) GetOperationsChecksEnabled() bool {
+	return v.VariantCheckConfiguration.OperationsChecksEnabled
+}
+
+// GetTimeRangeConfig returns getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration.TimeRangeConfig, and is useful for accessing the field via an interface.
+func (v *getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration) GetTimeRangeConfig() VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange {
+	return v.VariantCheckConfiguration.TimeRangeConfig
+}
+
+// GetIncludedVariantsConfig returns getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration.IncludedVariantsConfig, and is useful for accessing the field via an interface.
+func (v *getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration) GetIncludedVariantsConfig() VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants {
+	return v.VariantCheckConfiguration.IncludedVariantsConfig
+}
+
+// GetExcludedClientsConfig returns getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration.ExcludedClientsConfig, and is useful for accessing the field via an interface.
+func (v *getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration) GetExcludedClientsConfig() VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients {
+	return v.VariantCheckConfiguration.ExcludedClientsConfig
+}
+
+// GetExcludedOperationsConfig returns getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration.ExcludedOperationsConfig, and is useful for accessing the field via an interface.
+func (v *getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration) GetExcludedOperationsConfig() VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations {
+	return v.VariantCheckConfiguration.ExcludedOperationsConfig
+}
+
+// GetDowngradeChecksConfig returns getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration.DowngradeChecksConfig, and is useful for accessing the field via an interface.
+func (v *getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration) GetDowngradeChecksConfig() VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks {
+	return v.VariantCheckConfiguration.DowngradeChecksConfig
+}
+
+func (v *getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.VariantCheckConfiguration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration struct {
+	OperationsChecksEnabled bool `json:"operationsChecksEnabled"`
+
+	TimeRangeConfig VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange `json:"timeRangeConfig"`
+
+	IncludedVariantsConfig VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants `json:"includedVariantsConfig"`
+
+	ExcludedClientsConfig VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients `json:"excludedClientsConfig"`
+
+	ExcludedOperationsConfig VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations `json:"excludedOperationsConfig"`
+
+	DowngradeChecksConfig VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks `json:"downgradeChecksConfig"`
+}
+
+func (v *getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration) __premarshalJSON() (*__premarshalgetVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration, error) {
+	var retval __premarshalgetVariantCheckConfigurationServiceVariantGraphVariantCheckConfiguration
+
+	retval.OperationsChecksEnabled = v.VariantCheckConfiguration.OperationsChecksEnabled
+	retval.TimeRangeConfig = v.VariantCheckConfiguration.TimeRangeConfig
+	retval.IncludedVariantsConfig = v.VariantCheckConfiguration.IncludedVariantsConfig
+	retval.ExcludedClientsConfig = v.VariantCheckConfiguration.ExcludedClientsConfig
+	retval.ExcludedOperationsConfig = v.VariantCheckConfiguration.ExcludedOperationsConfig
+	retval.DowngradeChecksConfig = v.VariantCheckConfiguration.DowngradeChecksConfig
+	return &retval, nil
+}
+
+// getVariantPersistedQueryListResponse is returned by getVariantPersistedQueryList on success.
+type getVariantPersistedQueryListResponse struct {
+	// Service by ID
+	Service *getVariantPersistedQueryListService `json:"service"`
+}
+
+// GetService returns getVariantPersistedQueryListResponse.Service, and is useful for accessing the field via an interface.
+func (v *getVariantPersistedQueryListResponse) GetService() *getVariantPersistedQueryListService {
+	return v.Service
+}
+
+// getVariantPersistedQueryListService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getVariantPersistedQueryListService struct {
+	// Provides details of the graph variant with the provided `name`, if a variant
+	// with that name exists for this graph. Otherwise, returns null.
+	//
+	// For a list of _all_ variants associated with a graph, use `Graph.variants` instead.
+	Variant *getVariantPersistedQueryListServiceVariantGraphVariant `json:"variant"`
+}
+
+// GetVariant returns getVariantPersistedQueryListService.Variant, and is useful for accessing the field via an interface.
+func (v *getVariantPersistedQueryListService) GetVariant() *getVariantPersistedQueryListServiceVariantGraphVariant {
+	return v.Variant
+}
+
+// getVariantPersistedQueryListServiceVariantGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type getVariantPersistedQueryListServiceVariantGraphVariant struct {
+	// The Persisted Query List linked to this variant, if any.
+	PersistedQueryList *getVariantPersistedQueryListServiceVariantGraphVariantPersistedQueryList `json:"persistedQueryList"`
+}
+
+// GetPersistedQueryList returns getVariantPersistedQueryListServiceVariantGraphVariant.PersistedQueryList, and is useful for accessing the field via an interface.
+func (v *getVariantPersistedQueryListServiceVariantGraphVariant) GetPersistedQueryList() *getVariantPersistedQueryListServiceVariantGraphVariantPersistedQueryList {
+	return v.PersistedQueryList
+}
+
+// getVariantPersistedQueryListServiceVariantGraphVariantPersistedQueryList includes the requested fields of the GraphQL type PersistedQueryList.
+// The GraphQL type's documentation follows.
+//
+// TODO
+type getVariantPersistedQueryListServiceVariantGraphVariantPersistedQueryList struct {
+	// The immutable ID for this Persisted Query List.
+	Id string `json:"id"`
+}
+
+// GetId returns getVariantPersistedQueryListServiceVariantGraphVariantPersistedQueryList.Id, and is useful for accessing the field via an interface.
+func (v *getVariantPersistedQueryListServiceVariantGraphVariantPersistedQueryList) GetId() string {
+	return v.Id
+}
+
+// getVariantResponse is returned by getVariant on success.
+type getVariantResponse struct {
+	// Service by ID
+	Service *getVariantService `json:"service"`
+}
+
+// GetService returns getVariantResponse.Service, and is useful for accessing the field via an interface.
+func (v *getVariantResponse) GetService() *getVariantService { return v.Service }
+
+// getVariantService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getVariantService struct {
+	// Provides details of the graph variant with the provided `name`, if a variant
+	// with that name exists for this graph. Otherwise, returns null.
+	//
+	// For a list of _all_ variants associated with a graph, use `Graph.variants` instead.
+	Variant *getVariantServiceVariantGraphVariant `json:"variant"`
+}
+
+// GetVariant returns getVariantService.Variant, and is useful for accessing the field via an interface.
+func (v *getVariantService) GetVariant() *getVariantServiceVariantGraphVariant { return v.Variant }
+
+// getVariantServiceVariantGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type getVariantServiceVariantGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns getVariantServiceVariantGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetId() string { return v.Variant.Id }
+
+// GetName returns getVariantServiceVariantGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetName() string { return v.Variant.Name }
+
+// GetIsPublic returns getVariantServiceVariantGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetIsPublic() bool { return v.Variant.IsPublic }
+
+// GetIsPubliclyListed returns getVariantServiceVariantGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns getVariantServiceVariantGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetIsProtected() bool { return v.Variant.IsProtected }
+
+// GetUrl returns getVariantServiceVariantGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetUrl() *string { return v.Variant.Url }
+
+// GetSubscriptionUrl returns getVariantServiceVariantGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns getVariantServiceVariantGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetSendCookies() *bool { return v.Variant.SendCookies }
+
+// GetPreflightScript returns getVariantServiceVariantGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns getVariantServiceVariantGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns getVariantServiceVariantGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns getVariantServiceVariantGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetReadme() VariantReadme { return v.Variant.Readme }
+
+// GetLinks returns getVariantServiceVariantGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns getVariantServiceVariantGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *getVariantServiceVariantGraphVariant) GetGraphId() string { return v.Variant.GraphId }
+
+func (v *getVariantServiceVariantGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getVariantServiceVariantGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getVariantServiceVariantGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetVariantServiceVariantGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *getVariantServiceVariantGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getVariantServiceVariantGraphVariant) __premarshalJSON() (*__premarshalgetVariantServiceVariantGraphVariant, error) {
+	var retval __premarshalgetVariantServiceVariantGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// getWebhookChannelResponse is returned by getWebhookChannel on success.
+type getWebhookChannelResponse struct {
+	// Service by ID
+	Service *getWebhookChannelService `json:"service"`
+}
+
+// GetService returns getWebhookChannelResponse.Service, and is useful for accessing the field via an interface.
+func (v *getWebhookChannelResponse) GetService() *getWebhookChannelService { return v.Service }
+
+// getWebhookChannelService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type getWebhookChannelService struct {
+	// Get available notification endpoints
+	Channels []getWebhookChannelServiceChannelsChannel `json:"-"`
+}
+
+// GetChannels returns getWebhookChannelService.Channels, and is useful for accessing the field via an interface.
+func (v *getWebhookChannelService) GetChannels() []getWebhookChannelServiceChannelsChannel {
+	return v.Channels
+}
+
+func (v *getWebhookChannelService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getWebhookChannelService
+		Channels []json.RawMessage `json:"channels"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getWebhookChannelService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Channels
+		src := firstPass.Channels
+		*dst = make(
+			[]getWebhookChannelServiceChannelsChannel,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalgetWebhookChannelServiceChannelsChannel(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"Unable to unmarshal getWebhookChannelService.Channels: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetWebhookChannelService struct {
+	Channels []json.RawMessage `json:"channels"`
+}
+
+func (v *getWebhookChannelService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getWebhookChannelService) __premarshalJSON() (*__premarshalgetWebhookChannelService, error) {
+	var retval __premarshalgetWebhookChannelService
+
+	{
+
+		dst := &retval.Channels
+		src := v.Channels
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalgetWebhookChannelServiceChannelsChannel(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal getWebhookChannelService.Channels: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// getWebhookChannelServiceChannelsChannel includes the requested fields of the GraphQL interface Channel.
+//
+// getWebhookChannelServiceChannelsChannel is implemented by the following types:
+// getWebhookChannelServiceChannelsPagerDutyChannel
+// getWebhookChannelServiceChannelsSlackChannel
+// getWebhookChannelServiceChannelsWebhookChannel
+// The GraphQL type's documentation follows.
+//
+// Destination for notifications
+type getWebhookChannelServiceChannelsChannel interface {
+	implementsGraphQLInterfacegetWebhookChannelServiceChannelsChannel()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *getWebhookChannelServiceChannelsPagerDutyChannel) implementsGraphQLInterfacegetWebhookChannelServiceChannelsChannel() {
+}
+func (v *getWebhookChannelServiceChannelsSlackChannel) implementsGraphQLInterfacegetWebhookChannelServiceChannelsChannel() {
+}
+func (v *getWebhookChannelServiceChannelsWebhookChannel) implementsGraphQLInterfacegetWebhookChannelServiceChannelsChannel() {
+}
+
+func __unmarshalgetWebhookChannelServiceChannelsChannel(b []byte, v *getWebhookChannelServiceChannelsChannel) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "PagerDutyChannel":
+		*v = new(getWebhookChannelServiceChannelsPagerDutyChannel)
+		return json.Unmarshal(b, *v)
+	case "SlackChannel":
+		*v = new(getWebhookChannelServiceChannelsSlackChannel)
+		return json.Unmarshal(b, *v)
+	case "WebhookChannel":
+		*v = new(getWebhookChannelServiceChannelsWebhookChannel)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Channel.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for getWebhookChannelServiceChannelsChannel: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgetWebhookChannelServiceChannelsChannel(v *getWebhookChannelServiceChannelsChannel) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *getWebhookChannelServiceChannelsPagerDutyChannel:
+		typename = "PagerDutyChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getWebhookChannelServiceChannelsPagerDutyChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case *getWebhookChannelServiceChannelsSlackChannel:
+		typename = "SlackChannel"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*getWebhookChannelServiceChannelsSlackChannel
+		}{typename, v}
+		return json.Marshal(result)
+	case *getWebhookChannelServiceChannelsWebhookChannel:
+		typename = "WebhookChannel"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalgetWebhookChannelServiceChannelsWebhookChannel
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for getWebhookChannelServiceChannelsChannel: "%T"`, v)
+	}
+}
+
+// getWebhookChannelServiceChannelsPagerDutyChannel includes the requested fields of the GraphQL type PagerDutyChannel.
+// The GraphQL type's documentation follows.
+//
+// PagerDuty notification channel
+type getWebhookChannelServiceChannelsPagerDutyChannel struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getWebhookChannelServiceChannelsPagerDutyChannel.Typename, and is useful for accessing the field via an interface.
+func (v *getWebhookChannelServiceChannelsPagerDutyChannel) GetTypename() string { return v.Typename }
+
+// getWebhookChannelServiceChannelsSlackChannel includes the requested fields of the GraphQL type SlackChannel.
+// The GraphQL type's documentation follows.
+//
+// Slack notification channel
+type getWebhookChannelServiceChannelsSlackChannel struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns getWebhookChannelServiceChannelsSlackChannel.Typename, and is useful for accessing the field via an interface.
+func (v *getWebhookChannelServiceChannelsSlackChannel) GetTypename() string { return v.Typename }
+
+// getWebhookChannelServiceChannelsWebhookChannel includes the requested fields of the GraphQL type WebhookChannel.
+// The GraphQL type's documentation follows.
+//
+// Webhook notification channel
+type getWebhookChannelServiceChannelsWebhookChannel struct {
+	Typename       string `json:"__typename"`
+	WebhookChannel `json:"-"`
+}
+
+// GetTypename returns getWebhookChannelServiceChannelsWebhookChannel.Typename, and is useful for accessing the field via an interface.
+func (v *getWebhookChannelServiceChannelsWebhookChannel) GetTypename() string { return v.Typename }
+
+// GetId returns getWebhookChannelServiceChannelsWebhookChannel.Id, and is useful for accessing the field via an interface.
+func (v *getWebhookChannelServiceChannelsWebhookChannel) GetId() string { return v.WebhookChannel.Id }
+
+// GetName returns getWebhookChannelServiceChannelsWebhookChannel.Name, and is useful for accessing the field via an interface.
+func (v *getWebhookChannelServiceChannelsWebhookChannel) GetName() string {
+	return v.WebhookChannel.Name
+}
+
+// GetUrl returns getWebhookChannelServiceChannelsWebhookChannel.Url, and is useful for accessing the field via an interface.
+func (v *getWebhookChannelServiceChannelsWebhookChannel) GetUrl() string { return v.WebhookChannel.Url }
+
+// GetSecretToken returns getWebhookChannelServiceChannelsWebhookChannel.SecretToken, and is useful for accessing the field via an interface.
+func (v *getWebhookChannelServiceChannelsWebhookChannel) GetSecretToken() *string {
+	return v.WebhookChannel.SecretToken
+}
+
+func (v *getWebhookChannelServiceChannelsWebhookChannel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*getWebhookChannelServiceChannelsWebhookChannel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getWebhookChannelServiceChannelsWebhookChannel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WebhookChannel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalgetWebhookChannelServiceChannelsWebhookChannel struct {
+	Typename string `json:"__typename"`
+
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Url string `json:"url"`
+
+	SecretToken *string `json:"secretToken"`
+}
+
+func (v *getWebhookChannelServiceChannelsWebhookChannel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getWebhookChannelServiceChannelsWebhookChannel) __premarshalJSON() (*__premarshalgetWebhookChannelServiceChannelsWebhookChannel, error) {
+	var retval __premarshalgetWebhookChannelServiceChannelsWebhookChannel
+
+	retval.Typename = v.Typename
+	retval.Id = v.WebhookChannel.Id
+	retval.Name = v.WebhookChannel.Name
+	retval.Url = v.WebhookChannel.Url
+	retval.SecretToken = v.WebhookChannel.SecretToken
+	return &retval, nil
+}
+
+// linkPersistedQueryListResponse is returned by linkPersistedQueryList on success.
+type linkPersistedQueryListResponse struct {
+	Service linkPersistedQueryListServiceServiceMutation `json:"service"`
+}
+
+// GetService returns linkPersistedQueryListResponse.Service, and is useful for accessing the field via an interface.
+func (v *linkPersistedQueryListResponse) GetService() linkPersistedQueryListServiceServiceMutation {
+	return v.Service
+}
+
+// linkPersistedQueryListServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type linkPersistedQueryListServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns linkPersistedQueryListServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *linkPersistedQueryListServiceServiceMutation) GetVariant() linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation struct {
+	LinkPersistedQueryList linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError `json:"-"`
+}
+
+// GetLinkPersistedQueryList returns linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation.LinkPersistedQueryList, and is useful for accessing the field via an interface.
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation) GetLinkPersistedQueryList() linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError {
+	return v.LinkPersistedQueryList
+}
+
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation
+		LinkPersistedQueryList json.RawMessage `json:"linkPersistedQueryList"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.LinkPersistedQueryList
+		src := firstPass.LinkPersistedQueryList
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshallinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation.LinkPersistedQueryList: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshallinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation struct {
+	LinkPersistedQueryList json.RawMessage `json:"linkPersistedQueryList"`
+}
+
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation) __premarshalJSON() (*__premarshallinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation, error) {
+	var retval __premarshallinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation
+
+	{
+
+		dst := &retval.LinkPersistedQueryList
+		src := v.LinkPersistedQueryList
+		var err error
+		*dst, err = __marshallinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation.LinkPersistedQueryList: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResult includes the requested fields of the GraphQL type LinkPersistedQueryListResult.
+type linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResult struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResult.Typename, and is useful for accessing the field via an interface.
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResult) GetTypename() string {
+	return v.Typename
+}
+
+// linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError includes the requested fields of the GraphQL interface LinkPersistedQueryListResultOrError.
+//
+// linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError is implemented by the following types:
+// linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResult
+// linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListListNotFoundError
+// linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListPermissionError
+// linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListVariantAlreadyLinkedError
+type linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError interface {
+	implementsGraphQLInterfacelinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResult) implementsGraphQLInterfacelinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError() {
+}
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListListNotFoundError) implementsGraphQLInterfacelinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError() {
+}
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListPermissionError) implementsGraphQLInterfacelinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError() {
+}
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListVariantAlreadyLinkedError) implementsGraphQLInterfacelinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError() {
+}
+
+func __unmarshallinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError(b []byte, v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "LinkPersistedQueryListResult":
+		*v = new(linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResult)
+		return json.Unmarshal(b, *v)
+	case "ListNotFoundError":
+		*v = new(linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListListNotFoundError)
+		return json.Unmarshal(b, *v)
+	case "PermissionError":
+		*v = new(linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListPermissionError)
+		return json.Unmarshal(b, *v)
+	case "VariantAlreadyLinkedError":
+		*v = new(linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListVariantAlreadyLinkedError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing LinkPersistedQueryListResultOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshallinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError(v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResult:
+		typename = "LinkPersistedQueryListResult"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResult
+		}{typename, v}
+		return json.Marshal(result)
+	case *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListListNotFoundError:
+		typename = "ListNotFoundError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListListNotFoundError
+		}{typename, v}
+		return json.Marshal(result)
+	case *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListPermissionError:
+		typename = "PermissionError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListPermissionError
+		}{typename, v}
+		return json.Marshal(result)
+	case *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListVariantAlreadyLinkedError:
+		typename = "VariantAlreadyLinkedError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListVariantAlreadyLinkedError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListLinkPersistedQueryListResultOrError: "%T"`, v)
+	}
+}
+
+// linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListListNotFoundError includes the requested fields of the GraphQL type ListNotFoundError.
+type linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListListNotFoundError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListListNotFoundError.Typename, and is useful for accessing the field via an interface.
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListListNotFoundError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListListNotFoundError.Message, and is useful for accessing the field via an interface.
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListListNotFoundError) GetMessage() string {
+	return v.Message
+}
+
+// linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListPermissionError includes the requested fields of the GraphQL type PermissionError.
+// The GraphQL type's documentation follows.
+//
+// An error that's returned when the current user doesn't have sufficient permissions to perform an action.
+type linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListPermissionError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListPermissionError.Typename, and is useful for accessing the field via an interface.
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListPermissionError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListPermissionError.Message, and is useful for accessing the field via an interface.
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListPermissionError) GetMessage() string {
+	return v.Message
+}
+
+// linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListVariantAlreadyLinkedError includes the requested fields of the GraphQL type VariantAlreadyLinkedError.
+type linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListVariantAlreadyLinkedError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListVariantAlreadyLinkedError.Typename, and is useful for accessing the field via an interface.
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListVariantAlreadyLinkedError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListVariantAlreadyLinkedError.Message, and is useful for accessing the field via an interface.
+func (v *linkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationLinkPersistedQueryListVariantAlreadyLinkedError) GetMessage() string {
+	return v.Message
+}
+
+// listKeysResponse is returned by listKeys on success.
+type listKeysResponse struct {
+	// Service by ID
+	Service *listKeysService `json:"service"`
+}
+
+// GetService returns listKeysResponse.Service, and is useful for accessing the field via an interface.
+func (v *listKeysResponse) GetService() *listKeysService { return v.Service }
+
+// listKeysService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type listKeysService struct {
+	// A list of the graph API keys that are active for this graph.
+	ApiKeys []listKeysServiceApiKeysGraphApiKey `json:"apiKeys"`
+}
+
+// GetApiKeys returns listKeysService.ApiKeys, and is useful for accessing the field via an interface.
+func (v *listKeysService) GetApiKeys() []listKeysServiceApiKeysGraphApiKey { return v.ApiKeys }
+
+// listKeysServiceApiKeysGraphApiKey includes the requested fields of the GraphQL type GraphApiKey.
+// The GraphQL type's documentation follows.
+//
+// Represents a graph API key, which has permissions scoped to a
+// user role for a single Apollo graph.
+type listKeysServiceApiKeysGraphApiKey struct {
+	Key `json:"-"`
+}
+
+// GetId returns listKeysServiceApiKeysGraphApiKey.Id, and is useful for accessing the field via an interface.
+func (v *listKeysServiceApiKeysGraphApiKey) GetId() string { return v.Key.Id }
+
+// GetKeyName returns listKeysServiceApiKeysGraphApiKey.KeyName, and is useful for accessing the field via an interface.
+func (v *listKeysServiceApiKeysGraphApiKey) GetKeyName() string { return v.Key.KeyName }
+
+// GetRole returns listKeysServiceApiKeysGraphApiKey.Role, and is useful for accessing the field via an interface.
+func (v *listKeysServiceApiKeysGraphApiKey) GetRole() string { return v.Key.Role }
+
+// GetToken returns listKeysServiceApiKeysGraphApiKey.Token, and is useful for accessing the field via an interface.
+func (v *listKeysServiceApiKeysGraphApiKey) GetToken() string { return v.Key.Token }
+
+func (v *listKeysServiceApiKeysGraphApiKey) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*listKeysServiceApiKeysGraphApiKey
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.listKeysServiceApiKeysGraphApiKey = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Key)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshallistKeysServiceApiKeysGraphApiKey struct {
+	Id string `json:"id"`
+
+	KeyName string `json:"keyName"`
+
+	Role string `json:"role"`
+
+	Token string `json:"token"`
+}
+
+func (v *listKeysServiceApiKeysGraphApiKey) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *listKeysServiceApiKeysGraphApiKey) __premarshalJSON() (*__premarshallistKeysServiceApiKeysGraphApiKey, error) {
+	var retval __premarshallistKeysServiceApiKeysGraphApiKey
+
+	retval.Id = v.Key.Id
+	retval.KeyName = v.Key.KeyName
+	retval.Role = v.Key.Role
+	retval.Token = v.Key.Token
+	return &retval, nil
+}
+
+// publishOperationsResponse is returned by publishOperations on success.
+type publishOperationsResponse struct {
+	Service publishOperationsServiceServiceMutation `json:"service"`
+}
+
+// GetService returns publishOperationsResponse.Service, and is useful for accessing the field via an interface.
+func (v *publishOperationsResponse) GetService() publishOperationsServiceServiceMutation {
+	return v.Service
+}
+
+// publishOperationsServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type publishOperationsServiceServiceMutation struct {
+	// Provides access to mutation fields for modifying a Persisted Query List with the provided ID.
+	PersistedQueryList publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation `json:"persistedQueryList"`
+}
+
+// GetPersistedQueryList returns publishOperationsServiceServiceMutation.PersistedQueryList, and is useful for accessing the field via an interface.
+func (v *publishOperationsServiceServiceMutation) GetPersistedQueryList() publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation {
+	return v.PersistedQueryList
+}
+
+// publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation includes the requested fields of the GraphQL type PersistedQueryListMutation.
+type publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation struct {
+	// Updates this Persisted Query List by publishing a set of operations and
+	// removing other operations. Operations not mentioned remain in the list unchanged.
+	PublishOperations publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError `json:"-"`
+}
+
+// GetPublishOperations returns publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation.PublishOperations, and is useful for accessing the field via an interface.
+func (v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation) GetPublishOperations() publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError {
+	return v.PublishOperations
+}
+
+func (v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation
+		PublishOperations json.RawMessage `json:"publishOperations"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.PublishOperations
+		src := firstPass.PublishOperations
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalpublishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation.PublishOperations: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalpublishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation struct {
+	PublishOperations json.RawMessage `json:"publishOperations"`
+}
+
+func (v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation) __premarshalJSON() (*__premarshalpublishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation, error) {
+	var retval __premarshalpublishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation
+
+	{
+
+		dst := &retval.PublishOperations
+		src := v.PublishOperations
+		var err error
+		*dst, err = __marshalpublishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutation.PublishOperations: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsCannotModifyOperationBodyError includes the requested fields of the GraphQL type CannotModifyOperationBodyError.
+type publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsCannotModifyOperationBodyError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsCannotModifyOperationBodyError.Typename, and is useful for accessing the field via an interface.
+func (v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsCannotModifyOperationBodyError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsCannotModifyOperationBodyError.Message, and is useful for accessing the field via an interface.
+func (v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsCannotModifyOperationBodyError) GetMessage() string {
+	return v.Message
+}
+
+// publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPermissionError includes the requested fields of the GraphQL type PermissionError.
+// The GraphQL type's documentation follows.
+//
+// An error that's returned when the current user doesn't have sufficient permissions to perform an action.
+type publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPermissionError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPermissionError.Typename, and is useful for accessing the field via an interface.
+func (v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPermissionError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPermissionError.Message, and is useful for accessing the field via an interface.
+func (v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPermissionError) GetMessage() string {
+	return v.Message
+}
+
+// publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResult includes the requested fields of the GraphQL type PublishOperationsResult.
+// The GraphQL type's documentation follows.
+//
+// The result of a successful call to PersistedQueryListMutation.publishOperations.
+type publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResult struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResult.Typename, and is useful for accessing the field via an interface.
+func (v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResult) GetTypename() string {
+	return v.Typename
+}
+
+// publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError includes the requested fields of the GraphQL interface PublishOperationsResultOrError.
+//
+// publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError is implemented by the following types:
+// publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsCannotModifyOperationBodyError
+// publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPermissionError
+// publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResult
+// The GraphQL type's documentation follows.
+//
+// The interface returned by PersistedQueryListMutation.publishOperations.
+type publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError interface {
+	implementsGraphQLInterfacepublishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsCannotModifyOperationBodyError) implementsGraphQLInterfacepublishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError() {
+}
+func (v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPermissionError) implementsGraphQLInterfacepublishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError() {
+}
+func (v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResult) implementsGraphQLInterfacepublishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError() {
+}
+
+func __unmarshalpublishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError(b []byte, v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CannotModifyOperationBodyError":
+		*v = new(publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsCannotModifyOperationBodyError)
+		return json.Unmarshal(b, *v)
+	case "PermissionError":
+		*v = new(publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPermissionError)
+		return json.Unmarshal(b, *v)
+	case "PublishOperationsResult":
+		*v = new(publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResult)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing PublishOperationsResultOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalpublishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError(v *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsCannotModifyOperationBodyError:
+		typename = "CannotModifyOperationBodyError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsCannotModifyOperationBodyError
+		}{typename, v}
+		return json.Marshal(result)
+	case *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPermissionError:
+		typename = "PermissionError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPermissionError
+		}{typename, v}
+		return json.Marshal(result)
+	case *publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResult:
+		typename = "PublishOperationsResult"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResult
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for publishOperationsServiceServiceMutationPersistedQueryListPersistedQueryListMutationPublishOperationsPublishOperationsResultOrError: "%T"`, v)
+	}
+}
+
+// publishSubgraphResponse is returned by publishSubgraph on success.
+type publishSubgraphResponse struct {
+	Service publishSubgraphServiceServiceMutation `json:"service"`
+}
+
+// GetService returns publishSubgraphResponse.Service, and is useful for accessing the field via an interface.
+func (v *publishSubgraphResponse) GetService() publishSubgraphServiceServiceMutation {
+	return v.Service
+}
+
+// publishSubgraphServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type publishSubgraphServiceServiceMutation struct {
+	// Publish to a subgraph. If composition is successful, this will update running routers.
+	PublishSubgraph *publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult `json:"publishSubgraph"`
+}
+
+// GetPublishSubgraph returns publishSubgraphServiceServiceMutation.PublishSubgraph, and is useful for accessing the field via an interface.
+func (v *publishSubgraphServiceServiceMutation) GetPublishSubgraph() *publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult {
+	return v.PublishSubgraph
+}
+
+// publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult includes the requested fields of the GraphQL type CompositionAndUpsertResult.
+// The GraphQL type's documentation follows.
+//
+// The result of supergraph composition that Studio performed in response to an attempted publish of a subgraph.
+type publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult struct {
+	SubgraphComposition `json:"-"`
+}
+
+// GetErrors returns publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult.Errors, and is useful for accessing the field via an interface.
+func (v *publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult) GetErrors() []SubgraphCompositionErrorsSchemaCompositionError {
+	return v.SubgraphComposition.Errors
+}
+
+// GetCompositionConfig returns publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult.CompositionConfig, and is useful for accessing the field via an interface.
+func (v *publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult) GetCompositionConfig() *SubgraphCompositionCompositionConfig {
+	return v.SubgraphComposition.CompositionConfig
+}
+
+// GetLaunch returns publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult.Launch, and is useful for accessing the field via an interface.
+func (v *publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult) GetLaunch() *SubgraphCompositionLaunch {
+	return v.SubgraphComposition.Launch
+}
+
+func (v *publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SubgraphComposition)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalpublishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult struct {
+	Errors []SubgraphCompositionErrorsSchemaCompositionError `json:"errors"`
+
+	CompositionConfig *SubgraphCompositionCompositionConfig `json:"compositionConfig"`
+
+	Launch *SubgraphCompositionLaunch `json:"launch"`
+}
+
+func (v *publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *publishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult) __premarshalJSON() (*__premarshalpublishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult, error) {
+	var retval __premarshalpublishSubgraphServiceServiceMutationPublishSubgraphCompositionAndUpsertResult
+
+	retval.Errors = v.SubgraphComposition.Errors
+	retval.CompositionConfig = v.SubgraphComposition.CompositionConfig
+	retval.Launch = v.SubgraphComposition.Launch
+	return &retval, nil
+}
+
+// removeVariantLinkResponse is returned by removeVariantLink on success.
+type removeVariantLinkResponse struct {
+	Service removeVariantLinkServiceServiceMutation `json:"service"`
+}
+
+// GetService returns removeVariantLinkResponse.Service, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkResponse) GetService() removeVariantLinkServiceServiceMutation {
+	return v.Service
+}
+
+// removeVariantLinkServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type removeVariantLinkServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant removeVariantLinkServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns removeVariantLinkServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutation) GetVariant() removeVariantLinkServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// removeVariantLinkServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type removeVariantLinkServiceServiceMutationVariantGraphVariantMutation struct {
+	RemoveLinkFromVariant removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant `json:"removeLinkFromVariant"`
+}
+
+// GetRemoveLinkFromVariant returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutation.RemoveLinkFromVariant, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutation) GetRemoveLinkFromVariant() removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant {
+	return v.RemoveLinkFromVariant
+}
+
+// removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetId() string {
+	return v.Variant.Id
+}
+
+// GetName returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetName() string {
+	return v.Variant.Name
+}
+
+// GetIsPublic returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetIsPublic() bool {
+	return v.Variant.IsPublic
+}
+
+// GetIsPubliclyListed returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetIsProtected() bool {
+	return v.Variant.IsProtected
+}
+
+// GetUrl returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetUrl() *string {
+	return v.Variant.Url
+}
+
+// GetSubscriptionUrl returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetSendCookies() *bool {
+	return v.Variant.SendCookies
+}
+
+// GetPreflightScript returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetReadme() VariantReadme {
+	return v.Variant.Readme
+}
+
+// GetLinks returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) GetGraphId() string {
+	return v.Variant.GraphId
+}
+
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalremoveVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *removeVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant) __premarshalJSON() (*__premarshalremoveVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant, error) {
+	var retval __premarshalremoveVariantLinkServiceServiceMutationVariantGraphVariantMutationRemoveLinkFromVariantGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// resendOrganizationInvitationAccountAccountMutation includes the requested fields of the GraphQL type AccountMutation.
+type resendOrganizationInvitationAccountAccountMutation struct {
+	// Send a new E-mail for an existing invitation
+	ResendInvitation *resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation `json:"resendInvitation"`
+}
+
+// GetResendInvitation returns resendOrganizationInvitationAccountAccountMutation.ResendInvitation, and is useful for accessing the field via an interface.
+func (v *resendOrganizationInvitationAccountAccountMutation) GetResendInvitation() *resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation {
+	return v.ResendInvitation
+}
+
+// resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation includes the requested fields of the GraphQL type AccountInvitation.
+type resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation struct {
+	OrganizationInvitation `json:"-"`
+}
+
+// GetId returns resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation.Id, and is useful for accessing the field via an interface.
+func (v *resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation) GetId() string {
+	return v.OrganizationInvitation.Id
+}
+
+// GetEmail returns resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation.Email, and is useful for accessing the field via an interface.
+func (v *resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation) GetEmail() string {
+	return v.OrganizationInvitation.Email
+}
+
+// GetRole returns resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation.Role, and is useful for accessing the field via an interface.
+func (v *resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation) GetRole() string {
+	return v.OrganizationInvitation.Role
+}
+
+// GetAcceptedAt returns resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation.AcceptedAt, and is useful for accessing the field via an interface.
+func (v *resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation) GetAcceptedAt() *time.Time {
+	return v.OrganizationInvitation.AcceptedAt
+}
+
+func (v *resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationInvitation)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalresendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation struct {
+	Id string `json:"id"`
+
+	Email string `json:"email"`
+
+	Role string `json:"role"`
+
+	AcceptedAt *time.Time `json:"acceptedAt"`
+}
+
+func (v *resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *resendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation) __premarshalJSON() (*__premarshalresendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation, error) {
+	var retval __premarshalresendOrganizationInvitationAccountAccountMutationResendInvitationAccountInvitation
+
+	retval.Id = v.OrganizationInvitation.Id
+	retval.Email = v.OrganizationInvitation.Email
+	retval.Role = v.OrganizationInvitation.Role
+	retval.AcceptedAt = v.OrganizationInvitation.AcceptedAt
+	return &retval, nil
+}
+
+// resendOrganizationInvitationResponse is returned by resendOrganizationInvitation on success.
+type resendOrganizationInvitationResponse struct {
+	Account resendOrganizationInvitationAccountAccountMutation `json:"account"`
+}
+
+// GetAccount returns resendOrganizationInvitationResponse.Account, and is useful for accessing the field via an interface.
+func (v *resendOrganizationInvitationResponse) GetAccount() resendOrganizationInvitationAccountAccountMutation {
+	return v.Account
+}
+
+// transferServiceResponse is returned by transferService on success.
+type transferServiceResponse struct {
+	Service transferServiceServiceServiceMutation `json:"service"`
+}
+
+// GetService returns transferServiceResponse.Service, and is useful for accessing the field via an interface.
+func (v *transferServiceResponse) GetService() transferServiceServiceServiceMutation {
+	return v.Service
+}
+
+// transferServiceServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type transferServiceServiceServiceMutation struct {
+	Transfer *transferServiceServiceServiceMutationTransferService `json:"transfer"`
+}
+
+// GetTransfer returns transferServiceServiceServiceMutation.Transfer, and is useful for accessing the field via an interface.
+func (v *transferServiceServiceServiceMutation) GetTransfer() *transferServiceServiceServiceMutationTransferService {
+	return v.Transfer
+}
+
+// transferServiceServiceServiceMutationTransferService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type transferServiceServiceServiceMutationTransferService struct {
+	Service `json:"-"`
+}
+
+// GetId returns transferServiceServiceServiceMutationTransferService.Id, and is useful for accessing the field via an interface.
+func (v *transferServiceServiceServiceMutationTransferService) GetId() string { return v.Service.Id }
+
+// GetTitle returns transferServiceServiceServiceMutationTransferService.Title, and is useful for accessing the field via an interface.
+func (v *transferServiceServiceServiceMutationTransferService) GetTitle() string {
+	return v.Service.Title
+}
+
+// GetOnboardingArchitecture returns transferServiceServiceServiceMutationTransferService.OnboardingArchitecture, and is useful for accessing the field via an interface.
+func (v *transferServiceServiceServiceMutationTransferService) GetOnboardingArchitecture() string {
+	return v.Service.OnboardingArchitecture
+}
+
+// GetAccountId returns transferServiceServiceServiceMutationTransferService.AccountId, and is useful for accessing the field via an interface.
+func (v *transferServiceServiceServiceMutationTransferService) GetAccountId() string {
+	return v.Service.AccountId
+}
+
+// GetDescription returns transferServiceServiceServiceMutationTransferService.Description, and is useful for accessing the field via an interface.
+func (v *transferServiceServiceServiceMutationTransferService) GetDescription() string {
+	return v.Service.Description
+}
+
+// GetHiddenFromUninvitedNonAdminAccountMembers returns transferServiceServiceServiceMutationTransferService.HiddenFromUninvitedNonAdminAccountMembers, and is useful for accessing the field via an interface.
+func (v *transferServiceServiceServiceMutationTransferService) GetHiddenFromUninvitedNonAdminAccountMembers() bool {
+	return v.Service.HiddenFromUninvitedNonAdminAccountMembers
+}
+
+// GetReadme returns transferServiceServiceServiceMutationTransferService.Readme, and is useful for accessing the field via an interface.
+func (v *transferServiceServiceServiceMutationTransferService) GetReadme() *ServiceReadme {
+	return v.Service.Readme
+}
+
+func (v *transferServiceServiceServiceMutationTransferService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*transferServiceServiceServiceMutationTransferService
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.transferServiceServiceServiceMutationTransferService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Service)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshaltransferServiceServiceServiceMutationTransferService struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	OnboardingArchitecture string `json:"onboardingArchitecture"`
+
+	AccountId string `json:"accountId"`
+
+	Description string `json:"description"`
+
+	HiddenFromUninvitedNonAdminAccountMembers bool `json:"hiddenFromUninvitedNonAdminAccountMembers"`
+
+	Readme *ServiceReadme `json:"readme"`
+}
+
+func (v *transferServiceServiceServiceMutationTransferService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *transferServiceServiceServiceMutationTransferService) __premarshalJSON() (*__premarshaltransferServiceServiceServiceMutationTransferService, error) {
+	var retval __premarshaltransferServiceServiceServiceMutationTransferService
+
+	retval.Id = v.Service.Id
+	retval.Title = v.Service.Title
+	retval.OnboardingArchitecture = v.Service.OnboardingArchitecture
+	retval.AccountId = v.Service.AccountId
+	retval.Description = v.Service.Description
+	retval.HiddenFromUninvitedNonAdminAccountMembers = v.Service.HiddenFromUninvitedNonAdminAccountMembers
+	retval.Readme = v.Service.Readme
+	return &retval, nil
+}
+
+// unlinkPersistedQueryListResponse is returned by unlinkPersistedQueryList on success.
+type unlinkPersistedQueryListResponse struct {
+	Service unlinkPersistedQueryListServiceServiceMutation `json:"service"`
+}
+
+// GetService returns unlinkPersistedQueryListResponse.Service, and is useful for accessing the field via an interface.
+func (v *unlinkPersistedQueryListResponse) GetService() unlinkPersistedQueryListServiceServiceMutation {
+	return v.Service
+}
+
+// unlinkPersistedQueryListServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type unlinkPersistedQueryListServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns unlinkPersistedQueryListServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *unlinkPersistedQueryListServiceServiceMutation) GetVariant() unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation struct {
+	UnlinkPersistedQueryList unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError `json:"-"`
+}
+
+// GetUnlinkPersistedQueryList returns unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation.UnlinkPersistedQueryList, and is useful for accessing the field via an interface.
+func (v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation) GetUnlinkPersistedQueryList() unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError {
+	return v.UnlinkPersistedQueryList
+}
+
+func (v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation
+		UnlinkPersistedQueryList json.RawMessage `json:"unlinkPersistedQueryList"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.UnlinkPersistedQueryList
+		src := firstPass.UnlinkPersistedQueryList
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalunlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation.UnlinkPersistedQueryList: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalunlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation struct {
+	UnlinkPersistedQueryList json.RawMessage `json:"unlinkPersistedQueryList"`
+}
+
+func (v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation) __premarshalJSON() (*__premarshalunlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation, error) {
+	var retval __premarshalunlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation
+
+	{
+
+		dst := &retval.UnlinkPersistedQueryList
+		src := v.UnlinkPersistedQueryList
+		var err error
+		*dst, err = __marshalunlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutation.UnlinkPersistedQueryList: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListPermissionError includes the requested fields of the GraphQL type PermissionError.
+// The GraphQL type's documentation follows.
+//
+// An error that's returned when the current user doesn't have sufficient permissions to perform an action.
+type unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListPermissionError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListPermissionError.Typename, and is useful for accessing the field via an interface.
+func (v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListPermissionError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListPermissionError.Message, and is useful for accessing the field via an interface.
+func (v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListPermissionError) GetMessage() string {
+	return v.Message
+}
+
+// unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResult includes the requested fields of the GraphQL type UnlinkPersistedQueryListResult.
+type unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResult struct {
+	Typename string `json:"__typename"`
+}
+
+// GetTypename returns unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResult.Typename, and is useful for accessing the field via an interface.
+func (v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResult) GetTypename() string {
+	return v.Typename
+}
+
+// unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError includes the requested fields of the GraphQL interface UnlinkPersistedQueryListResultOrError.
+//
+// unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError is implemented by the following types:
+// unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListPermissionError
+// unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResult
+// unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListVariantAlreadyUnlinkedError
+type unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError interface {
+	implementsGraphQLInterfaceunlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListPermissionError) implementsGraphQLInterfaceunlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError() {
+}
+func (v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResult) implementsGraphQLInterfaceunlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError() {
+}
+func (v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListVariantAlreadyUnlinkedError) implementsGraphQLInterfaceunlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError() {
+}
+
+func __unmarshalunlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError(b []byte, v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "PermissionError":
+		*v = new(unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListPermissionError)
+		return json.Unmarshal(b, *v)
+	case "UnlinkPersistedQueryListResult":
+		*v = new(unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResult)
+		return json.Unmarshal(b, *v)
+	case "VariantAlreadyUnlinkedError":
+		*v = new(unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListVariantAlreadyUnlinkedError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing UnlinkPersistedQueryListResultOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalunlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError(v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListPermissionError:
+		typename = "PermissionError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListPermissionError
+		}{typename, v}
+		return json.Marshal(result)
+	case *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResult:
+		typename = "UnlinkPersistedQueryListResult"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResult
+		}{typename, v}
+		return json.Marshal(result)
+	case *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListVariantAlreadyUnlinkedError:
+		typename = "VariantAlreadyUnlinkedError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListVariantAlreadyUnlinkedError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListUnlinkPersistedQueryListResultOrError: "%T"`, v)
+	}
+}
+
+// unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListVariantAlreadyUnlinkedError includes the requested fields of the GraphQL type VariantAlreadyUnlinkedError.
+type unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListVariantAlreadyUnlinkedError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListVariantAlreadyUnlinkedError.Typename, and is useful for accessing the field via an interface.
+func (v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListVariantAlreadyUnlinkedError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListVariantAlreadyUnlinkedError.Message, and is useful for accessing the field via an interface.
+func (v *unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListVariantAlreadyUnlinkedError) GetMessage() string {
+	return v.Message
+}
+
+// updateCheckConfigurationResponse is returned by updateCheckConfiguration on success.
+type updateCheckConfigurationResponse struct {
+	Service updateCheckConfigurationServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateCheckConfigurationResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateCheckConfigurationResponse) GetService() updateCheckConfigurationServiceServiceMutation {
+	return v.Service
+}
+
+// updateCheckConfigurationServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateCheckConfigurationServiceServiceMutation struct {
+	// Update schema check configuration for a graph.
+	UpdateCheckConfiguration updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration `json:"updateCheckConfiguration"`
+}
+
+// GetUpdateCheckConfiguration returns updateCheckConfigurationServiceServiceMutation.UpdateCheckConfiguration, and is useful for accessing the field via an interface.
+func (v *updateCheckConfigurationServiceServiceMutation) GetUpdateCheckConfiguration() updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration {
+	return v.UpdateCheckConfiguration
+}
+
+// updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration includes the requested fields of the GraphQL type CheckConfiguration.
+// The GraphQL type's documentation follows.
+//
+// Graph-level configuration of checks.
+type updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration struct {
+	CheckConfiguration `json:"-"`
+}
+
+// GetTimeRangeSeconds returns updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration.TimeRangeSeconds, and is useful for accessing the field via an interface.
+func (v *updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration) GetTimeRangeSeconds() int64 {
+	return v.CheckConfiguration.TimeRangeSeconds
+}
+
+// GetOperationCountThreshold returns updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration.OperationCountThreshold, and is useful for accessing the field via an interface.
+func (v *updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration) GetOperationCountThreshold() int {
+	return v.CheckConfiguration.OperationCountThreshold
+}
+
+// GetOperationCountThresholdPercentage returns updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration.OperationCountThresholdPercentage, and is useful for accessing the field via an interface.
+func (v *updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration) GetOperationCountThresholdPercentage() float64 {
+	return v.CheckConfiguration.OperationCountThresholdPercentage
+}
+
+// GetIncludeBaseVariant returns updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration.IncludeBaseVariant, and is useful for accessing the field via an interface.
+func (v *updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration) GetIncludeBaseVariant() bool {
+	return v.CheckConfiguration.IncludeBaseVariant
+}
+
+// GetIncludedVariants returns updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration.IncludedVariants, and is useful for accessing the field via an interface.
+func (v *updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration) GetIncludedVariants() []string {
+	return v.CheckConfiguration.IncludedVariants
+}
+
+// GetExcludedClients returns updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration.ExcludedClients, and is useful for accessing the field via an interface.
+func (v *updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration) GetExcludedClients() []CheckConfigurationExcludedClientsClientFilter {
+	return v.CheckConfiguration.ExcludedClients
+}
+
+// GetExcludedOperations returns updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration.ExcludedOperations, and is useful for accessing the field via an interface.
+func (v *updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration) GetExcludedOperations() []CheckConfigurationExcludedOperationsExcludedOperation {
+	return v.CheckConfiguration.ExcludedOperations
+}
+
+// GetDowngradeStaticChecks returns updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration.DowngradeStaticChecks, and is useful for accessing the field via an interface.
+func (v *updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration) GetDowngradeStaticChecks() bool {
+	return v.CheckConfiguration.DowngradeStaticChecks
+}
+
+// GetDowngradeDefaultValueChange returns updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration.DowngradeDefaultValueChange, and is useful for accessing the field via an interface.
+func (v *updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration) GetDowngradeDefaultValueChange() bool {
+	return v.CheckConfiguration.DowngradeDefaultValueChange
+}
+
+func (v *updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.CheckConfiguration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration struct {
+	TimeRangeSeconds int64 `json:"timeRangeSeconds"`
+
+	OperationCountThreshold int `json:"operationCountThreshold"`
+
+	OperationCountThresholdPercentage float64 `json:"operationCountThresholdPercentage"`
+
+	IncludeBaseVariant bool `json:"includeBaseVariant"`
+
+	IncludedVariants []string `json:"includedVariants"`
+
+	ExcludedClients []CheckConfigurationExcludedClientsClientFilter `json:"excludedClients"`
+
+	ExcludedOperations []CheckConfigurationExcludedOperationsExcludedOperation `json:"excludedOperations"`
+
+	DowngradeStaticChecks bool `json:"downgradeStaticChecks"`
+
+	DowngradeDefaultValueChange bool `json:"downgradeDefaultValueChange"`
+}
+
+func (v *updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration) __premarshalJSON() (*__premarshalupdateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration, error) {
+	var retval __premarshalupdateCheckConfigurationServiceServiceMutationUpdateCheckConfiguration
+
+	retval.TimeRangeSeconds = v.CheckConfiguration.TimeRangeSeconds
+	retval.OperationCountThreshold = v.CheckConfiguration.OperationCountThreshold
+	retval.OperationCountThresholdPercentage = v.CheckConfiguration.OperationCountThresholdPercentage
+	retval.IncludeBaseVariant = v.CheckConfiguration.IncludeBaseVariant
+	retval.IncludedVariants = v.CheckConfiguration.IncludedVariants
+	retval.ExcludedClients = v.CheckConfiguration.ExcludedClients
+	retval.ExcludedOperations = v.CheckConfiguration.ExcludedOperations
+	retval.DowngradeStaticChecks = v.CheckConfiguration.DowngradeStaticChecks
+	retval.DowngradeDefaultValueChange = v.CheckConfiguration.DowngradeDefaultValueChange
+	return &retval, nil
+}
+
+// updateGraphUserPermissionResponse is returned by updateGraphUserPermission on success.
+type updateGraphUserPermissionResponse struct {
+	Service updateGraphUserPermissionServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateGraphUserPermissionResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateGraphUserPermissionResponse) GetService() updateGraphUserPermissionServiceServiceMutation {
+	return v.Service
+}
+
+// updateGraphUserPermissionServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateGraphUserPermissionServiceServiceMutation struct {
+	// Adds an override to the given users permission for this graph
+	OverrideUserPermission *updateGraphUserPermissionServiceServiceMutationOverrideUserPermissionService `json:"overrideUserPermission"`
+}
+
+// GetOverrideUserPermission returns updateGraphUserPermissionServiceServiceMutation.OverrideUserPermission, and is useful for accessing the field via an interface.
+func (v *updateGraphUserPermissionServiceServiceMutation) GetOverrideUserPermission() *updateGraphUserPermissionServiceServiceMutationOverrideUserPermissionService {
+	return v.OverrideUserPermission
+}
+
+// updateGraphUserPermissionServiceServiceMutationOverrideUserPermissionService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type updateGraphUserPermissionServiceServiceMutationOverrideUserPermissionService struct {
+	// The graph's globally unique identifier.
+	Id string `json:"id"`
+}
+
+// GetId returns updateGraphUserPermissionServiceServiceMutationOverrideUserPermissionService.Id, and is useful for accessing the field via an interface.
+func (v *updateGraphUserPermissionServiceServiceMutationOverrideUserPermissionService) GetId() string {
+	return v.Id
+}
+
+// updateKeyResponse is returned by updateKey on success.
+type updateKeyResponse struct {
+	Service updateKeyServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateKeyResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateKeyResponse) GetService() updateKeyServiceServiceMutation { return v.Service }
+
+// updateKeyServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateKeyServiceServiceMutation struct {
+	// Sets a new name for the graph API key with the provided ID, if any. This does not invalidate the key or change its value.
+	RenameKey updateKeyServiceServiceMutationRenameKeyGraphApiKey `json:"renameKey"`
+}
+
+// GetRenameKey returns updateKeyServiceServiceMutation.RenameKey, and is useful for accessing the field via an interface.
+func (v *updateKeyServiceServiceMutation) GetRenameKey() updateKeyServiceServiceMutationRenameKeyGraphApiKey {
+	return v.RenameKey
+}
+
+// updateKeyServiceServiceMutationRenameKeyGraphApiKey includes the requested fields of the GraphQL type GraphApiKey.
+// The GraphQL type's documentation follows.
+//
+// Represents a graph API key, which has permissions scoped to a
+// user role for a single Apollo graph.
+type updateKeyServiceServiceMutationRenameKeyGraphApiKey struct {
+	Key `json:"-"`
+}
+
+// GetId returns updateKeyServiceServiceMutationRenameKeyGraphApiKey.Id, and is useful for accessing the field via an interface.
+func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) GetId() string { return v.Key.Id }
+
+// GetKeyName returns updateKeyServiceServiceMutationRenameKeyGraphApiKey.KeyName, and is useful for accessing the field via an interface.
+func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) GetKeyName() string {
+	return v.Key.KeyName
+}
+
+// GetRole returns updateKeyServiceServiceMutationRenameKeyGraphApiKey.Role, and is useful for accessing the field via an interface.
+func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) GetRole() string { return v.Key.Role }
+
+// GetToken returns updateKeyServiceServiceMutationRenameKeyGraphApiKey.Token, and is useful for accessing the field via an interface.
+func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) GetToken() string { return v.Key.Token }
+
+func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateKeyServiceServiceMutationRenameKeyGraphApiKey
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateKeyServiceServiceMutationRenameKeyGraphApiKey = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Key)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateKeyServiceServiceMutationRenameKeyGraphApiKey struct {
+	Id string `json:"id"`
+
+	KeyName string `json:"keyName"`
+
+	Role string `json:"role"`
+
+	Token string `json:"token"`
+}
+
+func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateKeyServiceServiceMutationRenameKeyGraphApiKey) __premarshalJSON() (*__premarshalupdateKeyServiceServiceMutationRenameKeyGraphApiKey, error) {
+	var retval __premarshalupdateKeyServiceServiceMutationRenameKeyGraphApiKey
+
+	retval.Id = v.Key.Id
+	retval.KeyName = v.Key.KeyName
+	retval.Role = v.Key.Role
+	retval.Token = v.Key.Token
+	return &retval, nil
+}
+
+// updateLinterConfigurationResponse is returned by updateLinterConfiguration on success.
+type updateLinterConfigurationResponse struct {
+	Service updateLinterConfigurationServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateLinterConfigurationResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateLinterConfigurationResponse) GetService() updateLinterConfigurationServiceServiceMutation {
+	return v.Service
+}
+
+// updateLinterConfigurationServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateLinterConfigurationServiceServiceMutation struct {
+	// Update the linter configuration for this graph.
+	UpdateLinterConfiguration updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration `json:"updateLinterConfiguration"`
+}
+
+// GetUpdateLinterConfiguration returns updateLinterConfigurationServiceServiceMutation.UpdateLinterConfiguration, and is useful for accessing the field via an interface.
+func (v *updateLinterConfigurationServiceServiceMutation) GetUpdateLinterConfiguration() updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration {
+	return v.UpdateLinterConfiguration
+}
+
+// updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration includes the requested fields of the GraphQL type GraphLinterConfiguration.
+// The GraphQL type's documentation follows.
+//
+// The linter configuration for this graph.
+type updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration struct {
+	LinterConfiguration `json:"-"`
+}
+
+// GetAllowedTagNames returns updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration.AllowedTagNames, and is useful for accessing the field via an interface.
+func (v *updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration) GetAllowedTagNames() []string {
+	return v.LinterConfiguration.AllowedTagNames
+}
+
+// GetIgnoreDeprecated returns updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration.IgnoreDeprecated, and is useful for accessing the field via an interface.
+func (v *updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration) GetIgnoreDeprecated() bool {
+	return v.LinterConfiguration.IgnoreDeprecated
+}
+
+// GetIgnoreInaccessible returns updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration.IgnoreInaccessible, and is useful for accessing the field via an interface.
+func (v *updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration) GetIgnoreInaccessible() bool {
+	return v.LinterConfiguration.IgnoreInaccessible
+}
+
+// GetRules returns updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration.Rules, and is useful for accessing the field via an interface.
+func (v *updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration) GetRules() []LinterConfigurationRulesLinterRuleLevelConfiguration {
+	return v.LinterConfiguration.Rules
+}
+
+func (v *updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.LinterConfiguration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration struct {
+	AllowedTagNames []string `json:"allowedTagNames"`
+
+	IgnoreDeprecated bool `json:"ignoreDeprecated"`
+
+	IgnoreInaccessible bool `json:"ignoreInaccessible"`
+
+	Rules []LinterConfigurationRulesLinterRuleLevelConfiguration `json:"rules"`
+}
+
+func (v *updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration) __premarshalJSON() (*__premarshalupdateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration, error) {
+	var retval __premarshalupdateLinterConfigurationServiceServiceMutationUpdateLinterConfigurationGraphLinterConfiguration
+
+	retval.AllowedTagNames = v.LinterConfiguration.AllowedTagNames
+	retval.IgnoreDeprecated = v.LinterConfiguration.IgnoreDeprecated
+	retval.IgnoreInaccessible = v.LinterConfiguration.IgnoreInaccessible
+	retval.Rules = v.LinterConfiguration.Rules
+	return &retval, nil
+}
+
+// updateOrganizationMemberAccountAccountMutation includes the requested fields of the GraphQL type AccountMutation.
+type updateOrganizationMemberAccountAccountMutation struct {
+	// A (currently) internal to Apollo mutation to update a user's role within an organization
+	UpdateUserPermission *updateOrganizationMemberAccountAccountMutationUpdateUserPermissionUser `json:"updateUserPermission"`
+}
+
+// GetUpdateUserPermission returns updateOrganizationMemberAccountAccountMutation.UpdateUserPermission, and is useful for accessing the field via an interface.
+func (v *updateOrganizationMemberAccountAccountMutation) GetUpdateUserPermission() *updateOrganizationMemberAccountAccountMutationUpdateUserPermissionUser {
+	return v.UpdateUserPermission
+}
+
+// updateOrganizationMemberAccountAccountMutationUpdateUserPermissionUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A registered Apollo Studio user.
+type updateOrganizationMemberAccountAccountMutationUpdateUserPermissionUser struct {
+	// The user's unique ID.
+	Id string `json:"id"`
+}
+
+// GetId returns updateOrganizationMemberAccountAccountMutationUpdateUserPermissionUser.Id, and is useful for accessing the field via an interface.
+func (v *updateOrganizationMemberAccountAccountMutationUpdateUserPermissionUser) GetId() string {
+	return v.Id
+}
+
+// updateOrganizationMemberResponse is returned by updateOrganizationMember on success.
+type updateOrganizationMemberResponse struct {
+	Account updateOrganizationMemberAccountAccountMutation `json:"account"`
+}
+
+// GetAccount returns updateOrganizationMemberResponse.Account, and is useful for accessing the field via an interface.
+func (v *updateOrganizationMemberResponse) GetAccount() updateOrganizationMemberAccountAccountMutation {
+	return v.Account
+}
+
+// updatePersistedQueryListResponse is returned by updatePersistedQueryList on success.
+type updatePersistedQueryListResponse struct {
+	Service updatePersistedQueryListServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updatePersistedQueryListResponse.Service, and is useful for accessing the field via an interface.
+func (v *updatePersistedQueryListResponse) GetService() updatePersistedQueryListServiceServiceMutation {
+	return v.Service
+}
+
+// updatePersistedQueryListServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updatePersistedQueryListServiceServiceMutation struct {
+	// Provides access to mutation fields for modifying a Persisted Query List with the provided ID.
+	PersistedQueryList updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation `json:"persistedQueryList"`
+}
+
+// GetPersistedQueryList returns updatePersistedQueryListServiceServiceMutation.PersistedQueryList, and is useful for accessing the field via an interface.
+func (v *updatePersistedQueryListServiceServiceMutation) GetPersistedQueryList() updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation {
+	return v.PersistedQueryList
+}
+
+// updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation includes the requested fields of the GraphQL type PersistedQueryListMutation.
+type updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation struct {
+	UpdateMetadata updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError `json:"-"`
+}
+
+// GetUpdateMetadata returns updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation.UpdateMetadata, and is useful for accessing the field via an interface.
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation) GetUpdateMetadata() updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError {
+	return v.UpdateMetadata
+}
+
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation
+		UpdateMetadata json.RawMessage `json:"updateMetadata"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.UpdateMetadata
+		src := firstPass.UpdateMetadata
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation.UpdateMetadata: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation struct {
+	UpdateMetadata json.RawMessage `json:"updateMetadata"`
+}
+
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation) __premarshalJSON() (*__premarshalupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation, error) {
+	var retval __premarshalupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation
+
+	{
+
+		dst := &retval.UpdateMetadata
+		src := v.UpdateMetadata
+		var err error
+		*dst, err = __marshalupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutation.UpdateMetadata: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataPermissionError includes the requested fields of the GraphQL type PermissionError.
+// The GraphQL type's documentation follows.
+//
+// An error that's returned when the current user doesn't have sufficient permissions to perform an action.
+type updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataPermissionError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataPermissionError.Typename, and is useful for accessing the field via an interface.
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataPermissionError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataPermissionError.Message, and is useful for accessing the field via an interface.
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataPermissionError) GetMessage() string {
+	return v.Message
+}
+
+// updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResult includes the requested fields of the GraphQL type UpdatePersistedQueryListMetadataResult.
+type updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResult struct {
+	Typename           string                                                                                                                                                           `json:"__typename"`
+	PersistedQueryList updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList `json:"persistedQueryList"`
+}
+
+// GetTypename returns updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResult.Typename, and is useful for accessing the field via an interface.
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResult) GetTypename() string {
+	return v.Typename
+}
+
+// GetPersistedQueryList returns updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResult.PersistedQueryList, and is useful for accessing the field via an interface.
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResult) GetPersistedQueryList() updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList {
+	return v.PersistedQueryList
+}
+
+// updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError includes the requested fields of the GraphQL interface UpdatePersistedQueryListMetadataResultOrError.
+//
+// updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError is implemented by the following types:
+// updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataPermissionError
+// updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResult
+type updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError interface {
+	implementsGraphQLInterfaceupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataPermissionError) implementsGraphQLInterfaceupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError() {
+}
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResult) implementsGraphQLInterfaceupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError() {
+}
+
+func __unmarshalupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError(b []byte, v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "PermissionError":
+		*v = new(updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataPermissionError)
+		return json.Unmarshal(b, *v)
+	case "UpdatePersistedQueryListMetadataResult":
+		*v = new(updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResult)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing UpdatePersistedQueryListMetadataResultOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError(v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataPermissionError:
+		typename = "PermissionError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataPermissionError
+		}{typename, v}
+		return json.Marshal(result)
+	case *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResult:
+		typename = "UpdatePersistedQueryListMetadataResult"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResult
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultOrError: "%T"`, v)
+	}
+}
+
+// updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList includes the requested fields of the GraphQL type PersistedQueryList.
+// The GraphQL type's documentation follows.
+//
+// TODO
+type updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList struct {
+	PersistedQueryList `json:"-"`
+}
+
+// GetId returns updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList.Id, and is useful for accessing the field via an interface.
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList) GetId() string {
+	return v.PersistedQueryList.Id
+}
+
+// GetName returns updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList.Name, and is useful for accessing the field via an interface.
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList) GetName() string {
+	return v.PersistedQueryList.Name
+}
+
+// GetDescription returns updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList.Description, and is useful for accessing the field via an interface.
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList) GetDescription() string {
+	return v.PersistedQueryList.Description
+}
+
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PersistedQueryList)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+}
+
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList) __premarshalJSON() (*__premarshalupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList, error) {
+	var retval __premarshalupdatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResultPersistedQueryList
+
+	retval.Id = v.PersistedQueryList.Id
+	retval.Name = v.PersistedQueryList.Name
+	retval.Description = v.PersistedQueryList.Description
+	return &retval, nil
+}
+
+// updateServiceDescriptionResponse is returned by updateServiceDescription on success.
+type updateServiceDescriptionResponse struct {
+	Service updateServiceDescriptionServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateServiceDescriptionResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateServiceDescriptionResponse) GetService() updateServiceDescriptionServiceServiceMutation {
+	return v.Service
+}
+
+// updateServiceDescriptionServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateServiceDescriptionServiceServiceMutation struct {
+	UpdateDescription updateServiceDescriptionServiceServiceMutationUpdateDescriptionService `json:"updateDescription"`
+}
+
+// GetUpdateDescription returns updateServiceDescriptionServiceServiceMutation.UpdateDescription, and is useful for accessing the field via an interface.
+func (v *updateServiceDescriptionServiceServiceMutation) GetUpdateDescription() updateServiceDescriptionServiceServiceMutationUpdateDescriptionService {
+	return v.UpdateDescription
+}
+
+// updateServiceDescriptionServiceServiceMutationUpdateDescriptionService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type updateServiceDescriptionServiceServiceMutationUpdateDescriptionService struct {
+	Service `json:"-"`
+}
+
+// GetId returns updateServiceDescriptionServiceServiceMutationUpdateDescriptionService.Id, and is useful for accessing the field via an interface.
+func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) GetId() string {
+	return v.Service.Id
+}
+
+// GetTitle returns updateServiceDescriptionServiceServiceMutationUpdateDescriptionService.Title, and is useful for accessing the field via an interface.
+func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) GetTitle() string {
+	return v.Service.Title
+}
+
+// GetOnboardingArchitecture returns updateServiceDescriptionServiceServiceMutationUpdateDescriptionService.OnboardingArchitecture, and is useful for accessing the field via an interface.
+func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) GetOnboardingArchitecture() string {
+	return v.Service.OnboardingArchitecture
+}
+
+// GetAccountId returns updateServiceDescriptionServiceServiceMutationUpdateDescriptionService.AccountId, and is useful for accessing the field via an interface.
+func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) GetAccountId() string {
+	return v.Service.AccountId
+}
+
+// GetDescription returns updateServiceDescriptionServiceServiceMutationUpdateDescriptionService.Description, and is useful for accessing the field via an interface.
+func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) GetDescription() string {
+	return v.Service.Description
+}
+
+// GetHiddenFromUninvitedNonAdminAccountMembers returns updateServiceDescriptionServiceServiceMutationUpdateDescriptionService.HiddenFromUninvitedNonAdminAccountMembers, and is useful for accessing the field via an interface.
+func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) GetHiddenFromUninvitedNonAdminAccountMembers() bool {
+	return v.Service.HiddenFromUninvitedNonAdminAccountMembers
+}
+
+// GetReadme returns updateServiceDescriptionServiceServiceMutationUpdateDescriptionService.Readme, and is useful for accessing the field via an interface.
+func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) GetReadme() *ServiceReadme {
+	return v.Service.Readme
+}
+
+func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateServiceDescriptionServiceServiceMutationUpdateDescriptionService
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateServiceDescriptionServiceServiceMutationUpdateDescriptionService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Service)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateServiceDescriptionServiceServiceMutationUpdateDescriptionService struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	OnboardingArchitecture string `json:"onboardingArchitecture"`
+
+	AccountId string `json:"accountId"`
+
+	Description string `json:"description"`
+
+	HiddenFromUninvitedNonAdminAccountMembers bool `json:"hiddenFromUninvitedNonAdminAccountMembers"`
+
+	Readme *ServiceReadme `json:"readme"`
+}
+
+func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateServiceDescriptionServiceServiceMutationUpdateDescriptionService) __premarshalJSON() (*__premarshalupdateServiceDescriptionServiceServiceMutationUpdateDescriptionService, error) {
+	var retval __premarshalupdateServiceDescriptionServiceServiceMutationUpdateDescriptionService
+
+	retval.Id = v.Service.Id
+	retval.Title = v.Service.Title
+	retval.OnboardingArchitecture = v.Service.OnboardingArchitecture
+	retval.AccountId = v.Service.AccountId
+	retval.Description = v.Service.Description
+	retval.HiddenFromUninvitedNonAdminAccountMembers = v.Service.HiddenFromUninvitedNonAdminAccountMembers
+	retval.Readme = v.Service.Readme
+	return &retval, nil
+}
+
+// updateServiceHiddenFromUninvitedNonAdminAccountMembersResponse is returned by updateServiceHiddenFromUninvitedNonAdminAccountMembers on success.
+type updateServiceHiddenFromUninvitedNonAdminAccountMembersResponse struct {
+	Service updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateServiceHiddenFromUninvitedNonAdminAccountMembersResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateServiceHiddenFromUninvitedNonAdminAccountMembersResponse) GetService() updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutation {
+	return v.Service
+}
+
+// updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutation struct {
+	// Update hiddenFromUninvitedNonAdminAccountMembers
+	UpdateHiddenFromUninvitedNonAdminAccountMembers updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService `json:"updateHiddenFromUninvitedNonAdminAccountMembers"`
+}
+
+// GetUpdateHiddenFromUninvitedNonAdminAccountMembers returns updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutation.UpdateHiddenFromUninvitedNonAdminAccountMembers, and is useful for accessing the field via an interface.
+func (v *updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutation) GetUpdateHiddenFromUninvitedNonAdminAccountMembers() updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService {
+	return v.UpdateHiddenFromUninvitedNonAdminAccountMembers
+}
+
+// updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService struct {
+	Service `json:"-"`
+}
+
+// GetId returns updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService.Id, and is useful for accessing the field via an interface.
+func (v *updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService) GetId() string {
+	return v.Service.Id
+}
+
+// GetTitle returns updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService.Title, and is useful for accessing the field via an interface.
+func (v *updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService) GetTitle() string {
+	return v.Service.Title
+}
+
+// GetOnboardingArchitecture returns updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService.OnboardingArchitecture, and is useful for accessing the field via an interface.
+func (v *updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService) GetOnboardingArchitecture() string {
+	return v.Service.OnboardingArchitecture
+}
+
+// GetAccountId returns updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService.AccountId, and is useful for accessing the field via an interface.
+func (v *updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService) GetAccountId() string {
+	return v.Service.AccountId
+}
+
+// GetDescription returns updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService.Description, and is useful for accessing the field via an interface.
+func (v *updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService) GetDescription() string {
+	return v.Service.Description
+}
+
+// GetHiddenFromUninvitedNonAdminAccountMembers returns updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService.HiddenFromUninvitedNonAdminAccountMembers, and is useful for accessing the field via an interface.
+func (v *updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService) GetHiddenFromUninvitedNonAdminAccountMembers() bool {
+	return v.Service.HiddenFromUninvitedNonAdminAccountMembers
+}
+
+// GetReadme returns updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService.Readme, and is useful for accessing the field via an interface.
+func (v *updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService) GetReadme() *ServiceReadme {
+	return v.Service.Readme
+}
+
+func (v *updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Service)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	OnboardingArchitecture string `json:"onboardingArchitecture"`
+
+	AccountId string `json:"accountId"`
+
+	Description string `json:"description"`
+
+	HiddenFromUninvitedNonAdminAccountMembers bool `json:"hiddenFromUninvitedNonAdminAccountMembers"`
+
+	Readme *ServiceReadme `json:"readme"`
+}
+
+func (v *updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService) __premarshalJSON() (*__premarshalupdateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService, error) {
+	var retval __premarshalupdateServiceHiddenFromUninvitedNonAdminAccountMembersServiceServiceMutationUpdateHiddenFromUninvitedNonAdminAccountMembersService
+
+	retval.Id = v.Service.Id
+	retval.Title = v.Service.Title
+	retval.OnboardingArchitecture = v.Service.OnboardingArchitecture
+	retval.AccountId = v.Service.AccountId
+	retval.Description = v.Service.Description
+	retval.HiddenFromUninvitedNonAdminAccountMembers = v.Service.HiddenFromUninvitedNonAdminAccountMembers
+	retval.Readme = v.Service.Readme
+	return &retval, nil
+}
+
+// updateServiceReadmeResponse is returned by updateServiceReadme on success.
+type updateServiceReadmeResponse struct {
+	Service updateServiceReadmeServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateServiceReadmeResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateServiceReadmeResponse) GetService() updateServiceReadmeServiceServiceMutation {
+	return v.Service
+}
+
+// updateServiceReadmeServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateServiceReadmeServiceServiceMutation struct {
+	UpdateReadme *updateServiceReadmeServiceServiceMutationUpdateReadmeService `json:"updateReadme"`
+}
+
+// GetUpdateReadme returns updateServiceReadmeServiceServiceMutation.UpdateReadme, and is useful for accessing the field via an interface.
+func (v *updateServiceReadmeServiceServiceMutation) GetUpdateReadme() *updateServiceReadmeServiceServiceMutationUpdateReadmeService {
+	return v.UpdateReadme
+}
+
+// updateServiceReadmeServiceServiceMutationUpdateReadmeService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type updateServiceReadmeServiceServiceMutationUpdateReadmeService struct {
+	Service `json:"-"`
+}
+
+// GetId returns updateServiceReadmeServiceServiceMutationUpdateReadmeService.Id, and is useful for accessing the field via an interface.
+func (v *updateServiceReadmeServiceServiceMutationUpdateReadmeService) GetId() string {
+	return v.Service.Id
+}
+
+// GetTitle returns updateServiceReadmeServiceServiceMutationUpdateReadmeService.Title, and is useful for accessing the field via an interface.
+func (v *updateServiceReadmeServiceServiceMutationUpdateReadmeService) GetTitle() string {
+	return v.Service.Title
+}
+
+// GetOnboardingArchitecture returns updateServiceReadmeServiceServiceMutationUpdateReadmeService.OnboardingArchitecture, and is useful for accessing the field via an interface.
+func (v *updateServiceReadmeServiceServiceMutationUpdateReadmeService) GetOnboardingArchitecture() string {
+	return v.Service.OnboardingArchitecture
+}
+
+// GetAccountId returns updateServiceReadmeServiceServiceMutationUpdateReadmeService.AccountId, and is useful for accessing the field via an interface.
+func (v *updateServiceReadmeServiceServiceMutationUpdateReadmeService) GetAccountId() string {
+	return v.Service.AccountId
+}
+
+// GetDescription returns updateServiceReadmeServiceServiceMutationUpdateReadmeService.Description, and is useful for accessing the field via an interface.
+func (v *updateServiceReadmeServiceServiceMutationUpdateReadmeService) GetDescription() string {
+	return v.Service.Description
+}
+
+// GetHiddenFromUninvitedNonAdminAccountMembers returns updateServiceReadmeServiceServiceMutationUpdateReadmeService.HiddenFromUninvitedNonAdminAccountMembers, and is useful for accessing the field via an interface.
+func (v *updateServiceReadmeServiceServiceMutationUpdateReadmeService) GetHiddenFromUninvitedNonAdminAccountMembers() bool {
+	return v.Service.HiddenFromUninvitedNonAdminAccountMembers
+}
+
+// GetReadme returns updateServiceReadmeServiceServiceMutationUpdateReadmeService.Readme, and is useful for accessing the field via an interface.
+func (v *updateServiceReadmeServiceServiceMutationUpdateReadmeService) GetReadme() *ServiceReadme {
+	return v.Service.Readme
+}
+
+func (v *updateServiceReadmeServiceServiceMutationUpdateReadmeService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateServiceReadmeServiceServiceMutationUpdateReadmeService
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateServiceReadmeServiceServiceMutationUpdateReadmeService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Service)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateServiceReadmeServiceServiceMutationUpdateReadmeService struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	OnboardingArchitecture string `json:"onboardingArchitecture"`
+
+	AccountId string `json:"accountId"`
+
+	Description string `json:"description"`
+
+	HiddenFromUninvitedNonAdminAccountMembers bool `json:"hiddenFromUninvitedNonAdminAccountMembers"`
+
+	Readme *ServiceReadme `json:"readme"`
+}
+
+func (v *updateServiceReadmeServiceServiceMutationUpdateReadmeService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateServiceReadmeServiceServiceMutationUpdateReadmeService) __premarshalJSON() (*__premarshalupdateServiceReadmeServiceServiceMutationUpdateReadmeService, error) {
+	var retval __premarshalupdateServiceReadmeServiceServiceMutationUpdateReadmeService
+
+	retval.Id = v.Service.Id
+	retval.Title = v.Service.Title
+	retval.OnboardingArchitecture = v.Service.OnboardingArchitecture
+	retval.AccountId = v.Service.AccountId
+	retval.Description = v.Service.Description
+	retval.HiddenFromUninvitedNonAdminAccountMembers = v.Service.HiddenFromUninvitedNonAdminAccountMembers
+	retval.Readme = v.Service.Readme
+	return &retval, nil
+}
+
+// updateServiceTitleResponse is returned by updateServiceTitle on success.
+type updateServiceTitleResponse struct {
+	Service updateServiceTitleServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateServiceTitleResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateServiceTitleResponse) GetService() updateServiceTitleServiceServiceMutation {
+	return v.Service
+}
+
+// updateServiceTitleServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateServiceTitleServiceServiceMutation struct {
+	UpdateTitle updateServiceTitleServiceServiceMutationUpdateTitleService `json:"updateTitle"`
+}
+
+// GetUpdateTitle returns updateServiceTitleServiceServiceMutation.UpdateTitle, and is useful for accessing the field via an interface.
+func (v *updateServiceTitleServiceServiceMutation) GetUpdateTitle() updateServiceTitleServiceServiceMutationUpdateTitleService {
+	return v.UpdateTitle
+}
+
+// updateServiceTitleServiceServiceMutationUpdateTitleService includes the requested fields of the GraphQL type Service.
+// The GraphQL type's documentation follows.
+//
+// A graph in Apollo Studio represents a graph in your organization.
+// Each graph has one or more variants, which correspond to the different
+// environments where that graph runs (such as staging and production).
+// Each variant has its own GraphQL schema, which means schemas can differ between environments.
+type updateServiceTitleServiceServiceMutationUpdateTitleService struct {
+	Service `json:"-"`
+}
+
+// GetId returns updateServiceTitleServiceServiceMutationUpdateTitleService.Id, and is useful for accessing the field via an interface.
+func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) GetId() string {
+	return v.Service.Id
+}
+
+// GetTitle returns updateServiceTitleServiceServiceMutationUpdateTitleService.Title, and is useful for accessing the field via an interface.
+func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) GetTitle() string {
+	return v.Service.Title
+}
+
+// GetOnboardingArchitecture returns updateServiceTitleServiceServiceMutationUpdateTitleService.OnboardingArchitecture, and is useful for accessing the field via an interface.
+func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) GetOnboardingArchitecture() string {
+	return v.Service.OnboardingArchitecture
+}
+
+// GetAccountId returns updateServiceTitleServiceServiceMutationUpdateTitleService.AccountId, and is useful for accessing the field via an interface.
+func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) GetAccountId() string {
+	return v.Service.AccountId
+}
+
+// GetDescription returns updateServiceTitleServiceServiceMutationUpdateTitleService.Description, and is useful for accessing the field via an interface.
+func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) GetDescription() string {
+	return v.Service.Description
+}
+
+// GetHiddenFromUninvitedNonAdminAccountMembers returns updateServiceTitleServiceServiceMutationUpdateTitleService.HiddenFromUninvitedNonAdminAccountMembers, and is useful for accessing the field via an interface.
+func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) GetHiddenFromUninvitedNonAdminAccountMembers() bool {
+	return v.Service.HiddenFromUninvitedNonAdminAccountMembers
+}
+
+// GetReadme returns updateServiceTitleServiceServiceMutationUpdateTitleService.Readme, and is useful for accessing the field via an interface.
+func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) GetReadme() *ServiceReadme {
+	return v.Service.Readme
+}
+
+func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateServiceTitleServiceServiceMutationUpdateTitleService
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateServiceTitleServiceServiceMutationUpdateTitleService = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Service)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateServiceTitleServiceServiceMutationUpdateTitleService struct {
+	Id string `json:"id"`
+
+	Title string `json:"title"`
+
+	OnboardingArchitecture string `json:"onboardingArchitecture"`
+
+	AccountId string `json:"accountId"`
+
+	Description string `json:"description"`
+
+	HiddenFromUninvitedNonAdminAccountMembers bool `json:"hiddenFromUninvitedNonAdminAccountMembers"`
+
+	Readme *ServiceReadme `json:"readme"`
+}
+
+func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateServiceTitleServiceServiceMutationUpdateTitleService) __premarshalJSON() (*__premarshalupdateServiceTitleServiceServiceMutationUpdateTitleService, error) {
+	var retval __premarshalupdateServiceTitleServiceServiceMutationUpdateTitleService
+
+	retval.Id = v.Service.Id
+	retval.Title = v.Service.Title
+	retval.OnboardingArchitecture = v.Service.OnboardingArchitecture
+	retval.AccountId = v.Service.AccountId
+	retval.Description = v.Service.Description
+	retval.HiddenFromUninvitedNonAdminAccountMembers = v.Service.HiddenFromUninvitedNonAdminAccountMembers
+	retval.Readme = v.Service.Readme
+	return &retval, nil
+}
+
+// updateVariantCheckConfigurationDowngradeChecksResponse is returned by updateVariantCheckConfigurationDowngradeChecks on success.
+type updateVariantCheckConfigurationDowngradeChecksResponse struct {
+	Service updateVariantCheckConfigurationDowngradeChecksServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantCheckConfigurationDowngradeChecksResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationDowngradeChecksResponse) GetService() updateVariantCheckConfigurationDowngradeChecksServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantCheckConfigurationDowngradeChecksServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantCheckConfigurationDowngradeChecksServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantCheckConfigurationDowngradeChecksServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationDowngradeChecksServiceServiceMutation) GetVariant() updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdateCheckConfigurationDowngradeChecks updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration `json:"updateCheckConfigurationDowngradeChecks"`
+}
+
+// GetUpdateCheckConfigurationDowngradeChecks returns updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutation.UpdateCheckConfigurationDowngradeChecks, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutation) GetUpdateCheckConfigurationDowngradeChecks() updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration {
+	return v.UpdateCheckConfigurationDowngradeChecks
+}
+
+// updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration includes the requested fields of the GraphQL type VariantCheckConfiguration.
+// The GraphQL type's documentation follows.
+//
+// Variant-level configuration of checks.
+type updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration struct {
+	VariantCheckConfiguration `json:"-"`
+}
+
+// GetOperationsChecksEnabled returns updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration.OperationsChecksEnabled, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration) GetOperationsChecksEnabled() bool {
+	return v.VariantCheckConfiguration.OperationsChecksEnabled
+}
+
+// GetTimeRangeConfig returns updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration.TimeRangeConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration) GetTimeRangeConfig() VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange {
+	return v.VariantCheckConfiguration.TimeRangeConfig
+}
+
+// GetIncludedVariantsConfig returns updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration.IncludedVariantsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration) GetIncludedVariantsConfig() VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants {
+	return v.VariantCheckConfiguration.IncludedVariantsConfig
+}
+
+// GetExcludedClientsConfig returns updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration.ExcludedClientsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration) GetExcludedClientsConfig() VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients {
+	return v.VariantCheckConfiguration.ExcludedClientsConfig
+}
+
+// GetExcludedOperationsConfig returns updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration.ExcludedOperationsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration) GetExcludedOperationsConfig() VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations {
+	return v.VariantCheckConfiguration.ExcludedOperationsConfig
+}
+
+// GetDowngradeChecksConfig returns updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration.DowngradeChecksConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration) GetDowngradeChecksConfig() VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks {
+	return v.VariantCheckConfiguration.DowngradeChecksConfig
+}
+
+func (v *updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.VariantCheckConfiguration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration struct {
+	OperationsChecksEnabled bool `json:"operationsChecksEnabled"`
+
+	TimeRangeConfig VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange `json:"timeRangeConfig"`
+
+	IncludedVariantsConfig VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants `json:"includedVariantsConfig"`
+
+	ExcludedClientsConfig VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients `json:"excludedClientsConfig"`
+
+	ExcludedOperationsConfig VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations `json:"excludedOperationsConfig"`
+
+	DowngradeChecksConfig VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks `json:"downgradeChecksConfig"`
+}
+
+func (v *updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration) __premarshalJSON() (*__premarshalupdateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration, error) {
+	var retval __premarshalupdateVariantCheckConfigurationDowngradeChecksServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationDowngradeChecksVariantCheckConfiguration
+
+	retval.OperationsChecksEnabled = v.VariantCheckConfiguration.OperationsChecksEnabled
+	retval.TimeRangeConfig = v.VariantCheckConfiguration.TimeRangeConfig
+	retval.IncludedVariantsConfig = v.VariantCheckConfiguration.IncludedVariantsConfig
+	retval.ExcludedClientsConfig = v.VariantCheckConfiguration.ExcludedClientsConfig
+	retval.ExcludedOperationsConfig = v.VariantCheckConfiguration.ExcludedOperationsConfig
+	retval.DowngradeChecksConfig = v.VariantCheckConfiguration.DowngradeChecksConfig
+	return &retval, nil
+}
+
+// updateVariantCheckConfigurationEnableOperationsCheckResponse is returned by updateVariantCheckConfigurationEnableOperationsCheck on success.
+type updateVariantCheckConfigurationEnableOperationsCheckResponse struct {
+	Service updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantCheckConfigurationEnableOperationsCheckResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationEnableOperationsCheckResponse) GetService() updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutation) GetVariant() updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdateCheckConfigurationEnableOperationsCheck *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration `json:"updateCheckConfigurationEnableOperationsCheck"`
+}
+
+// GetUpdateCheckConfigurationEnableOperationsCheck returns updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutation.UpdateCheckConfigurationEnableOperationsCheck, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutation) GetUpdateCheckConfigurationEnableOperationsCheck() *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration {
+	return v.UpdateCheckConfigurationEnableOperationsCheck
+}
+
+// updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration includes the requested fields of the GraphQL type VariantCheckConfiguration.
+// The GraphQL type's documentation follows.
+//
+// Variant-level configuration of checks.
+type updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration struct {
+	VariantCheckConfiguration `json:"-"`
+}
+
+// GetOperationsChecksEnabled returns updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration.OperationsChecksEnabled, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration) GetOperationsChecksEnabled() bool {
+	return v.VariantCheckConfiguration.OperationsChecksEnabled
+}
+
+// GetTimeRangeConfig returns updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration.TimeRangeConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration) GetTimeRangeConfig() VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange {
+	return v.VariantCheckConfiguration.TimeRangeConfig
+}
+
+// GetIncludedVariantsConfig returns updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration.IncludedVariantsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration) GetIncludedVariantsConfig() VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants {
+	return v.VariantCheckConfiguration.IncludedVariantsConfig
+}
+
+// GetExcludedClientsConfig returns updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration.ExcludedClientsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration) GetExcludedClientsConfig() VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients {
+	return v.VariantCheckConfiguration.ExcludedClientsConfig
+}
+
+// GetExcludedOperationsConfig returns updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration.ExcludedOperationsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration) GetExcludedOperationsConfig() VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations {
+	return v.VariantCheckConfiguration.ExcludedOperationsConfig
+}
+
+// GetDowngradeChecksConfig returns updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration.DowngradeChecksConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration) GetDowngradeChecksConfig() VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks {
+	return v.VariantCheckConfiguration.DowngradeChecksConfig
+}
+
+func (v *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.VariantCheckConfiguration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration struct {
+	OperationsChecksEnabled bool `json:"operationsChecksEnabled"`
+
+	TimeRangeConfig VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange `json:"timeRangeConfig"`
+
+	IncludedVariantsConfig VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants `json:"includedVariantsConfig"`
+
+	ExcludedClientsConfig VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients `json:"excludedClientsConfig"`
+
+	ExcludedOperationsConfig VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations `json:"excludedOperationsConfig"`
+
+	DowngradeChecksConfig VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks `json:"downgradeChecksConfig"`
+}
+
+func (v *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration) __premarshalJSON() (*__premarshalupdateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration, error) {
+	var retval __premarshalupdateVariantCheckConfigurationEnableOperationsCheckServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationEnableOperationsCheckVariantCheckConfiguration
+
+	retval.OperationsChecksEnabled = v.VariantCheckConfiguration.OperationsChecksEnabled
+	retval.TimeRangeConfig = v.VariantCheckConfiguration.TimeRangeConfig
+	retval.IncludedVariantsConfig = v.VariantCheckConfiguration.IncludedVariantsConfig
+	retval.ExcludedClientsConfig = v.VariantCheckConfiguration.ExcludedClientsConfig
+	retval.ExcludedOperationsConfig = v.VariantCheckConfiguration.ExcludedOperationsConfig
+	retval.DowngradeChecksConfig = v.VariantCheckConfiguration.DowngradeChecksConfig
+	return &retval, nil
+}
+
+// updateVariantCheckConfigurationExcludedClientsResponse is returned by updateVariantCheckConfigurationExcludedClients on success.
+type updateVariantCheckConfigurationExcludedClientsResponse struct {
+	Service updateVariantCheckConfigurationExcludedClientsServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantCheckConfigurationExcludedClientsResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedClientsResponse) GetService() updateVariantCheckConfigurationExcludedClientsServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantCheckConfigurationExcludedClientsServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantCheckConfigurationExcludedClientsServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantCheckConfigurationExcludedClientsServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedClientsServiceServiceMutation) GetVariant() updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdateCheckConfigurationExcludedClients updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration `json:"updateCheckConfigurationExcludedClients"`
+}
+
+// GetUpdateCheckConfigurationExcludedClients returns updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutation.UpdateCheckConfigurationExcludedClients, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutation) GetUpdateCheckConfigurationExcludedClients() updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration {
+	return v.UpdateCheckConfigurationExcludedClients
+}
+
+// updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration includes the requested fields of the GraphQL type VariantCheckConfiguration.
+// The GraphQL type's documentation follows.
+//
+// Variant-level configuration of checks.
+type updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration struct {
+	VariantCheckConfiguration `json:"-"`
+}
+
+// GetOperationsChecksEnabled returns updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration.OperationsChecksEnabled, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration) GetOperationsChecksEnabled() bool {
+	return v.VariantCheckConfiguration.OperationsChecksEnabled
+}
+
+// GetTimeRangeConfig returns updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration.TimeRangeConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration) GetTimeRangeConfig() VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange {
+	return v.VariantCheckConfiguration.TimeRangeConfig
+}
+
+// GetIncludedVariantsConfig returns updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration.IncludedVariantsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration) GetIncludedVariantsConfig() VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants {
+	return v.VariantCheckConfiguration.IncludedVariantsConfig
+}
+
+// GetExcludedClientsConfig returns updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration.ExcludedClientsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration) GetExcludedClientsConfig() VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients {
+	return v.VariantCheckConfiguration.ExcludedClientsConfig
+}
+
+// GetExcludedOperationsConfig returns updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration.ExcludedOperationsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration) GetExcludedOperationsConfig() VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations {
+	return v.VariantCheckConfiguration.ExcludedOperationsConfig
+}
+
+// GetDowngradeChecksConfig returns updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration.DowngradeChecksConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration) GetDowngradeChecksConfig() VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks {
+	return v.VariantCheckConfiguration.DowngradeChecksConfig
+}
+
+func (v *updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.VariantCheckConfiguration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration struct {
+	OperationsChecksEnabled bool `json:"operationsChecksEnabled"`
+
+	TimeRangeConfig VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange `json:"timeRangeConfig"`
+
+	IncludedVariantsConfig VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants `json:"includedVariantsConfig"`
+
+	ExcludedClientsConfig VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients `json:"excludedClientsConfig"`
+
+	ExcludedOperationsConfig VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations `json:"excludedOperationsConfig"`
+
+	DowngradeChecksConfig VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks `json:"downgradeChecksConfig"`
+}
+
+func (v *updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration) __premarshalJSON() (*__premarshalupdateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration, error) {
+	var retval __premarshalupdateVariantCheckConfigurationExcludedClientsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedClientsVariantCheckConfiguration
+
+	retval.OperationsChecksEnabled = v.VariantCheckConfiguration.OperationsChecksEnabled
+	retval.TimeRangeConfig = v.VariantCheckConfiguration.TimeRangeConfig
+	retval.IncludedVariantsConfig = v.VariantCheckConfiguration.IncludedVariantsConfig
+	retval.ExcludedClientsConfig = v.VariantCheckConfiguration.ExcludedClientsConfig
+	retval.ExcludedOperationsConfig = v.VariantCheckConfiguration.ExcludedOperationsConfig
+	retval.DowngradeChecksConfig = v.VariantCheckConfiguration.DowngradeChecksConfig
+	return &retval, nil
+}
+
+// updateVariantCheckConfigurationExcludedOperationsResponse is returned by updateVariantCheckConfigurationExcludedOperations on success.
+type updateVariantCheckConfigurationExcludedOperationsResponse struct {
+	Service updateVariantCheckConfigurationExcludedOperationsServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantCheckConfigurationExcludedOperationsResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedOperationsResponse) GetService() updateVariantCheckConfigurationExcludedOperationsServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantCheckConfigurationExcludedOperationsServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantCheckConfigurationExcludedOperationsServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantCheckConfigurationExcludedOperationsServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedOperationsServiceServiceMutation) GetVariant() updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdateCheckConfigurationExcludedOperations updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration `json:"updateCheckConfigurationExcludedOperations"`
+}
+
+// GetUpdateCheckConfigurationExcludedOperations returns updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutation.UpdateCheckConfigurationExcludedOperations, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutation) GetUpdateCheckConfigurationExcludedOperations() updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration {
+	return v.UpdateCheckConfigurationExcludedOperations
+}
+
+// updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration includes the requested fields of the GraphQL type VariantCheckConfiguration.
+// The GraphQL type's documentation follows.
+//
+// Variant-level configuration of checks.
+type updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration struct {
+	VariantCheckConfiguration `json:"-"`
+}
+
+// GetOperationsChecksEnabled returns updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration.OperationsChecksEnabled, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration) GetOperationsChecksEnabled() bool {
+	return v.VariantCheckConfiguration.OperationsChecksEnabled
+}
+
+// GetTimeRangeConfig returns updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration.TimeRangeConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration) GetTimeRangeConfig() VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange {
+	return v.VariantCheckConfiguration.TimeRangeConfig
+}
+
+// GetIncludedVariantsConfig returns updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration.IncludedVariantsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration) GetIncludedVariantsConfig() VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants {
+	return v.VariantCheckConfiguration.IncludedVariantsConfig
+}
+
+// GetExcludedClientsConfig returns updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration.ExcludedClientsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration) GetExcludedClientsConfig() VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients {
+	return v.VariantCheckConfiguration.ExcludedClientsConfig
+}
+
+// GetExcludedOperationsConfig returns updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration.ExcludedOperationsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration) GetExcludedOperationsConfig() VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations {
+	return v.VariantCheckConfiguration.ExcludedOperationsConfig
+}
+
+// GetDowngradeChecksConfig returns updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration.DowngradeChecksConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration) GetDowngradeChecksConfig() VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks {
+	return v.VariantCheckConfiguration.DowngradeChecksConfig
+}
+
+func (v *updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.VariantCheckConfiguration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration struct {
+	OperationsChecksEnabled bool `json:"operationsChecksEnabled"`
+
+	TimeRangeConfig VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange `json:"timeRangeConfig"`
+
+	IncludedVariantsConfig VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants `json:"includedVariantsConfig"`
+
+	ExcludedClientsConfig VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients `json:"excludedClientsConfig"`
+
+	ExcludedOperationsConfig VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations `json:"excludedOperationsConfig"`
+
+	DowngradeChecksConfig VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks `json:"downgradeChecksConfig"`
+}
+
+func (v *updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration) __premarshalJSON() (*__premarshalupdateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration, error) {
+	var retval __premarshalupdateVariantCheckConfigurationExcludedOperationsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationExcludedOperationsVariantCheckConfiguration
+
+	retval.OperationsChecksEnabled = v.VariantCheckConfiguration.OperationsChecksEnabled
+	retval.TimeRangeConfig = v.VariantCheckConfiguration.TimeRangeConfig
+	retval.IncludedVariantsConfig = v.VariantCheckConfiguration.IncludedVariantsConfig
+	retval.ExcludedClientsConfig = v.VariantCheckConfiguration.ExcludedClientsConfig
+	retval.ExcludedOperationsConfig = v.VariantCheckConfiguration.ExcludedOperationsConfig
+	retval.DowngradeChecksConfig = v.VariantCheckConfiguration.DowngradeChecksConfig
+	return &retval, nil
+}
+
+// updateVariantCheckConfigurationIncludedVariantsResponse is returned by updateVariantCheckConfigurationIncludedVariants on success.
+type updateVariantCheckConfigurationIncludedVariantsResponse struct {
+	Service updateVariantCheckConfigurationIncludedVariantsServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantCheckConfigurationIncludedVariantsResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationIncludedVariantsResponse) GetService() updateVariantCheckConfigurationIncludedVariantsServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantCheckConfigurationIncludedVariantsServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantCheckConfigurationIncludedVariantsServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantCheckConfigurationIncludedVariantsServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationIncludedVariantsServiceServiceMutation) GetVariant() updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdateCheckConfigurationIncludedVariants updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration `json:"updateCheckConfigurationIncludedVariants"`
+}
+
+// GetUpdateCheckConfigurationIncludedVariants returns updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutation.UpdateCheckConfigurationIncludedVariants, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutation) GetUpdateCheckConfigurationIncludedVariants() updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration {
+	return v.UpdateCheckConfigurationIncludedVariants
+}
+
+// updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration includes the requested fields of the GraphQL type VariantCheckConfiguration.
+// The GraphQL type's documentation follows.
+//
+// Variant-level configuration of checks.
+type updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration struct {
+	VariantCheckConfiguration `json:"-"`
+}
+
+// GetOperationsChecksEnabled returns updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration.OperationsChecksEnabled, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration) GetOperationsChecksEnabled() bool {
+	return v.VariantCheckConfiguration.OperationsChecksEnabled
+}
+
+// GetTimeRangeConfig returns updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration.TimeRangeConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration) GetTimeRangeConfig() VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange {
+	return v.VariantCheckConfiguration.TimeRangeConfig
+}
+
+// GetIncludedVariantsConfig returns updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration.IncludedVariantsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration) GetIncludedVariantsConfig() VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants {
+	return v.VariantCheckConfiguration.IncludedVariantsConfig
+}
+
+// GetExcludedClientsConfig returns updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration.ExcludedClientsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration) GetExcludedClientsConfig() VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients {
+	return v.VariantCheckConfiguration.ExcludedClientsConfig
+}
+
+// GetExcludedOperationsConfig returns updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration.ExcludedOperationsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration) GetExcludedOperationsConfig() VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations {
+	return v.VariantCheckConfiguration.ExcludedOperationsConfig
+}
+
+// GetDowngradeChecksConfig returns updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration.DowngradeChecksConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration) GetDowngradeChecksConfig() VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks {
+	return v.VariantCheckConfiguration.DowngradeChecksConfig
+}
+
+func (v *updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.VariantCheckConfiguration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration struct {
+	OperationsChecksEnabled bool `json:"operationsChecksEnabled"`
+
+	TimeRangeConfig VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange `json:"timeRangeConfig"`
+
+	IncludedVariantsConfig VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants `json:"includedVariantsConfig"`
+
+	ExcludedClientsConfig VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients `json:"excludedClientsConfig"`
+
+	ExcludedOperationsConfig VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations `json:"excludedOperationsConfig"`
+
+	DowngradeChecksConfig VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks `json:"downgradeChecksConfig"`
+}
+
+func (v *updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration) __premarshalJSON() (*__premarshalupdateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration, error) {
+	var retval __premarshalupdateVariantCheckConfigurationIncludedVariantsServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationIncludedVariantsVariantCheckConfiguration
+
+	retval.OperationsChecksEnabled = v.VariantCheckConfiguration.OperationsChecksEnabled
+	retval.TimeRangeConfig = v.VariantCheckConfiguration.TimeRangeConfig
+	retval.IncludedVariantsConfig = v.VariantCheckConfiguration.IncludedVariantsConfig
+	retval.ExcludedClientsConfig = v.VariantCheckConfiguration.ExcludedClientsConfig
+	retval.ExcludedOperationsConfig = v.VariantCheckConfiguration.ExcludedOperationsConfig
+	retval.DowngradeChecksConfig = v.VariantCheckConfiguration.DowngradeChecksConfig
+	return &retval, nil
+}
+
+// updateVariantCheckConfigurationTimeRangeResponse is returned by updateVariantCheckConfigurationTimeRange on success.
+type updateVariantCheckConfigurationTimeRangeResponse struct {
+	Service updateVariantCheckConfigurationTimeRangeServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantCheckConfigurationTimeRangeResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationTimeRangeResponse) GetService() updateVariantCheckConfigurationTimeRangeServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantCheckConfigurationTimeRangeServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantCheckConfigurationTimeRangeServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantCheckConfigurationTimeRangeServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationTimeRangeServiceServiceMutation) GetVariant() updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdateCheckConfigurationTimeRange updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration `json:"updateCheckConfigurationTimeRange"`
+}
+
+// GetUpdateCheckConfigurationTimeRange returns updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutation.UpdateCheckConfigurationTimeRange, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutation) GetUpdateCheckConfigurationTimeRange() updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration {
+	return v.UpdateCheckConfigurationTimeRange
+}
+
+// updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration includes the requested fields of the GraphQL type VariantCheckConfiguration.
+// The GraphQL type's documentation follows.
+//
+// Variant-level configuration of checks.
+type updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration struct {
+	VariantCheckConfiguration `json:"-"`
+}
+
+// GetOperationsChecksEnabled returns updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration.OperationsChecksEnabled, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration) GetOperationsChecksEnabled() bool {
+	return v.VariantCheckConfiguration.OperationsChecksEnabled
+}
+
+// GetTimeRangeConfig returns updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration.TimeRangeConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration) GetTimeRangeConfig() VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange {
+	return v.VariantCheckConfiguration.TimeRangeConfig
+}
+
+// GetIncludedVariantsConfig returns updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration.IncludedVariantsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration) GetIncludedVariantsConfig() VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants {
+	return v.VariantCheckConfiguration.IncludedVariantsConfig
+}
+
+// GetExcludedClientsConfig returns updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration.ExcludedClientsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration) GetExcludedClientsConfig() VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients {
+	return v.VariantCheckConfiguration.ExcludedClientsConfig
+}
+
+// GetExcludedOperationsConfig returns updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration.ExcludedOperationsConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration) GetExcludedOperationsConfig() VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations {
+	return v.VariantCheckConfiguration.ExcludedOperationsConfig
+}
+
+// GetDowngradeChecksConfig returns updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration.DowngradeChecksConfig, and is useful for accessing the field via an interface.
+func (v *updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration) GetDowngradeChecksConfig() VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks {
+	return v.VariantCheckConfiguration.DowngradeChecksConfig
+}
+
+func (v *updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.VariantCheckConfiguration)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration struct {
+	OperationsChecksEnabled bool `json:"operationsChecksEnabled"`
+
+	TimeRangeConfig VariantCheckConfigurationTimeRangeConfigVariantCheckConfigurationTimeRange `json:"timeRangeConfig"`
+
+	IncludedVariantsConfig VariantCheckConfigurationIncludedVariantsConfigVariantCheckConfigurationIncludedVariants `json:"includedVariantsConfig"`
+
+	ExcludedClientsConfig VariantCheckConfigurationExcludedClientsConfigVariantCheckConfigurationExcludedClients `json:"excludedClientsConfig"`
+
+	ExcludedOperationsConfig VariantCheckConfigurationExcludedOperationsConfigVariantCheckConfigurationExcludedOperations `json:"excludedOperationsConfig"`
+
+	DowngradeChecksConfig VariantCheckConfigurationDowngradeChecksConfigVariantCheckConfigurationDowngradeChecks `json:"downgradeChecksConfig"`
+}
+
+func (v *updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration) __premarshalJSON() (*__premarshalupdateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration, error) {
+	var retval __premarshalupdateVariantCheckConfigurationTimeRangeServiceServiceMutationVariantGraphVariantMutationUpdateCheckConfigurationTimeRangeVariantCheckConfiguration
+
+	retval.OperationsChecksEnabled = v.VariantCheckConfiguration.OperationsChecksEnabled
+	retval.TimeRangeConfig = v.VariantCheckConfiguration.TimeRangeConfig
+	retval.IncludedVariantsConfig = v.VariantCheckConfiguration.IncludedVariantsConfig
+	retval.ExcludedClientsConfig = v.VariantCheckConfiguration.ExcludedClientsConfig
+	retval.ExcludedOperationsConfig = v.VariantCheckConfiguration.ExcludedOperationsConfig
+	retval.DowngradeChecksConfig = v.VariantCheckConfiguration.DowngradeChecksConfig
+	return &retval, nil
+}
+
+// updateVariantIsProtectedResponse is returned by updateVariantIsProtected on success.
+type updateVariantIsProtectedResponse struct {
+	Service updateVariantIsProtectedServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantIsProtectedResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedResponse) GetService() updateVariantIsProtectedServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantIsProtectedServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantIsProtectedServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantIsProtectedServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutation) GetVariant() updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdateIsProtected updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant `json:"updateIsProtected"`
+}
+
+// GetUpdateIsProtected returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutation.UpdateIsProtected, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutation) GetUpdateIsProtected() updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant {
+	return v.UpdateIsProtected
+}
+
+// updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetId() string {
+	return v.Variant.Id
+}
+
+// GetName returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetName() string {
+	return v.Variant.Name
+}
+
+// GetIsPublic returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetIsPublic() bool {
+	return v.Variant.IsPublic
+}
+
+// GetIsPubliclyListed returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetIsProtected() bool {
+	return v.Variant.IsProtected
+}
+
+// GetUrl returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetUrl() *string {
+	return v.Variant.Url
+}
+
+// GetSubscriptionUrl returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetSendCookies() *bool {
+	return v.Variant.SendCookies
+}
+
+// GetPreflightScript returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetReadme() VariantReadme {
+	return v.Variant.Readme
+}
+
+// GetLinks returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) GetGraphId() string {
+	return v.Variant.GraphId
+}
+
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant) __premarshalJSON() (*__premarshalupdateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant, error) {
+	var retval __premarshalupdateVariantIsProtectedServiceServiceMutationVariantGraphVariantMutationUpdateIsProtectedGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// updateVariantIsPublicResponse is returned by updateVariantIsPublic on success.
+type updateVariantIsPublicResponse struct {
+	Service updateVariantIsPublicServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantIsPublicResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicResponse) GetService() updateVariantIsPublicServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantIsPublicServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantIsPublicServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantIsPublicServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutation) GetVariant() updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdateVariantIsPublic updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant `json:"updateVariantIsPublic"`
+}
+
+// GetUpdateVariantIsPublic returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutation.UpdateVariantIsPublic, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutation) GetUpdateVariantIsPublic() updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant {
+	return v.UpdateVariantIsPublic
+}
+
+// updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetId() string {
+	return v.Variant.Id
+}
+
+// GetName returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetName() string {
+	return v.Variant.Name
+}
+
+// GetIsPublic returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetIsPublic() bool {
+	return v.Variant.IsPublic
+}
+
+// GetIsPubliclyListed returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetIsProtected() bool {
+	return v.Variant.IsProtected
+}
+
+// GetUrl returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetUrl() *string {
+	return v.Variant.Url
+}
+
+// GetSubscriptionUrl returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetSendCookies() *bool {
+	return v.Variant.SendCookies
+}
+
+// GetPreflightScript returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetReadme() VariantReadme {
+	return v.Variant.Readme
+}
+
+// GetLinks returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) GetGraphId() string {
+	return v.Variant.GraphId
+}
+
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant) __premarshalJSON() (*__premarshalupdateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant, error) {
+	var retval __premarshalupdateVariantIsPublicServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPublicGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// updateVariantIsPubliclyListedResponse is returned by updateVariantIsPubliclyListed on success.
+type updateVariantIsPubliclyListedResponse struct {
+	Service updateVariantIsPubliclyListedServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantIsPubliclyListedResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedResponse) GetService() updateVariantIsPubliclyListedServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantIsPubliclyListedServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantIsPubliclyListedServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantIsPubliclyListedServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutation) GetVariant() updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdateVariantIsPubliclyListed updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant `json:"updateVariantIsPubliclyListed"`
+}
+
+// GetUpdateVariantIsPubliclyListed returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutation.UpdateVariantIsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutation) GetUpdateVariantIsPubliclyListed() updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant {
+	return v.UpdateVariantIsPubliclyListed
+}
+
+// updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetId() string {
+	return v.Variant.Id
+}
+
+// GetName returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetName() string {
+	return v.Variant.Name
+}
+
+// GetIsPublic returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetIsPublic() bool {
+	return v.Variant.IsPublic
+}
+
+// GetIsPubliclyListed returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetIsProtected() bool {
+	return v.Variant.IsProtected
+}
+
+// GetUrl returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetUrl() *string {
+	return v.Variant.Url
+}
+
+// GetSubscriptionUrl returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetSendCookies() *bool {
+	return v.Variant.SendCookies
+}
+
+// GetPreflightScript returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetReadme() VariantReadme {
+	return v.Variant.Readme
+}
+
+// GetLinks returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) GetGraphId() string {
+	return v.Variant.GraphId
+}
+
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant) __premarshalJSON() (*__premarshalupdateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant, error) {
+	var retval __premarshalupdateVariantIsPubliclyListedServiceServiceMutationVariantGraphVariantMutationUpdateVariantIsPubliclyListedGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// updateVariantPostflightScriptResponse is returned by updateVariantPostflightScript on success.
+type updateVariantPostflightScriptResponse struct {
+	Service updateVariantPostflightScriptServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantPostflightScriptResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptResponse) GetService() updateVariantPostflightScriptServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantPostflightScriptServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantPostflightScriptServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantPostflightScriptServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutation) GetVariant() updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdatePostflightScript updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant `json:"updatePostflightScript"`
+}
+
+// GetUpdatePostflightScript returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutation.UpdatePostflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutation) GetUpdatePostflightScript() updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant {
+	return v.UpdatePostflightScript
+}
+
+// updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetId() string {
+	return v.Variant.Id
+}
+
+// GetName returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetName() string {
+	return v.Variant.Name
+}
+
+// GetIsPublic returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetIsPublic() bool {
+	return v.Variant.IsPublic
+}
+
+// GetIsPubliclyListed returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetIsProtected() bool {
+	return v.Variant.IsProtected
+}
+
+// GetUrl returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetUrl() *string {
+	return v.Variant.Url
+}
+
+// GetSubscriptionUrl returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetSendCookies() *bool {
+	return v.Variant.SendCookies
+}
+
+// GetPreflightScript returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetReadme() VariantReadme {
+	return v.Variant.Readme
+}
+
+// GetLinks returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) GetGraphId() string {
+	return v.Variant.GraphId
+}
+
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant) __premarshalJSON() (*__premarshalupdateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant, error) {
+	var retval __premarshalupdateVariantPostflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePostflightScriptGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// updateVariantPreflightScriptResponse is returned by updateVariantPreflightScript on success.
+type updateVariantPreflightScriptResponse struct {
+	Service updateVariantPreflightScriptServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantPreflightScriptResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptResponse) GetService() updateVariantPreflightScriptServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantPreflightScriptServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantPreflightScriptServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantPreflightScriptServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutation) GetVariant() updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdatePreflightScript updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant `json:"updatePreflightScript"`
+}
+
+// GetUpdatePreflightScript returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutation.UpdatePreflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutation) GetUpdatePreflightScript() updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant {
+	return v.UpdatePreflightScript
+}
+
+// updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetId() string {
+	return v.Variant.Id
+}
+
+// GetName returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetName() string {
+	return v.Variant.Name
+}
+
+// GetIsPublic returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetIsPublic() bool {
+	return v.Variant.IsPublic
+}
+
+// GetIsPubliclyListed returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetIsProtected() bool {
+	return v.Variant.IsProtected
+}
+
+// GetUrl returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetUrl() *string {
+	return v.Variant.Url
+}
+
+// GetSubscriptionUrl returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetSendCookies() *bool {
+	return v.Variant.SendCookies
+}
+
+// GetPreflightScript returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetReadme() VariantReadme {
+	return v.Variant.Readme
+}
+
+// GetLinks returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) GetGraphId() string {
+	return v.Variant.GraphId
+}
+
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant) __premarshalJSON() (*__premarshalupdateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant, error) {
+	var retval __premarshalupdateVariantPreflightScriptServiceServiceMutationVariantGraphVariantMutationUpdatePreflightScriptGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// updateVariantReadmeResponse is returned by updateVariantReadme on success.
+type updateVariantReadmeResponse struct {
+	Service updateVariantReadmeServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantReadmeResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeResponse) GetService() updateVariantReadmeServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantReadmeServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantReadmeServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantReadmeServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantReadmeServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutation) GetVariant() updateVariantReadmeServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantReadmeServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantReadmeServiceServiceMutationVariantGraphVariantMutation struct {
+	// Updates the [README](https://www.apollographql.com/docs/studio/org/graphs/#the-readme-page) of this variant.
+	UpdateVariantReadme updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant `json:"updateVariantReadme"`
+}
+
+// GetUpdateVariantReadme returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutation.UpdateVariantReadme, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutation) GetUpdateVariantReadme() updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant {
+	return v.UpdateVariantReadme
+}
+
+// updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetId() string {
+	return v.Variant.Id
+}
+
+// GetName returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetName() string {
+	return v.Variant.Name
+}
+
+// GetIsPublic returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetIsPublic() bool {
+	return v.Variant.IsPublic
+}
+
+// GetIsPubliclyListed returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetIsProtected() bool {
+	return v.Variant.IsProtected
+}
+
+// GetUrl returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetUrl() *string {
+	return v.Variant.Url
+}
+
+// GetSubscriptionUrl returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetSendCookies() *bool {
+	return v.Variant.SendCookies
+}
+
+// GetPreflightScript returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetReadme() VariantReadme {
+	return v.Variant.Readme
+}
+
+// GetLinks returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) GetGraphId() string {
+	return v.Variant.GraphId
+}
+
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant) __premarshalJSON() (*__premarshalupdateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant, error) {
+	var retval __premarshalupdateVariantReadmeServiceServiceMutationVariantGraphVariantMutationUpdateVariantReadmeGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// updateVariantSendCookiesResponse is returned by updateVariantSendCookies on success.
+type updateVariantSendCookiesResponse struct {
+	Service updateVariantSendCookiesServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantSendCookiesResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesResponse) GetService() updateVariantSendCookiesServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantSendCookiesServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantSendCookiesServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantSendCookiesServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutation) GetVariant() updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdateSendCookies updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant `json:"updateSendCookies"`
+}
+
+// GetUpdateSendCookies returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutation.UpdateSendCookies, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutation) GetUpdateSendCookies() updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant {
+	return v.UpdateSendCookies
+}
+
+// updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetId() string {
+	return v.Variant.Id
+}
+
+// GetName returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetName() string {
+	return v.Variant.Name
+}
+
+// GetIsPublic returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetIsPublic() bool {
+	return v.Variant.IsPublic
+}
+
+// GetIsPubliclyListed returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetIsProtected() bool {
+	return v.Variant.IsProtected
+}
+
+// GetUrl returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetUrl() *string {
+	return v.Variant.Url
+}
+
+// GetSubscriptionUrl returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetSendCookies() *bool {
+	return v.Variant.SendCookies
+}
+
+// GetPreflightScript returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetReadme() VariantReadme {
+	return v.Variant.Readme
+}
+
+// GetLinks returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) GetGraphId() string {
+	return v.Variant.GraphId
+}
+
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant) __premarshalJSON() (*__premarshalupdateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant, error) {
+	var retval __premarshalupdateVariantSendCookiesServiceServiceMutationVariantGraphVariantMutationUpdateSendCookiesGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// updateVariantSharedHeadersResponse is returned by updateVariantSharedHeaders on success.
+type updateVariantSharedHeadersResponse struct {
+	Service updateVariantSharedHeadersServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantSharedHeadersResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersResponse) GetService() updateVariantSharedHeadersServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantSharedHeadersServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantSharedHeadersServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantSharedHeadersServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutation) GetVariant() updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdateSharedHeaders updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant `json:"updateSharedHeaders"`
+}
+
+// GetUpdateSharedHeaders returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutation.UpdateSharedHeaders, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutation) GetUpdateSharedHeaders() updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant {
+	return v.UpdateSharedHeaders
+}
+
+// updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetId() string {
+	return v.Variant.Id
+}
+
+// GetName returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetName() string {
+	return v.Variant.Name
+}
+
+// GetIsPublic returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetIsPublic() bool {
+	return v.Variant.IsPublic
+}
+
+// GetIsPubliclyListed returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetIsProtected() bool {
+	return v.Variant.IsProtected
+}
+
+// GetUrl returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetUrl() *string {
+	return v.Variant.Url
+}
+
+// GetSubscriptionUrl returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetSendCookies() *bool {
+	return v.Variant.SendCookies
+}
+
+// GetPreflightScript returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetReadme() VariantReadme {
+	return v.Variant.Readme
+}
+
+// GetLinks returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) GetGraphId() string {
+	return v.Variant.GraphId
+}
+
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant) __premarshalJSON() (*__premarshalupdateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant, error) {
+	var retval __premarshalupdateVariantSharedHeadersServiceServiceMutationVariantGraphVariantMutationUpdateSharedHeadersGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// updateVariantSubscriptionURLResponse is returned by updateVariantSubscriptionURL on success.
+type updateVariantSubscriptionURLResponse struct {
+	Service updateVariantSubscriptionURLServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantSubscriptionURLResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLResponse) GetService() updateVariantSubscriptionURLServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantSubscriptionURLServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantSubscriptionURLServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantSubscriptionURLServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutation) GetVariant() updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
+// Modifies a variant of a graph, also called a schema tag in parts of our product.
+type updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutation struct {
+	UpdateSubscriptionURL updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant `json:"updateSubscriptionURL"`
+}
+
+// GetUpdateSubscriptionURL returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutation.UpdateSubscriptionURL, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutation) GetUpdateSubscriptionURL() updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant {
+	return v.UpdateSubscriptionURL
+}
+
+// updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetId() string {
+	return v.Variant.Id
+}
+
+// GetName returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetName() string {
+	return v.Variant.Name
+}
+
+// GetIsPublic returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetIsPublic() bool {
+	return v.Variant.IsPublic
+}
+
+// GetIsPubliclyListed returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetIsProtected() bool {
+	return v.Variant.IsProtected
+}
+
+// GetUrl returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetUrl() *string {
+	return v.Variant.Url
+}
+
+// GetSubscriptionUrl returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetSendCookies() *bool {
+	return v.Variant.SendCookies
+}
+
+// GetPreflightScript returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetReadme() VariantReadme {
+	return v.Variant.Readme
+}
+
+// GetLinks returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) GetGraphId() string {
+	return v.Variant.GraphId
+}
+
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant) __premarshalJSON() (*__premarshalupdateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant, error) {
+	var retval __premarshalupdateVariantSubscriptionURLServiceServiceMutationVariantGraphVariantMutationUpdateSubscriptionURLGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// updateVariantURLResponse is returned by updateVariantURL on success.
+type updateVariantURLResponse struct {
+	Service updateVariantURLServiceServiceMutation `json:"service"`
+}
+
+// GetService returns updateVariantURLResponse.Service, and is useful for accessing the field via an interface.
+func (v *updateVariantURLResponse) GetService() updateVariantURLServiceServiceMutation {
+	return v.Service
+}
+
+// updateVariantURLServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type updateVariantURLServiceServiceMutation struct {
+	// Make changes to a graph variant.
+	Variant updateVariantURLServiceServiceMutationVariantGraphVariantMutation `json:"variant"`
+}
+
+// GetVariant returns updateVariantURLServiceServiceMutation.Variant, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutation) GetVariant() updateVariantURLServiceServiceMutationVariantGraphVariantMutation {
+	return v.Variant
+}
+
+// updateVariantURLServiceServiceMutationVariantGraphVariantMutation includes the requested fields of the GraphQL type GraphVariantMutation.
+// The GraphQL type's documentation follows.
+//
 // Modifies a variant of a graph, also called a schema tag in parts of our product.
 type updateVariantURLServiceServiceMutationVariantGraphVariantMutation struct {
 	UpdateURL updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant `json:"updateURL"`
 }
 
-// GetUpdateURL returns updateVariantURLServiceServiceMutationVariantGraphVariantMutation.UpdateURL, and is useful for accessing the field via an interface.
-func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutation) GetUpdateURL() updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant {
-	return v.UpdateURL
+// GetUpdateURL returns updateVariantURLServiceServiceMutationVariantGraphVariantMutation.UpdateURL, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutation) GetUpdateURL() updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant {
+	return v.UpdateURL
+}
+
+// updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant struct {
+	Variant `json:"-"`
+}
+
+// GetId returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetId() string {
+	return v.Variant.Id
+}
+
+// GetName returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetName() string {
+	return v.Variant.Name
+}
+
+// GetIsPublic returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.IsPublic, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetIsPublic() bool {
+	return v.Variant.IsPublic
+}
+
+// GetIsPubliclyListed returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.IsPubliclyListed, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetIsPubliclyListed() bool {
+	return v.Variant.IsPubliclyListed
+}
+
+// GetIsProtected returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.IsProtected, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetIsProtected() bool {
+	return v.Variant.IsProtected
+}
+
+// GetUrl returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.Url, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetUrl() *string {
+	return v.Variant.Url
+}
+
+// GetSubscriptionUrl returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.SubscriptionUrl, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetSubscriptionUrl() *string {
+	return v.Variant.SubscriptionUrl
+}
+
+// GetSendCookies returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.SendCookies, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetSendCookies() *bool {
+	return v.Variant.SendCookies
+}
+
+// GetPreflightScript returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.PreflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetPreflightScript() *string {
+	return v.Variant.PreflightScript
+}
+
+// GetPostflightScript returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.PostflightScript, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetPostflightScript() *string {
+	return v.Variant.PostflightScript
+}
+
+// GetSharedHeaders returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.SharedHeaders, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetSharedHeaders() *string {
+	return v.Variant.SharedHeaders
+}
+
+// GetReadme returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.Readme, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetReadme() VariantReadme {
+	return v.Variant.Readme
+}
+
+// GetLinks returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.Links, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetLinks() []VariantLinksLinkInfo {
+	return v.Variant.Links
+}
+
+// GetGraphId returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetGraphId() string {
+	return v.Variant.GraphId
+}
+
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.Variant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupdateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	IsPublic bool `json:"isPublic"`
+
+	IsPubliclyListed bool `json:"isPubliclyListed"`
+
+	IsProtected bool `json:"isProtected"`
+
+	Url *string `json:"url"`
+
+	SubscriptionUrl *string `json:"subscriptionUrl"`
+
+	SendCookies *bool `json:"sendCookies"`
+
+	PreflightScript *string `json:"preflightScript"`
+
+	PostflightScript *string `json:"postflightScript"`
+
+	SharedHeaders *string `json:"sharedHeaders"`
+
+	Readme VariantReadme `json:"readme"`
+
+	Links []VariantLinksLinkInfo `json:"links"`
+
+	GraphId string `json:"graphId"`
+}
+
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) __premarshalJSON() (*__premarshalupdateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant, error) {
+	var retval __premarshalupdateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant
+
+	retval.Id = v.Variant.Id
+	retval.Name = v.Variant.Name
+	retval.IsPublic = v.Variant.IsPublic
+	retval.IsPubliclyListed = v.Variant.IsPubliclyListed
+	retval.IsProtected = v.Variant.IsProtected
+	retval.Url = v.Variant.Url
+	retval.SubscriptionUrl = v.Variant.SubscriptionUrl
+	retval.SendCookies = v.Variant.SendCookies
+	retval.PreflightScript = v.Variant.PreflightScript
+	retval.PostflightScript = v.Variant.PostflightScript
+	retval.SharedHeaders = v.Variant.SharedHeaders
+	retval.Readme = v.Variant.Readme
+	retval.Links = v.Variant.Links
+	retval.GraphId = v.Variant.GraphId
+	return &retval, nil
+}
+
+// upsertContractVariantResponse is returned by upsertContractVariant on success.
+type upsertContractVariantResponse struct {
+	Service upsertContractVariantServiceServiceMutation `json:"service"`
+}
+
+// GetService returns upsertContractVariantResponse.Service, and is useful for accessing the field via an interface.
+func (v *upsertContractVariantResponse) GetService() upsertContractVariantServiceServiceMutation {
+	return v.Service
+}
+
+// upsertContractVariantServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type upsertContractVariantServiceServiceMutation struct {
+	// Creates a contract schema from a source variant and a set of filter configurations
+	UpsertContractVariant upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult `json:"-"`
+}
+
+// GetUpsertContractVariant returns upsertContractVariantServiceServiceMutation.UpsertContractVariant, and is useful for accessing the field via an interface.
+func (v *upsertContractVariantServiceServiceMutation) GetUpsertContractVariant() upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult {
+	return v.UpsertContractVariant
+}
+
+func (v *upsertContractVariantServiceServiceMutation) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*upsertContractVariantServiceServiceMutation
+		UpsertContractVariant json.RawMessage `json:"upsertContractVariant"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.upsertContractVariantServiceServiceMutation = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.UpsertContractVariant
+		src := firstPass.UpsertContractVariant
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalupsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal upsertContractVariantServiceServiceMutation.UpsertContractVariant: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalupsertContractVariantServiceServiceMutation struct {
+	UpsertContractVariant json.RawMessage `json:"upsertContractVariant"`
+}
+
+func (v *upsertContractVariantServiceServiceMutation) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *upsertContractVariantServiceServiceMutation) __premarshalJSON() (*__premarshalupsertContractVariantServiceServiceMutation, error) {
+	var retval __premarshalupsertContractVariantServiceServiceMutation
+
+	{
+
+		dst := &retval.UpsertContractVariant
+		src := v.UpsertContractVariant
+		var err error
+		*dst, err = __marshalupsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal upsertContractVariantServiceServiceMutation.UpsertContractVariant: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertErrors includes the requested fields of the GraphQL type ContractVariantUpsertErrors.
+type upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertErrors struct {
+	Typename string `json:"__typename"`
+	// A list of all errors that occurred when attempting to create or update a contract variant.
+	ErrorMessages []string `json:"errorMessages"`
+}
+
+// GetTypename returns upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertErrors.Typename, and is useful for accessing the field via an interface.
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertErrors) GetTypename() string {
+	return v.Typename
+}
+
+// GetErrorMessages returns upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertErrors.ErrorMessages, and is useful for accessing the field via an interface.
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertErrors) GetErrorMessages() []string {
+	return v.ErrorMessages
+}
+
+// upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult includes the requested fields of the GraphQL interface ContractVariantUpsertResult.
+//
+// upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult is implemented by the following types:
+// upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertErrors
+// upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccess
+type upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult interface {
+	implementsGraphQLInterfaceupsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertErrors) implementsGraphQLInterfaceupsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult() {
+}
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccess) implementsGraphQLInterfaceupsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult() {
+}
+
+func __unmarshalupsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult(b []byte, v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "ContractVariantUpsertErrors":
+		*v = new(upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertErrors)
+		return json.Unmarshal(b, *v)
+	case "ContractVariantUpsertSuccess":
+		*v = new(upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccess)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing ContractVariantUpsertResult.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalupsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult(v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertErrors:
+		typename = "ContractVariantUpsertErrors"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertErrors
+		}{typename, v}
+		return json.Marshal(result)
+	case *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccess:
+		typename = "ContractVariantUpsertSuccess"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccess
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertResult: "%T"`, v)
+	}
+}
+
+// upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccess includes the requested fields of the GraphQL type ContractVariantUpsertSuccess.
+type upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccess struct {
+	Typename string `json:"__typename"`
+	// The updated contract variant
+	ContractVariant upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant `json:"contractVariant"`
+}
+
+// GetTypename returns upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccess.Typename, and is useful for accessing the field via an interface.
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccess) GetTypename() string {
+	return v.Typename
+}
+
+// GetContractVariant returns upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccess.ContractVariant, and is useful for accessing the field via an interface.
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccess) GetContractVariant() upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant {
+	return v.ContractVariant
+}
+
+// upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant includes the requested fields of the GraphQL type GraphVariant.
+// The GraphQL type's documentation follows.
+//
+// A graph variant
+type upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant struct {
+	ContractVariant `json:"-"`
+}
+
+// GetId returns upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant.Id, and is useful for accessing the field via an interface.
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant) GetId() string {
+	return v.ContractVariant.Id
+}
+
+// GetName returns upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant.Name, and is useful for accessing the field via an interface.
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant) GetName() string {
+	return v.ContractVariant.Name
+}
+
+// GetGraphId returns upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant.GraphId, and is useful for accessing the field via an interface.
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant) GetGraphId() string {
+	return v.ContractVariant.GraphId
+}
+
+// GetSourceVariant returns upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant.SourceVariant, and is useful for accessing the field via an interface.
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant) GetSourceVariant() *ContractVariantSourceVariantGraphVariant {
+	return v.ContractVariant.SourceVariant
+}
+
+// GetContractFilterConfig returns upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant.ContractFilterConfig, and is useful for accessing the field via an interface.
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant) GetContractFilterConfig() *ContractVariantContractFilterConfig {
+	return v.ContractVariant.ContractFilterConfig
+}
+
+// GetLatestLaunch returns upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant.LatestLaunch, and is useful for accessing the field via an interface.
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant) GetLatestLaunch() *ContractVariantLatestLaunch {
+	return v.ContractVariant.LatestLaunch
+}
+
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ContractVariant)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	GraphId string `json:"graphId"`
+
+	SourceVariant *ContractVariantSourceVariantGraphVariant `json:"sourceVariant"`
+
+	ContractFilterConfig *ContractVariantContractFilterConfig `json:"contractFilterConfig"`
+
+	LatestLaunch *ContractVariantLatestLaunch `json:"latestLaunch"`
+}
+
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant) __premarshalJSON() (*__premarshalupsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant, error) {
+	var retval __premarshalupsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccessContractVariantGraphVariant
+
+	retval.Id = v.ContractVariant.Id
+	retval.Name = v.ContractVariant.Name
+	retval.GraphId = v.ContractVariant.GraphId
+	retval.SourceVariant = v.ContractVariant.SourceVariant
+	retval.ContractFilterConfig = v.ContractVariant.ContractFilterConfig
+	retval.LatestLaunch = v.ContractVariant.LatestLaunch
+	return &retval, nil
+}
+
+// upsertPagerDutyChannelResponse is returned by upsertPagerDutyChannel on success.
+type upsertPagerDutyChannelResponse struct {
+	Service upsertPagerDutyChannelServiceServiceMutation `json:"service"`
+}
+
+// GetService returns upsertPagerDutyChannelResponse.Service, and is useful for accessing the field via an interface.
+func (v *upsertPagerDutyChannelResponse) GetService() upsertPagerDutyChannelServiceServiceMutation {
+	return v.Service
+}
+
+// upsertPagerDutyChannelServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type upsertPagerDutyChannelServiceServiceMutation struct {
+	// Create/update PagerDuty notification channel
+	UpsertPagerDutyChannel *upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel `json:"upsertPagerDutyChannel"`
+}
+
+// GetUpsertPagerDutyChannel returns upsertPagerDutyChannelServiceServiceMutation.UpsertPagerDutyChannel, and is useful for accessing the field via an interface.
+func (v *upsertPagerDutyChannelServiceServiceMutation) GetUpsertPagerDutyChannel() *upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel {
+	return v.UpsertPagerDutyChannel
+}
+
+// upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel includes the requested fields of the GraphQL type PagerDutyChannel.
+// The GraphQL type's documentation follows.
+//
+// PagerDuty notification channel
+type upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel struct {
+	PagerDutyChannel `json:"-"`
+}
+
+// GetId returns upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel.Id, and is useful for accessing the field via an interface.
+func (v *upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel) GetId() string {
+	return v.PagerDutyChannel.Id
+}
+
+// GetName returns upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel.Name, and is useful for accessing the field via an interface.
+func (v *upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel) GetName() string {
+	return v.PagerDutyChannel.Name
+}
+
+// GetRoutingKey returns upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel.RoutingKey, and is useful for accessing the field via an interface.
+func (v *upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel) GetRoutingKey() string {
+	return v.PagerDutyChannel.RoutingKey
+}
+
+func (v *upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.PagerDutyChannel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	RoutingKey string `json:"routingKey"`
+}
+
+func (v *upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *upsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel) __premarshalJSON() (*__premarshalupsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel, error) {
+	var retval __premarshalupsertPagerDutyChannelServiceServiceMutationUpsertPagerDutyChannel
+
+	retval.Id = v.PagerDutyChannel.Id
+	retval.Name = v.PagerDutyChannel.Name
+	retval.RoutingKey = v.PagerDutyChannel.RoutingKey
+	return &retval, nil
+}
+
+// upsertQueryTriggerResponse is returned by upsertQueryTrigger on success.
+type upsertQueryTriggerResponse struct {
+	Service upsertQueryTriggerServiceServiceMutation `json:"service"`
+}
+
+// GetService returns upsertQueryTriggerResponse.Service, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerResponse) GetService() upsertQueryTriggerServiceServiceMutation {
+	return v.Service
+}
+
+// upsertQueryTriggerServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type upsertQueryTriggerServiceServiceMutation struct {
+	UpsertQueryTrigger *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger `json:"upsertQueryTrigger"`
+}
+
+// GetUpsertQueryTrigger returns upsertQueryTriggerServiceServiceMutation.UpsertQueryTrigger, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutation) GetUpsertQueryTrigger() *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger {
+	return v.UpsertQueryTrigger
+}
+
+// upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger includes the requested fields of the GraphQL type QueryTrigger.
+// The GraphQL type's documentation follows.
+//
+// Query Trigger
+type upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger struct {
+	QueryTrigger `json:"-"`
+}
+
+// GetId returns upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.Id, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) GetId() string {
+	return v.QueryTrigger.Id
+}
+
+// GetVariant returns upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.Variant, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) GetVariant() *string {
+	return v.QueryTrigger.Variant
+}
+
+// GetMetric returns upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.Metric, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) GetMetric() QueryTriggerMetric {
+	return v.QueryTrigger.Metric
+}
+
+// GetComparisonOperator returns upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.ComparisonOperator, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) GetComparisonOperator() ComparisonOperator {
+	return v.QueryTrigger.ComparisonOperator
+}
+
+// GetThreshold returns upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.Threshold, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) GetThreshold() float64 {
+	return v.QueryTrigger.Threshold
+}
+
+// GetPercentile returns upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.Percentile, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) GetPercentile() *float64 {
+	return v.QueryTrigger.Percentile
+}
+
+// GetWindow returns upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.Window, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) GetWindow() QueryTriggerWindow {
+	return v.QueryTrigger.Window
+}
+
+// GetScope returns upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.Scope, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) GetScope() QueryTriggerScope {
+	return v.QueryTrigger.Scope
+}
+
+// GetOperationNames returns upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.OperationNames, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) GetOperationNames() []string {
+	return v.QueryTrigger.OperationNames
+}
+
+// GetExcludedOperationNames returns upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.ExcludedOperationNames, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) GetExcludedOperationNames() []string {
+	return v.QueryTrigger.ExcludedOperationNames
+}
+
+// GetEnabled returns upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.Enabled, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) GetEnabled() bool {
+	return v.QueryTrigger.Enabled
+}
+
+// GetChannels returns upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.Channels, and is useful for accessing the field via an interface.
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) GetChannels() []QueryTriggerChannelsChannel {
+	return v.QueryTrigger.Channels
+}
+
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.QueryTrigger)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupsertQueryTriggerServiceServiceMutationUpsertQueryTrigger struct {
+	Id string `json:"id"`
+
+	Variant *string `json:"variant"`
+
+	Metric QueryTriggerMetric `json:"metric"`
+
+	ComparisonOperator ComparisonOperator `json:"comparisonOperator"`
+
+	Threshold float64 `json:"threshold"`
+
+	Percentile *float64 `json:"percentile"`
+
+	Window QueryTriggerWindow `json:"window"`
+
+	Scope QueryTriggerScope `json:"scope"`
+
+	OperationNames []string `json:"operationNames"`
+
+	ExcludedOperationNames []string `json:"excludedOperationNames"`
+
+	Enabled bool `json:"enabled"`
+
+	Channels []json.RawMessage `json:"channels"`
+}
+
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger) __premarshalJSON() (*__premarshalupsertQueryTriggerServiceServiceMutationUpsertQueryTrigger, error) {
+	var retval __premarshalupsertQueryTriggerServiceServiceMutationUpsertQueryTrigger
+
+	retval.Id = v.QueryTrigger.Id
+	retval.Variant = v.QueryTrigger.Variant
+	retval.Metric = v.QueryTrigger.Metric
+	retval.ComparisonOperator = v.QueryTrigger.ComparisonOperator
+	retval.Threshold = v.QueryTrigger.Threshold
+	retval.Percentile = v.QueryTrigger.Percentile
+	retval.Window = v.QueryTrigger.Window
+	retval.Scope = v.QueryTrigger.Scope
+	retval.OperationNames = v.QueryTrigger.OperationNames
+	retval.ExcludedOperationNames = v.QueryTrigger.ExcludedOperationNames
+	retval.Enabled = v.QueryTrigger.Enabled
+	{
+
+		dst := &retval.Channels
+		src := v.QueryTrigger.Channels
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalQueryTriggerChannelsChannel(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal upsertQueryTriggerServiceServiceMutationUpsertQueryTrigger.QueryTrigger.Channels: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// upsertRegistrySubscriptionResponse is returned by upsertRegistrySubscription on success.
+type upsertRegistrySubscriptionResponse struct {
+	Service upsertRegistrySubscriptionServiceServiceMutation `json:"service"`
+}
+
+// GetService returns upsertRegistrySubscriptionResponse.Service, and is useful for accessing the field via an interface.
+func (v *upsertRegistrySubscriptionResponse) GetService() upsertRegistrySubscriptionServiceServiceMutation {
+	return v.Service
+}
+
+// upsertRegistrySubscriptionServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type upsertRegistrySubscriptionServiceServiceMutation struct {
+	// Create or update a subscription for a service.
+	UpsertRegistrySubscription upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription `json:"upsertRegistrySubscription"`
+}
+
+// GetUpsertRegistrySubscription returns upsertRegistrySubscriptionServiceServiceMutation.UpsertRegistrySubscription, and is useful for accessing the field via an interface.
+func (v *upsertRegistrySubscriptionServiceServiceMutation) GetUpsertRegistrySubscription() upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription {
+	return v.UpsertRegistrySubscription
+}
+
+// upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription includes the requested fields of the GraphQL type RegistrySubscription.
+type upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription struct {
+	RegistrySubscription `json:"-"`
+}
+
+// GetId returns upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription.Id, and is useful for accessing the field via an interface.
+func (v *upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription) GetId() string {
+	return v.RegistrySubscription.Id
+}
+
+// GetVariant returns upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription.Variant, and is useful for accessing the field via an interface.
+func (v *upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription) GetVariant() *string {
+	return v.RegistrySubscription.Variant
+}
+
+// GetEnabled returns upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription.Enabled, and is useful for accessing the field via an interface.
+func (v *upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription) GetEnabled() bool {
+	return v.RegistrySubscription.Enabled
+}
+
+// GetChannel returns upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription.Channel, and is useful for accessing the field via an interface.
+func (v *upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription) GetChannel() RegistrySubscriptionChannel {
+	return v.RegistrySubscription.Channel
+}
+
+// GetOptions returns upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription.Options, and is useful for accessing the field via an interface.
+func (v *upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription) GetOptions() RegistrySubscriptionOptions {
+	return v.RegistrySubscription.Options
+}
+
+func (v *upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.RegistrySubscription)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription struct {
+	Id string `json:"id"`
+
+	Variant *string `json:"variant"`
+
+	Enabled bool `json:"enabled"`
+
+	Channel json.RawMessage `json:"channel"`
+
+	Options RegistrySubscriptionOptions `json:"options"`
+}
+
+func (v *upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription) __premarshalJSON() (*__premarshalupsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription, error) {
+	var retval __premarshalupsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription
+
+	retval.Id = v.RegistrySubscription.Id
+	retval.Variant = v.RegistrySubscription.Variant
+	retval.Enabled = v.RegistrySubscription.Enabled
+	{
+
+		dst := &retval.Channel
+		src := v.RegistrySubscription.Channel
+		var err error
+		*dst, err = __marshalRegistrySubscriptionChannel(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"Unable to marshal upsertRegistrySubscriptionServiceServiceMutationUpsertRegistrySubscription.RegistrySubscription.Channel: %w", err)
+		}
+	}
+	retval.Options = v.RegistrySubscription.Options
+	return &retval, nil
+}
+
+// upsertScheduledSummaryResponse is returned by upsertScheduledSummary on success.
+type upsertScheduledSummaryResponse struct {
+	Service upsertScheduledSummaryServiceServiceMutation `json:"service"`
+}
+
+// GetService returns upsertScheduledSummaryResponse.Service, and is useful for accessing the field via an interface.
+func (v *upsertScheduledSummaryResponse) GetService() upsertScheduledSummaryServiceServiceMutation {
+	return v.Service
+}
+
+// upsertScheduledSummaryServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type upsertScheduledSummaryServiceServiceMutation struct {
+	UpsertScheduledSummary *upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary `json:"upsertScheduledSummary"`
+}
+
+// GetUpsertScheduledSummary returns upsertScheduledSummaryServiceServiceMutation.UpsertScheduledSummary, and is useful for accessing the field via an interface.
+func (v *upsertScheduledSummaryServiceServiceMutation) GetUpsertScheduledSummary() *upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary {
+	return v.UpsertScheduledSummary
+}
+
+// upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary includes the requested fields of the GraphQL type ScheduledSummary.
+type upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary struct {
+	ScheduledSummary `json:"-"`
+}
+
+// GetId returns upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary.Id, and is useful for accessing the field via an interface.
+func (v *upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary) GetId() string {
+	return v.ScheduledSummary.Id
+}
+
+// GetVariant returns upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary.Variant, and is useful for accessing the field via an interface.
+func (v *upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary) GetVariant() string {
+	return v.ScheduledSummary.Variant
+}
+
+// GetTimezone returns upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary.Timezone, and is useful for accessing the field via an interface.
+func (v *upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary) GetTimezone() string {
+	return v.ScheduledSummary.Timezone
+}
+
+// GetEnabled returns upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary.Enabled, and is useful for accessing the field via an interface.
+func (v *upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary) GetEnabled() bool {
+	return v.ScheduledSummary.Enabled
+}
+
+// GetChannels returns upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary.Channels, and is useful for accessing the field via an interface.
+func (v *upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary) GetChannels() []ScheduledSummaryChannelsChannel {
+	return v.ScheduledSummary.Channels
+}
+
+func (v *upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ScheduledSummary)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary struct {
+	Id string `json:"id"`
+
+	Variant string `json:"variant"`
+
+	Timezone string `json:"timezone"`
+
+	Enabled bool `json:"enabled"`
+
+	Channels []json.RawMessage `json:"channels"`
+}
+
+func (v *upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary) __premarshalJSON() (*__premarshalupsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary, error) {
+	var retval __premarshalupsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary
+
+	retval.Id = v.ScheduledSummary.Id
+	retval.Variant = v.ScheduledSummary.Variant
+	retval.Timezone = v.ScheduledSummary.Timezone
+	retval.Enabled = v.ScheduledSummary.Enabled
+	{
+
+		dst := &retval.Channels
+		src := v.ScheduledSummary.Channels
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalScheduledSummaryChannelsChannel(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal upsertScheduledSummaryServiceServiceMutationUpsertScheduledSummary.ScheduledSummary.Channels: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// upsertSlackChannelResponse is returned by upsertSlackChannel on success.
+type upsertSlackChannelResponse struct {
+	Service upsertSlackChannelServiceServiceMutation `json:"service"`
+}
+
+// GetService returns upsertSlackChannelResponse.Service, and is useful for accessing the field via an interface.
+func (v *upsertSlackChannelResponse) GetService() upsertSlackChannelServiceServiceMutation {
+	return v.Service
+}
+
+// upsertSlackChannelServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type upsertSlackChannelServiceServiceMutation struct {
+	// Create/update Slack notification channel
+	UpsertSlackChannel *upsertSlackChannelServiceServiceMutationUpsertSlackChannel `json:"upsertSlackChannel"`
+}
+
+// GetUpsertSlackChannel returns upsertSlackChannelServiceServiceMutation.UpsertSlackChannel, and is useful for accessing the field via an interface.
+func (v *upsertSlackChannelServiceServiceMutation) GetUpsertSlackChannel() *upsertSlackChannelServiceServiceMutationUpsertSlackChannel {
+	return v.UpsertSlackChannel
+}
+
+// upsertSlackChannelServiceServiceMutationUpsertSlackChannel includes the requested fields of the GraphQL type SlackChannel.
+// The GraphQL type's documentation follows.
+//
+// Slack notification channel
+type upsertSlackChannelServiceServiceMutationUpsertSlackChannel struct {
+	SlackChannel `json:"-"`
+}
+
+// GetId returns upsertSlackChannelServiceServiceMutationUpsertSlackChannel.Id, and is useful for accessing the field via an interface.
+func (v *upsertSlackChannelServiceServiceMutationUpsertSlackChannel) GetId() string {
+	return v.SlackChannel.Id
+}
+
+// GetName returns upsertSlackChannelServiceServiceMutationUpsertSlackChannel.Name, and is useful for accessing the field via an interface.
+func (v *upsertSlackChannelServiceServiceMutationUpsertSlackChannel) GetName() string {
+	return v.SlackChannel.Name
+}
+
+// GetUrl returns upsertSlackChannelServiceServiceMutationUpsertSlackChannel.Url, and is useful for accessing the field via an interface.
+func (v *upsertSlackChannelServiceServiceMutationUpsertSlackChannel) GetUrl() string {
+	return v.SlackChannel.Url
+}
+
+func (v *upsertSlackChannelServiceServiceMutationUpsertSlackChannel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*upsertSlackChannelServiceServiceMutationUpsertSlackChannel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.upsertSlackChannelServiceServiceMutationUpsertSlackChannel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SlackChannel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupsertSlackChannelServiceServiceMutationUpsertSlackChannel struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Url string `json:"url"`
+}
+
+func (v *upsertSlackChannelServiceServiceMutationUpsertSlackChannel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *upsertSlackChannelServiceServiceMutationUpsertSlackChannel) __premarshalJSON() (*__premarshalupsertSlackChannelServiceServiceMutationUpsertSlackChannel, error) {
+	var retval __premarshalupsertSlackChannelServiceServiceMutationUpsertSlackChannel
+
+	retval.Id = v.SlackChannel.Id
+	retval.Name = v.SlackChannel.Name
+	retval.Url = v.SlackChannel.Url
+	return &retval, nil
+}
+
+// upsertWebhookChannelResponse is returned by upsertWebhookChannel on success.
+type upsertWebhookChannelResponse struct {
+	Service upsertWebhookChannelServiceServiceMutation `json:"service"`
+}
+
+// GetService returns upsertWebhookChannelResponse.Service, and is useful for accessing the field via an interface.
+func (v *upsertWebhookChannelResponse) GetService() upsertWebhookChannelServiceServiceMutation {
+	return v.Service
+}
+
+// upsertWebhookChannelServiceServiceMutation includes the requested fields of the GraphQL type ServiceMutation.
+// The GraphQL type's documentation follows.
+//
+// Provides access to mutation fields for managing Studio graphs and subgraphs.
+type upsertWebhookChannelServiceServiceMutation struct {
+	UpsertWebhookChannel *upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel `json:"upsertWebhookChannel"`
+}
+
+// GetUpsertWebhookChannel returns upsertWebhookChannelServiceServiceMutation.UpsertWebhookChannel, and is useful for accessing the field via an interface.
+func (v *upsertWebhookChannelServiceServiceMutation) GetUpsertWebhookChannel() *upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel {
+	return v.UpsertWebhookChannel
+}
+
+// upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel includes the requested fields of the GraphQL type WebhookChannel.
+// The GraphQL type's documentation follows.
+//
+// Webhook notification channel
+type upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel struct {
+	WebhookChannel `json:"-"`
+}
+
+// GetId returns upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel.Id, and is useful for accessing the field via an interface.
+func (v *upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel) GetId() string {
+	return v.WebhookChannel.Id
+}
+
+// GetName returns upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel.Name, and is useful for accessing the field via an interface.
+func (v *upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel) GetName() string {
+	return v.WebhookChannel.Name
+}
+
+// GetUrl returns upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel.Url, and is useful for accessing the field via an interface.
+func (v *upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel) GetUrl() string {
+	return v.WebhookChannel.Url
+}
+
+// GetSecretToken returns upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel.SecretToken, and is useful for accessing the field via an interface.
+func (v *upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel) GetSecretToken() *string {
+	return v.WebhookChannel.SecretToken
+}
+
+func (v *upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.WebhookChannel)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalupsertWebhookChannelServiceServiceMutationUpsertWebhookChannel struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Url string `json:"url"`
+
+	SecretToken *string `json:"secretToken"`
+}
+
+func (v *upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *upsertWebhookChannelServiceServiceMutationUpsertWebhookChannel) __premarshalJSON() (*__premarshalupsertWebhookChannelServiceServiceMutationUpsertWebhookChannel, error) {
+	var retval __premarshalupsertWebhookChannelServiceServiceMutationUpsertWebhookChannel
+
+	retval.Id = v.WebhookChannel.Id
+	retval.Name = v.WebhookChannel.Name
+	retval.Url = v.WebhookChannel.Url
+	retval.SecretToken = v.WebhookChannel.SecretToken
+	return &retval, nil
+}
+
+func addVariantLink(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	title *string,
+	linkType LinkInfoType,
+	url string,
+) (*addVariantLinkResponse, error) {
+	req := &graphql.Request{
+		OpName: "addVariantLink",
+		Query: `
+mutation addVariantLink ($serviceId: ID!, $variantName: String!, $title: String, $linkType: LinkInfoType!, $url: String!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			addLinkToVariant(title: $title, type: $linkType, url: $url) {
+				... Variant
+			}
+		}
+	}
+}
+fragment Variant on GraphVariant {
+	id
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
+	}
+	links {
+		id
+		title
+		type
+		url
+	}
+	graphId
+}
+`,
+		Variables: &__addVariantLinkInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+			Title:       title,
+			LinkType:    linkType,
+			Url:         url,
+		},
+	}
+	var err error
+
+	var data addVariantLinkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createKey(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	keyName string,
+	role string,
+) (*createKeyResponse, error) {
+	req := &graphql.Request{
+		OpName: "createKey",
+		Query: `
+mutation createKey ($serviceId: ID!, $keyName: String!, $role: UserPermission!) {
+	service(id: $serviceId) {
+		newKey(keyName: $keyName, role: $role) {
+			... Key
+		}
+	}
+}
+fragment Key on GraphApiKey {
+	id
+	keyName
+	role
+	token
+}
+`,
+		Variables: &__createKeyInput{
+			ServiceId: serviceId,
+			KeyName:   keyName,
+			Role:      role,
+		},
+	}
+	var err error
+
+	var data createKeyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createOrganizationInvitation(
+	ctx context.Context,
+	client graphql.Client,
+	accountId string,
+	email string,
+	role string,
+) (*createOrganizationInvitationResponse, error) {
+	req := &graphql.Request{
+		OpName: "createOrganizationInvitation",
+		Query: `
+mutation createOrganizationInvitation ($accountId: ID!, $email: String!, $role: UserPermission!) {
+	account(id: $accountId) {
+		invite(email: $email, role: $role) {
+			... OrganizationInvitation
+		}
+	}
+}
+fragment OrganizationInvitation on AccountInvitation {
+	id
+	email
+	role
+	acceptedAt
+}
+`,
+		Variables: &__createOrganizationInvitationInput{
+			AccountId: accountId,
+			Email:     email,
+			Role:      role,
+		},
+	}
+	var err error
+
+	var data createOrganizationInvitationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createOrganizationInviteLink(
+	ctx context.Context,
+	client graphql.Client,
+	accountId string,
+	role string,
+) (*createOrganizationInviteLinkResponse, error) {
+	req := &graphql.Request{
+		OpName: "createOrganizationInviteLink",
+		Query: `
+mutation createOrganizationInviteLink ($accountId: ID!, $role: UserPermission!) {
+	account(id: $accountId) {
+		createStaticInvitation(role: $role) {
+			... OrganizationInviteLink
+		}
+	}
+}
+fragment OrganizationInviteLink on OrganizationInviteLink {
+	joinToken
+	role
+	createdAt
+}
+`,
+		Variables: &__createOrganizationInviteLinkInput{
+			AccountId: accountId,
+			Role:      role,
+		},
+	}
+	var err error
+
+	var data createOrganizationInviteLinkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createPersistedQueryList(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	name string,
+	description string,
+) (*createPersistedQueryListResponse, error) {
+	req := &graphql.Request{
+		OpName: "createPersistedQueryList",
+		Query: `
+mutation createPersistedQueryList ($serviceId: ID!, $name: String!, $description: String) {
+	service(id: $serviceId) {
+		createPersistedQueryList(name: $name, description: $description) {
+			__typename
+			... on CreatePersistedQueryListResult {
+				persistedQueryList {
+					... PersistedQueryList
+				}
+			}
+			... on Error {
+				message
+			}
+		}
+	}
+}
+fragment PersistedQueryList on PersistedQueryList {
+	id
+	name
+	description
+}
+`,
+		Variables: &__createPersistedQueryListInput{
+			ServiceId:   serviceId,
+			Name:        name,
+			Description: description,
+		},
+	}
+	var err error
+
+	var data createPersistedQueryListResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createService(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	title string,
+	onboardingArchitecture string,
+	accountId string,
+	description string,
+	hiddenFromUninvitedNonAdminAccountMembers bool,
+) (*createServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "createService",
+		Query: `
+mutation createService ($id: ID!, $title: String!, $onboardingArchitecture: OnboardingArchitecture!, $accountId: ID!, $description: String, $hiddenFromUninvitedNonAdminAccountMembers: Boolean!) {
+	newService(id: $id, title: $title, onboardingArchitecture: $onboardingArchitecture, accountId: $accountId, description: $description, hiddenFromUninvitedNonAdminAccountMembers: $hiddenFromUninvitedNonAdminAccountMembers) {
+		... Service
+	}
+}
+fragment Service on Service {
+	id
+	title
+	onboardingArchitecture
+	accountId
+	description
+	hiddenFromUninvitedNonAdminAccountMembers
+	readme {
+		content
+	}
+}
+`,
+		Variables: &__createServiceInput{
+			Id:                     id,
+			Title:                  title,
+			OnboardingArchitecture: onboardingArchitecture,
+			AccountId:              accountId,
+			Description:            description,
+			HiddenFromUninvitedNonAdminAccountMembers: hiddenFromUninvitedNonAdminAccountMembers,
+		},
+	}
+	var err error
+
+	var data createServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func createVariant(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+) (*createVariantResponse, error) {
+	req := &graphql.Request{
+		OpName: "createVariant",
+		Query: `
+mutation createVariant ($serviceId: ID!, $variantName: String!) {
+	service(id: $serviceId) {
+		uploadSchema(tag: $variantName, schemaDocument: "scalar JSON") {
+			success
+		}
+	}
+}
+`,
+		Variables: &__createVariantInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+		},
+	}
+	var err error
+
+	var data createVariantResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteChannel(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	id string,
+) (*deleteChannelResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteChannel",
+		Query: `
+mutation deleteChannel ($serviceId: ID!, $id: ID!) {
+	service(id: $serviceId) {
+		deleteChannel(id: $id)
+	}
+}
+`,
+		Variables: &__deleteChannelInput{
+			ServiceId: serviceId,
+			Id:        id,
+		},
+	}
+	var err error
+
+	var data deleteChannelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteKey(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	keyId string,
+) (*deleteKeyResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteKey",
+		Query: `
+mutation deleteKey ($serviceId: ID!, $keyId: ID!) {
+	service(id: $serviceId) {
+		removeKey(id: $keyId)
+	}
+}
+`,
+		Variables: &__deleteKeyInput{
+			ServiceId: serviceId,
+			KeyId:     keyId,
+		},
+	}
+	var err error
+
+	var data deleteKeyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteOrganizationInvitation(
+	ctx context.Context,
+	client graphql.Client,
+	accountId string,
+	id string,
+) (*deleteOrganizationInvitationResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteOrganizationInvitation",
+		Query: `
+mutation deleteOrganizationInvitation ($accountId: ID!, $id: ID!) {
+	account(id: $accountId) {
+		removeInvitation(id: $id)
+	}
+}
+`,
+		Variables: &__deleteOrganizationInvitationInput{
+			AccountId: accountId,
+			Id:        id,
+		},
+	}
+	var err error
+
+	var data deleteOrganizationInvitationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteOrganizationInviteLink(
+	ctx context.Context,
+	client graphql.Client,
+	accountId string,
+	joinToken string,
+) (*deleteOrganizationInviteLinkResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteOrganizationInviteLink",
+		Query: `
+mutation deleteOrganizationInviteLink ($accountId: ID!, $joinToken: String!) {
+	account(id: $accountId) {
+		revokeStaticInvitation(token: $joinToken) {
+			joinToken
+		}
+	}
+}
+`,
+		Variables: &__deleteOrganizationInviteLinkInput{
+			AccountId: accountId,
+			JoinToken: joinToken,
+		},
+	}
+	var err error
+
+	var data deleteOrganizationInviteLinkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteOrganizationMember(
+	ctx context.Context,
+	client graphql.Client,
+	accountId string,
+	userId string,
+) (*deleteOrganizationMemberResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteOrganizationMember",
+		Query: `
+mutation deleteOrganizationMember ($accountId: ID!, $userId: ID!) {
+	account(id: $accountId) {
+		removeMember(id: $userId) {
+			id
+		}
+	}
+}
+`,
+		Variables: &__deleteOrganizationMemberInput{
+			AccountId: accountId,
+			UserId:    userId,
+		},
+	}
+	var err error
+
+	var data deleteOrganizationMemberResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deletePersistedQueryList(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	id string,
+) (*deletePersistedQueryListResponse, error) {
+	req := &graphql.Request{
+		OpName: "deletePersistedQueryList",
+		Query: `
+mutation deletePersistedQueryList ($serviceId: ID!, $id: ID!) {
+	service(id: $serviceId) {
+		persistedQueryList(id: $id) {
+			delete {
+				__typename
+				... on Error {
+					message
+				}
+			}
+		}
+	}
+}
+`,
+		Variables: &__deletePersistedQueryListInput{
+			ServiceId: serviceId,
+			Id:        id,
+		},
+	}
+	var err error
+
+	var data deletePersistedQueryListResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteQueryTrigger(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	id string,
+) (*deleteQueryTriggerResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteQueryTrigger",
+		Query: `
+mutation deleteQueryTrigger ($serviceId: ID!, $id: ID!) {
+	service(id: $serviceId) {
+		deleteQueryTrigger(id: $id)
+	}
+}
+`,
+		Variables: &__deleteQueryTriggerInput{
+			ServiceId: serviceId,
+			Id:        id,
+		},
+	}
+	var err error
+
+	var data deleteQueryTriggerResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteRegistrySubscription(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	id string,
+) (*deleteRegistrySubscriptionResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteRegistrySubscription",
+		Query: `
+mutation deleteRegistrySubscription ($serviceId: ID!, $id: ID!) {
+	service(id: $serviceId) {
+		deleteRegistrySubscription(id: $id)
+	}
+}
+`,
+		Variables: &__deleteRegistrySubscriptionInput{
+			ServiceId: serviceId,
+			Id:        id,
+		},
+	}
+	var err error
+
+	var data deleteRegistrySubscriptionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteScheduledSummary(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	id string,
+) (*deleteScheduledSummaryResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteScheduledSummary",
+		Query: `
+mutation deleteScheduledSummary ($serviceId: ID!, $id: ID!) {
+	service(id: $serviceId) {
+		deleteScheduledSummary(id: $id)
+	}
+}
+`,
+		Variables: &__deleteScheduledSummaryInput{
+			ServiceId: serviceId,
+			Id:        id,
+		},
+	}
+	var err error
+
+	var data deleteScheduledSummaryResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteService(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*deleteServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteService",
+		Query: `
+mutation deleteService ($id: ID!) {
+	service(id: $id) {
+		delete
+	}
+}
+`,
+		Variables: &__deleteServiceInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data deleteServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteSubgraph(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	name string,
+) (*deleteSubgraphResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteSubgraph",
+		Query: `
+mutation deleteSubgraph ($serviceId: ID!, $variantName: String!, $name: String!) {
+	service(id: $serviceId) {
+		removeImplementingServiceAndTriggerComposition(graphVariant: $variantName, name: $name) {
+			didExist
+		}
+	}
+}
+`,
+		Variables: &__deleteSubgraphInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+			Name:        name,
+		},
+	}
+	var err error
+
+	var data deleteSubgraphResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func deleteVariant(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+) (*deleteVariantResponse, error) {
+	req := &graphql.Request{
+		OpName: "deleteVariant",
+		Query: `
+mutation deleteVariant ($serviceId: ID!, $variantName: String!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			delete {
+				deleted
+			}
+		}
+	}
+}
+`,
+		Variables: &__deleteVariantInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+		},
+	}
+	var err error
+
+	var data deleteVariantResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getCheckConfiguration(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+) (*getCheckConfigurationResponse, error) {
+	req := &graphql.Request{
+		OpName: "getCheckConfiguration",
+		Query: `
+query getCheckConfiguration ($serviceId: ID!) {
+	service(id: $serviceId) {
+		checkConfiguration {
+			... CheckConfiguration
+		}
+	}
+}
+fragment CheckConfiguration on CheckConfiguration {
+	timeRangeSeconds
+	operationCountThreshold
+	operationCountThresholdPercentage
+	includeBaseVariant
+	includedVariants
+	excludedClients {
+		name
+		version
+	}
+	excludedOperations {
+		ID
+	}
+	downgradeStaticChecks
+	downgradeDefaultValueChange
+}
+`,
+		Variables: &__getCheckConfigurationInput{
+			ServiceId: serviceId,
+		},
+	}
+	var err error
+
+	var data getCheckConfigurationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getContractVariant(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+) (*getContractVariantResponse, error) {
+	req := &graphql.Request{
+		OpName: "getContractVariant",
+		Query: `
+query getContractVariant ($serviceId: ID!, $variantName: String!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			... ContractVariant
+		}
+	}
+}
+fragment ContractVariant on GraphVariant {
+	id
+	name
+	graphId
+	sourceVariant {
+		name
+	}
+	contractFilterConfig {
+		include
+		exclude
+		hideUnreachableTypes
+	}
+	latestLaunch {
+		status
+		build {
+			result {
+				__typename
+				... on BuildFailure {
+					errorMessages {
+						message
+					}
+				}
+			}
+		}
+	}
+}
+`,
+		Variables: &__getContractVariantInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+		},
+	}
+	var err error
+
+	var data getContractVariantResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getGraphUserPermissions(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+) (*getGraphUserPermissionsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getGraphUserPermissions",
+		Query: `
+query getGraphUserPermissions ($serviceId: ID!) {
+	service(id: $serviceId) {
+		roleOverrides {
+			... GraphUserPermission
+		}
+	}
+}
+fragment GraphUserPermission on RoleOverride {
+	user {
+		id
+	}
+	role
+}
+`,
+		Variables: &__getGraphUserPermissionsInput{
+			ServiceId: serviceId,
+		},
+	}
+	var err error
+
+	var data getGraphUserPermissionsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getLinterConfiguration(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+) (*getLinterConfigurationResponse, error) {
+	req := &graphql.Request{
+		OpName: "getLinterConfiguration",
+		Query: `
+query getLinterConfiguration ($serviceId: ID!) {
+	service(id: $serviceId) {
+		linterConfiguration {
+			... LinterConfiguration
+		}
+	}
+}
+fragment LinterConfiguration on GraphLinterConfiguration {
+	allowedTagNames
+	ignoreDeprecated
+	ignoreInaccessible
+	rules {
+		rule
+		level
+	}
+}
+`,
+		Variables: &__getLinterConfigurationInput{
+			ServiceId: serviceId,
+		},
+	}
+	var err error
+
+	var data getLinterConfigurationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getOrganizationInvitations(
+	ctx context.Context,
+	client graphql.Client,
+	accountId string,
+) (*getOrganizationInvitationsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getOrganizationInvitations",
+		Query: `
+query getOrganizationInvitations ($accountId: ID!) {
+	account(id: $accountId) {
+		invitations(includeAccepted: true) {
+			... OrganizationInvitation
+		}
+	}
+}
+fragment OrganizationInvitation on AccountInvitation {
+	id
+	email
+	role
+	acceptedAt
+}
+`,
+		Variables: &__getOrganizationInvitationsInput{
+			AccountId: accountId,
+		},
+	}
+	var err error
+
+	var data getOrganizationInvitationsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getOrganizationInviteLinks(
+	ctx context.Context,
+	client graphql.Client,
+	accountId string,
+) (*getOrganizationInviteLinksResponse, error) {
+	req := &graphql.Request{
+		OpName: "getOrganizationInviteLinks",
+		Query: `
+query getOrganizationInviteLinks ($accountId: ID!) {
+	account(id: $accountId) {
+		staticInvitations {
+			... OrganizationInviteLink
+		}
+	}
+}
+fragment OrganizationInviteLink on OrganizationInviteLink {
+	joinToken
+	role
+	createdAt
+}
+`,
+		Variables: &__getOrganizationInviteLinksInput{
+			AccountId: accountId,
+		},
+	}
+	var err error
+
+	var data getOrganizationInviteLinksResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getOrganizationMembers(
+	ctx context.Context,
+	client graphql.Client,
+	accountId string,
+) (*getOrganizationMembersResponse, error) {
+	req := &graphql.Request{
+		OpName: "getOrganizationMembers",
+		Query: `
+query getOrganizationMembers ($accountId: ID!) {
+	account(id: $accountId) {
+		memberships {
+			... OrganizationMember
+		}
+	}
+}
+fragment OrganizationMember on AccountMembership {
+	user {
+		id
+		email
+	}
+	permission
+}
+`,
+		Variables: &__getOrganizationMembersInput{
+			AccountId: accountId,
+		},
+	}
+	var err error
+
+	var data getOrganizationMembersResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getPagerDutyChannel(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	id string,
+) (*getPagerDutyChannelResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPagerDutyChannel",
+		Query: `
+query getPagerDutyChannel ($serviceId: ID!, $id: ID!) {
+	service(id: $serviceId) {
+		channels(channelIds: [$id]) {
+			__typename
+			... PagerDutyChannel
+		}
+	}
+}
+fragment PagerDutyChannel on PagerDutyChannel {
+	id
+	name
+	routingKey
+}
+`,
+		Variables: &__getPagerDutyChannelInput{
+			ServiceId: serviceId,
+			Id:        id,
+		},
+	}
+	var err error
+
+	var data getPagerDutyChannelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getPersistedQueryList(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	id string,
+) (*getPersistedQueryListResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPersistedQueryList",
+		Query: `
+query getPersistedQueryList ($serviceId: ID!, $id: ID!) {
+	service(id: $serviceId) {
+		persistedQueryList(id: $id) {
+			... PersistedQueryList
+		}
+	}
+}
+fragment PersistedQueryList on PersistedQueryList {
+	id
+	name
+	description
+}
+`,
+		Variables: &__getPersistedQueryListInput{
+			ServiceId: serviceId,
+			Id:        id,
+		},
+	}
+	var err error
+
+	var data getPersistedQueryListResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getPersistedQueryListOperations(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	id string,
+	after *string,
+) (*getPersistedQueryListOperationsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getPersistedQueryListOperations",
+		Query: `
+query getPersistedQueryListOperations ($serviceId: ID!, $id: ID!, $after: String) {
+	service(id: $serviceId) {
+		persistedQueryList(id: $id) {
+			operations(first: 100, after: $after) {
+				edges {
+					node {
+						id
+					}
+				}
+				pageInfo {
+					endCursor
+					hasNextPage
+				}
+			}
+		}
+	}
+}
+`,
+		Variables: &__getPersistedQueryListOperationsInput{
+			ServiceId: serviceId,
+			Id:        id,
+			After:     after,
+		},
+	}
+	var err error
+
+	var data getPersistedQueryListOperationsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getQueryTriggers(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+) (*getQueryTriggersResponse, error) {
+	req := &graphql.Request{
+		OpName: "getQueryTriggers",
+		Query: `
+query getQueryTriggers ($serviceId: ID!) {
+	service(id: $serviceId) {
+		queryTriggers {
+			... QueryTrigger
+		}
+	}
+}
+fragment QueryTrigger on QueryTrigger {
+	id
+	variant
+	metric
+	comparisonOperator
+	threshold
+	percentile
+	window
+	scope
+	operationNames
+	excludedOperationNames
+	enabled
+	channels {
+		__typename
+		id
+	}
+}
+`,
+		Variables: &__getQueryTriggersInput{
+			ServiceId: serviceId,
+		},
+	}
+	var err error
+
+	var data getQueryTriggersResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getRegistrySubscriptions(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+) (*getRegistrySubscriptionsResponse, error) {
+	req := &graphql.Request{
+		OpName: "getRegistrySubscriptions",
+		Query: `
+query getRegistrySubscriptions ($serviceId: ID!) {
+	service(id: $serviceId) {
+		channels {
+			__typename
+			subscriptions {
+				__typename
+				... RegistrySubscription
+			}
+		}
+	}
+}
+fragment RegistrySubscription on RegistrySubscription {
+	id
+	variant
+	enabled
+	channel {
+		__typename
+		id
+	}
+	options {
+		schemaUpdates
+	}
+}
+`,
+		Variables: &__getRegistrySubscriptionsInput{
+			ServiceId: serviceId,
+		},
+	}
+	var err error
+
+	var data getRegistrySubscriptionsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getScheduledSummaries(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+) (*getScheduledSummariesResponse, error) {
+	req := &graphql.Request{
+		OpName: "getScheduledSummaries",
+		Query: `
+query getScheduledSummaries ($serviceId: ID!) {
+	service(id: $serviceId) {
+		scheduledSummaries {
+			... ScheduledSummary
+		}
+	}
+}
+fragment ScheduledSummary on ScheduledSummary {
+	id
+	variant
+	timezone
+	enabled
+	channels {
+		__typename
+		id
+	}
+}
+`,
+		Variables: &__getScheduledSummariesInput{
+			ServiceId: serviceId,
+		},
+	}
+	var err error
+
+	var data getScheduledSummariesResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getService(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+) (*getServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "getService",
+		Query: `
+query getService ($id: ID!) {
+	service(id: $id) {
+		... Service
+	}
+}
+fragment Service on Service {
+	id
+	title
+	onboardingArchitecture
+	accountId
+	description
+	hiddenFromUninvitedNonAdminAccountMembers
+	readme {
+		content
+	}
+}
+`,
+		Variables: &__getServiceInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data getServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getSlackChannel(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	id string,
+) (*getSlackChannelResponse, error) {
+	req := &graphql.Request{
+		OpName: "getSlackChannel",
+		Query: `
+query getSlackChannel ($serviceId: ID!, $id: ID!) {
+	service(id: $serviceId) {
+		channels(channelIds: [$id]) {
+			__typename
+			... SlackChannel
+		}
+	}
+}
+fragment SlackChannel on SlackChannel {
+	id
+	name
+	url
+}
+`,
+		Variables: &__getSlackChannelInput{
+			ServiceId: serviceId,
+			Id:        id,
+		},
+	}
+	var err error
+
+	var data getSlackChannelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getSubgraph(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	name string,
+) (*getSubgraphResponse, error) {
+	req := &graphql.Request{
+		OpName: "getSubgraph",
+		Query: `
+query getSubgraph ($serviceId: ID!, $variantName: String!, $name: ID!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			subgraph(name: $name) {
+				... Subgraph
+			}
+		}
+	}
+}
+fragment Subgraph on FederatedImplementingService {
+	name
+	url
+	revision
+	graphID
+	graphVariant
+	activePartialSchema {
+		sdl
+	}
+}
+`,
+		Variables: &__getSubgraphInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+			Name:        name,
+		},
+	}
+	var err error
+
+	var data getSubgraphResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getVariant(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+) (*getVariantResponse, error) {
+	req := &graphql.Request{
+		OpName: "getVariant",
+		Query: `
+query getVariant ($serviceId: ID!, $variantName: String!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			... Variant
+		}
+	}
+}
+fragment Variant on GraphVariant {
+	id
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
+	}
+	links {
+		id
+		title
+		type
+		url
+	}
+	graphId
+}
+`,
+		Variables: &__getVariantInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+		},
+	}
+	var err error
+
+	var data getVariantResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getVariantCheckConfiguration(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+) (*getVariantCheckConfigurationResponse, error) {
+	req := &graphql.Request{
+		OpName: "getVariantCheckConfiguration",
+		Query: `
+query getVariantCheckConfiguration ($serviceId: ID!, $variantName: String!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			checkConfiguration {
+				... VariantCheckConfiguration
+			}
+		}
+	}
+}
+fragment VariantCheckConfiguration on VariantCheckConfiguration {
+	operationsChecksEnabled
+	timeRangeConfig {
+		useGraphSettings
+		timeRangeSeconds
+		operationCountThreshold
+		operationCountThresholdPercentage
+	}
+	includedVariantsConfig {
+		useGraphSettings
+		includedVariants
+	}
+	excludedClientsConfig {
+		appendGraphSettings
+		excludedClients {
+			name
+			version
+		}
+	}
+	excludedOperationsConfig {
+		appendGraphSettings
+		excludedOperations {
+			id
+		}
+	}
+	downgradeChecksConfig {
+		useGraphSettings
+		downgradeStaticChecks
+		downgradeDefaultValueChange
+	}
+}
+`,
+		Variables: &__getVariantCheckConfigurationInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+		},
+	}
+	var err error
+
+	var data getVariantCheckConfigurationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getVariantPersistedQueryList(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+) (*getVariantPersistedQueryListResponse, error) {
+	req := &graphql.Request{
+		OpName: "getVariantPersistedQueryList",
+		Query: `
+query getVariantPersistedQueryList ($serviceId: ID!, $variantName: String!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			persistedQueryList {
+				id
+			}
+		}
+	}
+}
+`,
+		Variables: &__getVariantPersistedQueryListInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+		},
+	}
+	var err error
+
+	var data getVariantPersistedQueryListResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func getWebhookChannel(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	id string,
+) (*getWebhookChannelResponse, error) {
+	req := &graphql.Request{
+		OpName: "getWebhookChannel",
+		Query: `
+query getWebhookChannel ($serviceId: ID!, $id: ID!) {
+	service(id: $serviceId) {
+		channels(channelIds: [$id]) {
+			__typename
+			... WebhookChannel
+		}
+	}
+}
+fragment WebhookChannel on WebhookChannel {
+	id
+	name
+	url
+	secretToken
+}
+`,
+		Variables: &__getWebhookChannelInput{
+			ServiceId: serviceId,
+			Id:        id,
+		},
+	}
+	var err error
+
+	var data getWebhookChannelResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func linkPersistedQueryList(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	id string,
+) (*linkPersistedQueryListResponse, error) {
+	req := &graphql.Request{
+		OpName: "linkPersistedQueryList",
+		Query: `
+mutation linkPersistedQueryList ($serviceId: ID!, $variantName: String!, $id: ID!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			linkPersistedQueryList(persistedQueryListId: $id) {
+				__typename
+				... on Error {
+					message
+				}
+			}
+		}
+	}
+}
+`,
+		Variables: &__linkPersistedQueryListInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+			Id:          id,
+		},
+	}
+	var err error
+
+	var data linkPersistedQueryListResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func listKeys(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+) (*listKeysResponse, error) {
+	req := &graphql.Request{
+		OpName: "listKeys",
+		Query: `
+query listKeys ($serviceId: ID!) {
+	service(id: $serviceId) {
+		apiKeys {
+			... Key
+		}
+	}
+}
+fragment Key on GraphApiKey {
+	id
+	keyName
+	role
+	token
+}
+`,
+		Variables: &__listKeysInput{
+			ServiceId: serviceId,
+		},
+	}
+	var err error
+
+	var data listKeysResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func publishOperations(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	id string,
+	operations []PersistedQueryInput,
+	removeOperations []string,
+) (*publishOperationsResponse, error) {
+	req := &graphql.Request{
+		OpName: "publishOperations",
+		Query: `
+mutation publishOperations ($serviceId: ID!, $id: ID!, $operations: [PersistedQueryInput!], $removeOperations: [ID!]) {
+	service(id: $serviceId) {
+		persistedQueryList(id: $id) {
+			publishOperations(operations: $operations, removeOperations: $removeOperations) {
+				__typename
+				... on Error {
+					message
+				}
+			}
+		}
+	}
+}
+`,
+		Variables: &__publishOperationsInput{
+			ServiceId:        serviceId,
+			Id:               id,
+			Operations:       operations,
+			RemoveOperations: removeOperations,
+		},
+	}
+	var err error
+
+	var data publishOperationsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func publishSubgraph(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	name string,
+	url string,
+	revision string,
+	schema string,
+) (*publishSubgraphResponse, error) {
+	req := &graphql.Request{
+		OpName: "publishSubgraph",
+		Query: `
+mutation publishSubgraph ($serviceId: ID!, $variantName: String!, $name: String!, $url: String, $revision: String!, $schema: String!) {
+	service(id: $serviceId) {
+		publishSubgraph(graphVariant: $variantName, name: $name, url: $url, revision: $revision, activePartialSchema: {sdl:$schema}) {
+			... SubgraphComposition
+		}
+	}
+}
+fragment SubgraphComposition on CompositionAndUpsertResult {
+	errors {
+		message
+	}
+	compositionConfig {
+		schemaHash
+	}
+	launch {
+		id
+	}
+}
+`,
+		Variables: &__publishSubgraphInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+			Name:        name,
+			Url:         url,
+			Revision:    revision,
+			Schema:      schema,
+		},
+	}
+	var err error
+
+	var data publishSubgraphResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func removeVariantLink(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	linkInfoId string,
+) (*removeVariantLinkResponse, error) {
+	req := &graphql.Request{
+		OpName: "removeVariantLink",
+		Query: `
+mutation removeVariantLink ($serviceId: ID!, $variantName: String!, $linkInfoId: ID!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			removeLinkFromVariant(linkInfoId: $linkInfoId) {
+				... Variant
+			}
+		}
+	}
+}
+fragment Variant on GraphVariant {
+	id
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
+	}
+	links {
+		id
+		title
+		type
+		url
+	}
+	graphId
+}
+`,
+		Variables: &__removeVariantLinkInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+			LinkInfoId:  linkInfoId,
+		},
+	}
+	var err error
+
+	var data removeVariantLinkResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func resendOrganizationInvitation(
+	ctx context.Context,
+	client graphql.Client,
+	accountId string,
+	id string,
+) (*resendOrganizationInvitationResponse, error) {
+	req := &graphql.Request{
+		OpName: "resendOrganizationInvitation",
+		Query: `
+mutation resendOrganizationInvitation ($accountId: ID!, $id: ID!) {
+	account(id: $accountId) {
+		resendInvitation(id: $id) {
+			... OrganizationInvitation
+		}
+	}
+}
+fragment OrganizationInvitation on AccountInvitation {
+	id
+	email
+	role
+	acceptedAt
+}
+`,
+		Variables: &__resendOrganizationInvitationInput{
+			AccountId: accountId,
+			Id:        id,
+		},
+	}
+	var err error
+
+	var data resendOrganizationInvitationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func transferService(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	to string,
+) (*transferServiceResponse, error) {
+	req := &graphql.Request{
+		OpName: "transferService",
+		Query: `
+mutation transferService ($id: ID!, $to: String!) {
+	service(id: $id) {
+		transfer(to: $to) {
+			... Service
+		}
+	}
+}
+fragment Service on Service {
+	id
+	title
+	onboardingArchitecture
+	accountId
+	description
+	hiddenFromUninvitedNonAdminAccountMembers
+	readme {
+		content
+	}
+}
+`,
+		Variables: &__transferServiceInput{
+			Id: id,
+			To: to,
+		},
+	}
+	var err error
+
+	var data transferServiceResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func unlinkPersistedQueryList(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+) (*unlinkPersistedQueryListResponse, error) {
+	req := &graphql.Request{
+		OpName: "unlinkPersistedQueryList",
+		Query: `
+mutation unlinkPersistedQueryList ($serviceId: ID!, $variantName: String!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			unlinkPersistedQueryList {
+				__typename
+				... on Error {
+					message
+				}
+			}
+		}
+	}
+}
+`,
+		Variables: &__unlinkPersistedQueryListInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+		},
+	}
+	var err error
+
+	var data unlinkPersistedQueryListResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateCheckConfiguration(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	timeRangeSeconds *int64,
+	operationCountThreshold *int,
+	operationCountThresholdPercentage *float64,
+	includeBaseVariant *bool,
+	includedVariants []string,
+	excludedClients []ClientFilterInput,
+	excludedOperations []ExcludedOperationInput,
+	downgradeStaticChecks *bool,
+	downgradeDefaultValueChange *bool,
+) (*updateCheckConfigurationResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateCheckConfiguration",
+		Query: `
+mutation updateCheckConfiguration ($serviceId: ID!, $timeRangeSeconds: Long, $operationCountThreshold: Int, $operationCountThresholdPercentage: Float, $includeBaseVariant: Boolean, $includedVariants: [String!], $excludedClients: [ClientFilterInput!], $excludedOperations: [ExcludedOperationInput!], $downgradeStaticChecks: Boolean, $downgradeDefaultValueChange: Boolean) {
+	service(id: $serviceId) {
+		updateCheckConfiguration(timeRangeSeconds: $timeRangeSeconds, operationCountThreshold: $operationCountThreshold, operationCountThresholdPercentage: $operationCountThresholdPercentage, includeBaseVariant: $includeBaseVariant, includedVariants: $includedVariants, excludedClients: $excludedClients, excludedOperations: $excludedOperations, downgradeStaticChecks: $downgradeStaticChecks, downgradeDefaultValueChange: $downgradeDefaultValueChange) {
+			... CheckConfiguration
+		}
+	}
+}
+fragment CheckConfiguration on CheckConfiguration {
+	timeRangeSeconds
+	operationCountThreshold
+	operationCountThresholdPercentage
+	includeBaseVariant
+	includedVariants
+	excludedClients {
+		name
+		version
+	}
+	excludedOperations {
+		ID
+	}
+	downgradeStaticChecks
+	downgradeDefaultValueChange
+}
+`,
+		Variables: &__updateCheckConfigurationInput{
+			ServiceId:                         serviceId,
+			TimeRangeSeconds:                  timeRangeSeconds,
+			OperationCountThreshold:           operationCountThreshold,
+			OperationCountThresholdPercentage: operationCountThresholdPercentage,
+			IncludeBaseVariant:                includeBaseVariant,
+			IncludedVariants:                  includedVariants,
+			ExcludedClients:                   excludedClients,
+			ExcludedOperations:                excludedOperations,
+			DowngradeStaticChecks:             downgradeStaticChecks,
+			DowngradeDefaultValueChange:       downgradeDefaultValueChange,
+		},
+	}
+	var err error
+
+	var data updateCheckConfigurationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateGraphUserPermission(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	userId string,
+	permission *string,
+) (*updateGraphUserPermissionResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateGraphUserPermission",
+		Query: `
+mutation updateGraphUserPermission ($serviceId: ID!, $userId: ID!, $permission: UserPermission) {
+	service(id: $serviceId) {
+		overrideUserPermission(userID: $userId, permission: $permission) {
+			id
+		}
+	}
+}
+`,
+		Variables: &__updateGraphUserPermissionInput{
+			ServiceId:  serviceId,
+			UserId:     userId,
+			Permission: permission,
+		},
+	}
+	var err error
+
+	var data updateGraphUserPermissionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateKey(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	keyId string,
+	keyName string,
+) (*updateKeyResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateKey",
+		Query: `
+mutation updateKey ($serviceId: ID!, $keyId: ID!, $keyName: String) {
+	service(id: $serviceId) {
+		renameKey(id: $keyId, newKeyName: $keyName) {
+			... Key
+		}
+	}
+}
+fragment Key on GraphApiKey {
+	id
+	keyName
+	role
+	token
+}
+`,
+		Variables: &__updateKeyInput{
+			ServiceId: serviceId,
+			KeyId:     keyId,
+			KeyName:   keyName,
+		},
+	}
+	var err error
+
+	var data updateKeyResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateLinterConfiguration(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	changes GraphLinterConfigurationChangesInput,
+) (*updateLinterConfigurationResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateLinterConfiguration",
+		Query: `
+mutation updateLinterConfiguration ($serviceId: ID!, $changes: GraphLinterConfigurationChangesInput!) {
+	service(id: $serviceId) {
+		updateLinterConfiguration(changes: $changes) {
+			... LinterConfiguration
+		}
+	}
+}
+fragment LinterConfiguration on GraphLinterConfiguration {
+	allowedTagNames
+	ignoreDeprecated
+	ignoreInaccessible
+	rules {
+		rule
+		level
+	}
+}
+`,
+		Variables: &__updateLinterConfigurationInput{
+			ServiceId: serviceId,
+			Changes:   changes,
+		},
+	}
+	var err error
+
+	var data updateLinterConfigurationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateOrganizationMember(
+	ctx context.Context,
+	client graphql.Client,
+	accountId string,
+	userId string,
+	permission string,
+) (*updateOrganizationMemberResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateOrganizationMember",
+		Query: `
+mutation updateOrganizationMember ($accountId: ID!, $userId: ID!, $permission: UserPermission!) {
+	account(id: $accountId) {
+		updateUserPermission(userID: $userId, permission: $permission) {
+			id
+		}
+	}
+}
+`,
+		Variables: &__updateOrganizationMemberInput{
+			AccountId:  accountId,
+			UserId:     userId,
+			Permission: permission,
+		},
+	}
+	var err error
+
+	var data updateOrganizationMemberResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updatePersistedQueryList(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	id string,
+	name string,
+	description string,
+) (*updatePersistedQueryListResponse, error) {
+	req := &graphql.Request{
+		OpName: "updatePersistedQueryList",
+		Query: `
+mutation updatePersistedQueryList ($serviceId: ID!, $id: ID!, $name: String!, $description: String) {
+	service(id: $serviceId) {
+		persistedQueryList(id: $id) {
+			updateMetadata(name: $name, description: $description) {
+				__typename
+				... on UpdatePersistedQueryListMetadataResult {
+					persistedQueryList {
+						... PersistedQueryList
+					}
+				}
+				... on Error {
+					message
+				}
+			}
+		}
+	}
+}
+fragment PersistedQueryList on PersistedQueryList {
+	id
+	name
+	description
+}
+`,
+		Variables: &__updatePersistedQueryListInput{
+			ServiceId:   serviceId,
+			Id:          id,
+			Name:        name,
+			Description: description,
+		},
+	}
+	var err error
+
+	var data updatePersistedQueryListResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateServiceDescription(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	description string,
+) (*updateServiceDescriptionResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateServiceDescription",
+		Query: `
+mutation updateServiceDescription ($id: ID!, $description: String!) {
+	service(id: $id) {
+		updateDescription(description: $description) {
+			... Service
+		}
+	}
+}
+fragment Service on Service {
+	id
+	title
+	onboardingArchitecture
+	accountId
+	description
+	hiddenFromUninvitedNonAdminAccountMembers
+	readme {
+		content
+	}
+}
+`,
+		Variables: &__updateServiceDescriptionInput{
+			Id:          id,
+			Description: description,
+		},
+	}
+	var err error
+
+	var data updateServiceDescriptionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateServiceHiddenFromUninvitedNonAdminAccountMembers(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	hiddenFromUninvitedNonAdminAccountMembers bool,
+) (*updateServiceHiddenFromUninvitedNonAdminAccountMembersResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateServiceHiddenFromUninvitedNonAdminAccountMembers",
+		Query: `
+mutation updateServiceHiddenFromUninvitedNonAdminAccountMembers ($id: ID!, $hiddenFromUninvitedNonAdminAccountMembers: Boolean!) {
+	service(id: $id) {
+		updateHiddenFromUninvitedNonAdminAccountMembers(hiddenFromUninvitedNonAdminAccountMembers: $hiddenFromUninvitedNonAdminAccountMembers) {
+			... Service
+		}
+	}
+}
+fragment Service on Service {
+	id
+	title
+	onboardingArchitecture
+	accountId
+	description
+	hiddenFromUninvitedNonAdminAccountMembers
+	readme {
+		content
+	}
+}
+`,
+		Variables: &__updateServiceHiddenFromUninvitedNonAdminAccountMembersInput{
+			Id: id,
+			HiddenFromUninvitedNonAdminAccountMembers: hiddenFromUninvitedNonAdminAccountMembers,
+		},
+	}
+	var err error
+
+	var data updateServiceHiddenFromUninvitedNonAdminAccountMembersResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateServiceReadme(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	readme string,
+) (*updateServiceReadmeResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateServiceReadme",
+		Query: `
+mutation updateServiceReadme ($id: ID!, $readme: String!) {
+	service(id: $id) {
+		updateReadme(readme: $readme) {
+			... Service
+		}
+	}
+}
+fragment Service on Service {
+	id
+	title
+	onboardingArchitecture
+	accountId
+	description
+	hiddenFromUninvitedNonAdminAccountMembers
+	readme {
+		content
+	}
+}
+`,
+		Variables: &__updateServiceReadmeInput{
+			Id:     id,
+			Readme: readme,
+		},
+	}
+	var err error
+
+	var data updateServiceReadmeResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateServiceTitle(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	title string,
+) (*updateServiceTitleResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateServiceTitle",
+		Query: `
+mutation updateServiceTitle ($id: ID!, $title: String!) {
+	service(id: $id) {
+		updateTitle(title: $title) {
+			... Service
+		}
+	}
+}
+fragment Service on Service {
+	id
+	title
+	onboardingArchitecture
+	accountId
+	description
+	hiddenFromUninvitedNonAdminAccountMembers
+	readme {
+		content
+	}
+}
+`,
+		Variables: &__updateServiceTitleInput{
+			Id:    id,
+			Title: title,
+		},
+	}
+	var err error
+
+	var data updateServiceTitleResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateVariantCheckConfigurationDowngradeChecks(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	useGraphSettings bool,
+	downgradeStaticChecks *bool,
+	downgradeDefaultValueChange *bool,
+) (*updateVariantCheckConfigurationDowngradeChecksResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateVariantCheckConfigurationDowngradeChecks",
+		Query: `
+mutation updateVariantCheckConfigurationDowngradeChecks ($serviceId: ID!, $variantName: String!, $useGraphSettings: Boolean!, $downgradeStaticChecks: Boolean, $downgradeDefaultValueChange: Boolean) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			updateCheckConfigurationDowngradeChecks(useGraphSettings: $useGraphSettings, downgradeStaticChecks: $downgradeStaticChecks, downgradeDefaultValueChange: $downgradeDefaultValueChange) {
+				... VariantCheckConfiguration
+			}
+		}
+	}
+}
+fragment VariantCheckConfiguration on VariantCheckConfiguration {
+	operationsChecksEnabled
+	timeRangeConfig {
+		useGraphSettings
+		timeRangeSeconds
+		operationCountThreshold
+		operationCountThresholdPercentage
+	}
+	includedVariantsConfig {
+		useGraphSettings
+		includedVariants
+	}
+	excludedClientsConfig {
+		appendGraphSettings
+		excludedClients {
+			name
+			version
+		}
+	}
+	excludedOperationsConfig {
+		appendGraphSettings
+		excludedOperations {
+			id
+		}
+	}
+	downgradeChecksConfig {
+		useGraphSettings
+		downgradeStaticChecks
+		downgradeDefaultValueChange
+	}
+}
+`,
+		Variables: &__updateVariantCheckConfigurationDowngradeChecksInput{
+			ServiceId:                   serviceId,
+			VariantName:                 variantName,
+			UseGraphSettings:            useGraphSettings,
+			DowngradeStaticChecks:       downgradeStaticChecks,
+			DowngradeDefaultValueChange: downgradeDefaultValueChange,
+		},
+	}
+	var err error
+
+	var data updateVariantCheckConfigurationDowngradeChecksResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateVariantCheckConfigurationEnableOperationsCheck(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	enabled bool,
+) (*updateVariantCheckConfigurationEnableOperationsCheckResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateVariantCheckConfigurationEnableOperationsCheck",
+		Query: `
+mutation updateVariantCheckConfigurationEnableOperationsCheck ($serviceId: ID!, $variantName: String!, $enabled: Boolean!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			updateCheckConfigurationEnableOperationsCheck(enabled: $enabled) {
+				... VariantCheckConfiguration
+			}
+		}
+	}
+}
+fragment VariantCheckConfiguration on VariantCheckConfiguration {
+	operationsChecksEnabled
+	timeRangeConfig {
+		useGraphSettings
+		timeRangeSeconds
+		operationCountThreshold
+		operationCountThresholdPercentage
+	}
+	includedVariantsConfig {
+		useGraphSettings
+		includedVariants
+	}
+	excludedClientsConfig {
+		appendGraphSettings
+		excludedClients {
+			name
+			version
+		}
+	}
+	excludedOperationsConfig {
+		appendGraphSettings
+		excludedOperations {
+			id
+		}
+	}
+	downgradeChecksConfig {
+		useGraphSettings
+		downgradeStaticChecks
+		downgradeDefaultValueChange
+	}
+}
+`,
+		Variables: &__updateVariantCheckConfigurationEnableOperationsCheckInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+			Enabled:     enabled,
+		},
+	}
+	var err error
+
+	var data updateVariantCheckConfigurationEnableOperationsCheckResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateVariantCheckConfigurationExcludedClients(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	appendGraphSettings bool,
+	excludedClients []ClientFilterInput,
+) (*updateVariantCheckConfigurationExcludedClientsResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateVariantCheckConfigurationExcludedClients",
+		Query: `
+mutation updateVariantCheckConfigurationExcludedClients ($serviceId: ID!, $variantName: String!, $appendGraphSettings: Boolean!, $excludedClients: [ClientFilterInput!]) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			updateCheckConfigurationExcludedClients(appendGraphSettings: $appendGraphSettings, excludedClients: $excludedClients) {
+				... VariantCheckConfiguration
+			}
+		}
+	}
+}
+fragment VariantCheckConfiguration on VariantCheckConfiguration {
+	operationsChecksEnabled
+	timeRangeConfig {
+		useGraphSettings
+		timeRangeSeconds
+		operationCountThreshold
+		operationCountThresholdPercentage
+	}
+	includedVariantsConfig {
+		useGraphSettings
+		includedVariants
+	}
+	excludedClientsConfig {
+		appendGraphSettings
+		excludedClients {
+			name
+			version
+		}
+	}
+	excludedOperationsConfig {
+		appendGraphSettings
+		excludedOperations {
+			id
+		}
+	}
+	downgradeChecksConfig {
+		useGraphSettings
+		downgradeStaticChecks
+		downgradeDefaultValueChange
+	}
+}
+`,
+		Variables: &__updateVariantCheckConfigurationExcludedClientsInput{
+			ServiceId:           serviceId,
+			VariantName:         variantName,
+			AppendGraphSettings: appendGraphSettings,
+			ExcludedClients:     excludedClients,
+		},
+	}
+	var err error
+
+	var data updateVariantCheckConfigurationExcludedClientsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateVariantCheckConfigurationExcludedOperations(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	appendGraphSettings bool,
+	excludedOperations []OperationInfoFilterInput,
+) (*updateVariantCheckConfigurationExcludedOperationsResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateVariantCheckConfigurationExcludedOperations",
+		Query: `
+mutation updateVariantCheckConfigurationExcludedOperations ($serviceId: ID!, $variantName: String!, $appendGraphSettings: Boolean!, $excludedOperations: [OperationInfoFilterInput!]) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			updateCheckConfigurationExcludedOperations(appendGraphSettings: $appendGraphSettings, excludedOperations: $excludedOperations) {
+				... VariantCheckConfiguration
+			}
+		}
+	}
+}
+fragment VariantCheckConfiguration on VariantCheckConfiguration {
+	operationsChecksEnabled
+	timeRangeConfig {
+		useGraphSettings
+		timeRangeSeconds
+		operationCountThreshold
+		operationCountThresholdPercentage
+	}
+	includedVariantsConfig {
+		useGraphSettings
+		includedVariants
+	}
+	excludedClientsConfig {
+		appendGraphSettings
+		excludedClients {
+			name
+			version
+		}
+	}
+	excludedOperationsConfig {
+		appendGraphSettings
+		excludedOperations {
+			id
+		}
+	}
+	downgradeChecksConfig {
+		useGraphSettings
+		downgradeStaticChecks
+		downgradeDefaultValueChange
+	}
+}
+`,
+		Variables: &__updateVariantCheckConfigurationExcludedOperationsInput{
+			ServiceId:           serviceId,
+			VariantName:         variantName,
+			AppendGraphSettings: appendGraphSettings,
+			ExcludedOperations:  excludedOperations,
+		},
+	}
+	var err error
+
+	var data updateVariantCheckConfigurationExcludedOperationsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateVariantCheckConfigurationIncludedVariants(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	useGraphSettings bool,
+	includedVariants []string,
+) (*updateVariantCheckConfigurationIncludedVariantsResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateVariantCheckConfigurationIncludedVariants",
+		Query: `
+mutation updateVariantCheckConfigurationIncludedVariants ($serviceId: ID!, $variantName: String!, $useGraphSettings: Boolean!, $includedVariants: [String!]) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			updateCheckConfigurationIncludedVariants(useGraphSettings: $useGraphSettings, includedVariants: $includedVariants) {
+				... VariantCheckConfiguration
+			}
+		}
+	}
+}
+fragment VariantCheckConfiguration on VariantCheckConfiguration {
+	operationsChecksEnabled
+	timeRangeConfig {
+		useGraphSettings
+		timeRangeSeconds
+		operationCountThreshold
+		operationCountThresholdPercentage
+	}
+	includedVariantsConfig {
+		useGraphSettings
+		includedVariants
+	}
+	excludedClientsConfig {
+		appendGraphSettings
+		excludedClients {
+			name
+			version
+		}
+	}
+	excludedOperationsConfig {
+		appendGraphSettings
+		excludedOperations {
+			id
+		}
+	}
+	downgradeChecksConfig {
+		useGraphSettings
+		downgradeStaticChecks
+		downgradeDefaultValueChange
+	}
+}
+`,
+		Variables: &__updateVariantCheckConfigurationIncludedVariantsInput{
+			ServiceId:        serviceId,
+			VariantName:      variantName,
+			UseGraphSettings: useGraphSettings,
+			IncludedVariants: includedVariants,
+		},
+	}
+	var err error
+
+	var data updateVariantCheckConfigurationIncludedVariantsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func updateVariantCheckConfigurationTimeRange(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	useGraphSettings bool,
+	timeRangeSeconds *int64,
+	operationCountThreshold *int,
+	operationCountThresholdPercentage *float64,
+) (*updateVariantCheckConfigurationTimeRangeResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateVariantCheckConfigurationTimeRange",
+		Query: `
+mutation updateVariantCheckConfigurationTimeRange ($serviceId: ID!, $variantName: String!, $useGraphSettings: Boolean!, $timeRangeSeconds: Long, $operationCountThreshold: Int, $operationCountThresholdPercentage: Float) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			updateCheckConfigurationTimeRange(useGraphSettings: $useGraphSettings, timeRangeSeconds: $timeRangeSeconds, operationCountThreshold: $operationCountThreshold, operationCountThresholdPercentage: $operationCountThresholdPercentage) {
+				... VariantCheckConfiguration
+			}
+		}
+	}
+}
+fragment VariantCheckConfiguration on VariantCheckConfiguration {
+	operationsChecksEnabled
+	timeRangeConfig {
+		useGraphSettings
+		timeRangeSeconds
+		operationCountThreshold
+		operationCountThresholdPercentage
+	}
+	includedVariantsConfig {
+		useGraphSettings
+		includedVariants
+	}
+	excludedClientsConfig {
+		appendGraphSettings
+		excludedClients {
+			name
+			version
+		}
+	}
+	excludedOperationsConfig {
+		appendGraphSettings
+		excludedOperations {
+			id
+		}
+	}
+	downgradeChecksConfig {
+		useGraphSettings
+		downgradeStaticChecks
+		downgradeDefaultValueChange
+	}
 }
+`,
+		Variables: &__updateVariantCheckConfigurationTimeRangeInput{
+			ServiceId:                         serviceId,
+			VariantName:                       variantName,
+			UseGraphSettings:                  useGraphSettings,
+			TimeRangeSeconds:                  timeRangeSeconds,
+			OperationCountThreshold:           operationCountThreshold,
+			OperationCountThresholdPercentage: operationCountThresholdPercentage,
+		},
+	}
+	var err error
 
-// updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant includes the requested fields of the GraphQL type GraphVariant.
-// The GraphQL type's documentation follows.
-//
-// A graph variant
-type updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant struct {
-	Variant `json:"-"`
-}
+	var data updateVariantCheckConfigurationTimeRangeResponse
+	resp := &graphql.Response{Data: &data}
 
-// GetId returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.Id, and is useful for accessing the field via an interface.
-func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetId() string {
-	return v.Variant.Id
-}
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-// GetName returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.Name, and is useful for accessing the field via an interface.
-func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetName() string {
-	return v.Variant.Name
+	return &data, err
 }
 
-// GetIsPublic returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.IsPublic, and is useful for accessing the field via an interface.
-func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetIsPublic() bool {
-	return v.Variant.IsPublic
+func updateVariantIsProtected(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	isProtected bool,
+) (*updateVariantIsProtectedResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateVariantIsProtected",
+		Query: `
+mutation updateVariantIsProtected ($serviceId: ID!, $variantName: String!, $isProtected: Boolean!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			updateIsProtected(isProtected: $isProtected) {
+				... Variant
+			}
+		}
+	}
 }
-
-// GetUrl returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.Url, and is useful for accessing the field via an interface.
-func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetUrl() *string {
-	return v.Variant.Url
+fragment Variant on GraphVariant {
+	id
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
+	}
+	links {
+		id
+		title
+		type
+		url
+	}
+	graphId
 }
+`,
+		Variables: &__updateVariantIsProtectedInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+			IsProtected: isProtected,
+		},
+	}
+	var err error
 
-// GetGraphId returns updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant.GraphId, and is useful for accessing the field via an interface.
-func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) GetGraphId() string {
-	return v.Variant.GraphId
-}
+	var data updateVariantIsProtectedResponse
+	resp := &graphql.Response{Data: &data}
 
-func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) UnmarshalJSON(b []byte) error {
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-	if string(b) == "null" {
-		return nil
-	}
+	return &data, err
+}
 
-	var firstPass struct {
-		*updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant
-		graphql.NoUnmarshalJSON
+func updateVariantIsPublic(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	isPublic bool,
+) (*updateVariantIsPublicResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateVariantIsPublic",
+		Query: `
+mutation updateVariantIsPublic ($serviceId: ID!, $variantName: String!, $isPublic: Boolean!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			updateVariantIsPublic(isPublic: $isPublic) {
+				... Variant
+			}
+		}
 	}
-	firstPass.updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant = v
-
-	err := json.Unmarshal(b, &firstPass)
-	if err != nil {
-		return err
+}
+fragment Variant on GraphVariant {
+	id
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
 	}
-
-	err = json.Unmarshal(
-		b, &v.Variant)
-	if err != nil {
-		return err
+	links {
+		id
+		title
+		type
+		url
 	}
-	return nil
+	graphId
 }
+`,
+		Variables: &__updateVariantIsPublicInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+			IsPublic:    isPublic,
+		},
+	}
+	var err error
 
-type __premarshalupdateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant struct {
-	Id string `json:"id"`
-
-	Name string `json:"name"`
-
-	IsPublic bool `json:"isPublic"`
+	var data updateVariantIsPublicResponse
+	resp := &graphql.Response{Data: &data}
 
-	Url *string `json:"url"`
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
 
-	GraphId string `json:"graphId"`
+	return &data, err
 }
 
-func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) MarshalJSON() ([]byte, error) {
-	premarshaled, err := v.__premarshalJSON()
-	if err != nil {
-		return nil, err
+func updateVariantIsPubliclyListed(
+	ctx context.Context,
+	client graphql.Client,
+	serviceId string,
+	variantName string,
+	isPubliclyListed bool,
+) (*updateVariantIsPubliclyListedResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateVariantIsPubliclyListed",
+		Query: `
+mutation updateVariantIsPubliclyListed ($serviceId: ID!, $variantName: String!, $isPubliclyListed: Boolean!) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			updateVariantIsPubliclyListed(isPubliclyListed: $isPubliclyListed) {
+				... Variant
+			}
+		}
 	}
-	return json.Marshal(premarshaled)
 }
+fragment Variant on GraphVariant {
+	id
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
+	}
+	links {
+		id
+		title
+		type
+		url
+	}
+	graphId
+}
+`,
+		Variables: &__updateVariantIsPubliclyListedInput{
+			ServiceId:        serviceId,
+			VariantName:      variantName,
+			IsPubliclyListed: isPubliclyListed,
+		},
+	}
+	var err error
 
-func (v *updateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant) __premarshalJSON() (*__premarshalupdateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant, error) {
-	var retval __premarshalupdateVariantURLServiceServiceMutationVariantGraphVariantMutationUpdateURLGraphVariant
+	var data updateVariantIsPubliclyListedResponse
+	resp := &graphql.Response{Data: &data}
 
-	retval.Id = v.Variant.Id
-	retval.Name = v.Variant.Name
-	retval.IsPublic = v.Variant.IsPublic
-	retval.Url = v.Variant.Url
-	retval.GraphId = v.Variant.GraphId
-	return &retval, nil
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
 }
 
-func createKey(
+func updateVariantPostflightScript(
 	ctx context.Context,
 	client graphql.Client,
 	serviceId string,
-	keyName string,
-	role string,
-) (*createKeyResponse, error) {
+	variantName string,
+	postflightScript *string,
+) (*updateVariantPostflightScriptResponse, error) {
 	req := &graphql.Request{
-		OpName: "createKey",
+		OpName: "updateVariantPostflightScript",
 		Query: `
-mutation createKey ($serviceId: ID!, $keyName: String!, $role: UserPermission!) {
+mutation updateVariantPostflightScript ($serviceId: ID!, $variantName: String!, $postflightScript: String) {
 	service(id: $serviceId) {
-		newKey(keyName: $keyName, role: $role) {
-			... Key
+		variant(name: $variantName) {
+			updatePostflightScript(postflightScript: $postflightScript) {
+				... Variant
+			}
 		}
 	}
 }
-fragment Key on GraphApiKey {
+fragment Variant on GraphVariant {
 	id
-	keyName
-	role
-	token
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
+	}
+	links {
+		id
+		title
+		type
+		url
+	}
+	graphId
 }
 `,
-		Variables: &__createKeyInput{
-			ServiceId: serviceId,
-			KeyName:   keyName,
-			Role:      role,
+		Variables: &__updateVariantPostflightScriptInput{
+			ServiceId:        serviceId,
+			VariantName:      variantName,
+			PostflightScript: postflightScript,
 		},
 	}
 	var err error
 
-	var data createKeyResponse
+	var data updateVariantPostflightScriptResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1520,42 +18147,58 @@ fragment Key on GraphApiKey {
 	return &data, err
 }
 
-func createService(
+func updateVariantPreflightScript(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-	title string,
-	onboardingArchitecture string,
-	accountId string,
-	description string,
-) (*createServiceResponse, error) {
+	serviceId string,
+	variantName string,
+	preflightScript *string,
+) (*updateVariantPreflightScriptResponse, error) {
 	req := &graphql.Request{
-		OpName: "createService",
+		OpName: "updateVariantPreflightScript",
 		Query: `
-mutation createService ($id: ID!, $title: String!, $onboardingArchitecture: OnboardingArchitecture!, $accountId: ID!, $description: String) {
-	newService(id: $id, title: $title, onboardingArchitecture: $onboardingArchitecture, accountId: $accountId, description: $description) {
-		... Service
+mutation updateVariantPreflightScript ($serviceId: ID!, $variantName: String!, $preflightScript: String) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			updatePreflightScript(preflightScript: $preflightScript) {
+				... Variant
+			}
+		}
 	}
 }
-fragment Service on Service {
+fragment Variant on GraphVariant {
 	id
-	title
-	onboardingArchitecture
-	accountId
-	description
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
+	}
+	links {
+		id
+		title
+		type
+		url
+	}
+	graphId
 }
 `,
-		Variables: &__createServiceInput{
-			Id:                     id,
-			Title:                  title,
-			OnboardingArchitecture: onboardingArchitecture,
-			AccountId:              accountId,
-			Description:            description,
+		Variables: &__updateVariantPreflightScriptInput{
+			ServiceId:       serviceId,
+			VariantName:     variantName,
+			PreflightScript: preflightScript,
 		},
 	}
 	var err error
 
-	var data createServiceResponse
+	var data updateVariantPreflightScriptResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1567,31 +18210,58 @@ fragment Service on Service {
 	return &data, err
 }
 
-func createVariant(
+func updateVariantReadme(
 	ctx context.Context,
 	client graphql.Client,
 	serviceId string,
 	variantName string,
-) (*createVariantResponse, error) {
+	readme string,
+) (*updateVariantReadmeResponse, error) {
 	req := &graphql.Request{
-		OpName: "createVariant",
+		OpName: "updateVariantReadme",
 		Query: `
-mutation createVariant ($serviceId: ID!, $variantName: String!) {
+mutation updateVariantReadme ($serviceId: ID!, $variantName: String!, $readme: String!) {
 	service(id: $serviceId) {
-		uploadSchema(tag: $variantName, schemaDocument: "scalar JSON") {
-			success
+		variant(name: $variantName) {
+			updateVariantReadme(readme: $readme) {
+				... Variant
+			}
 		}
 	}
 }
+fragment Variant on GraphVariant {
+	id
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
+	}
+	links {
+		id
+		title
+		type
+		url
+	}
+	graphId
+}
 `,
-		Variables: &__createVariantInput{
+		Variables: &__updateVariantReadmeInput{
 			ServiceId:   serviceId,
 			VariantName: variantName,
+			Readme:      readme,
 		},
 	}
 	var err error
 
-	var data createVariantResponse
+	var data updateVariantReadmeResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1603,29 +18273,58 @@ mutation createVariant ($serviceId: ID!, $variantName: String!) {
 	return &data, err
 }
 
-func deleteKey(
+func updateVariantSendCookies(
 	ctx context.Context,
 	client graphql.Client,
 	serviceId string,
-	keyId string,
-) (*deleteKeyResponse, error) {
+	variantName string,
+	sendCookies bool,
+) (*updateVariantSendCookiesResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteKey",
+		OpName: "updateVariantSendCookies",
 		Query: `
-mutation deleteKey ($serviceId: ID!, $keyId: ID!) {
+mutation updateVariantSendCookies ($serviceId: ID!, $variantName: String!, $sendCookies: Boolean!) {
 	service(id: $serviceId) {
-		removeKey(id: $keyId)
+		variant(name: $variantName) {
+			updateSendCookies(sendCookies: $sendCookies) {
+				... Variant
+			}
+		}
+	}
+}
+fragment Variant on GraphVariant {
+	id
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
+	}
+	links {
+		id
+		title
+		type
+		url
 	}
+	graphId
 }
 `,
-		Variables: &__deleteKeyInput{
-			ServiceId: serviceId,
-			KeyId:     keyId,
+		Variables: &__updateVariantSendCookiesInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+			SendCookies: sendCookies,
 		},
 	}
 	var err error
 
-	var data deleteKeyResponse
+	var data updateVariantSendCookiesResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1637,27 +18336,58 @@ mutation deleteKey ($serviceId: ID!, $keyId: ID!) {
 	return &data, err
 }
 
-func deleteService(
+func updateVariantSharedHeaders(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*deleteServiceResponse, error) {
+	serviceId string,
+	variantName string,
+	sharedHeaders *string,
+) (*updateVariantSharedHeadersResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteService",
+		OpName: "updateVariantSharedHeaders",
 		Query: `
-mutation deleteService ($id: ID!) {
-	service(id: $id) {
-		delete
+mutation updateVariantSharedHeaders ($serviceId: ID!, $variantName: String!, $sharedHeaders: String) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			updateSharedHeaders(sharedHeaders: $sharedHeaders) {
+				... Variant
+			}
+		}
+	}
+}
+fragment Variant on GraphVariant {
+	id
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
 	}
+	links {
+		id
+		title
+		type
+		url
+	}
+	graphId
 }
 `,
-		Variables: &__deleteServiceInput{
-			Id: id,
+		Variables: &__updateVariantSharedHeadersInput{
+			ServiceId:     serviceId,
+			VariantName:   variantName,
+			SharedHeaders: sharedHeaders,
 		},
 	}
 	var err error
 
-	var data deleteServiceResponse
+	var data updateVariantSharedHeadersResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1669,33 +18399,58 @@ mutation deleteService ($id: ID!) {
 	return &data, err
 }
 
-func deleteVariant(
+func updateVariantSubscriptionURL(
 	ctx context.Context,
 	client graphql.Client,
 	serviceId string,
 	variantName string,
-) (*deleteVariantResponse, error) {
+	subscriptionUrl *string,
+) (*updateVariantSubscriptionURLResponse, error) {
 	req := &graphql.Request{
-		OpName: "deleteVariant",
+		OpName: "updateVariantSubscriptionURL",
 		Query: `
-mutation deleteVariant ($serviceId: ID!, $variantName: String!) {
+mutation updateVariantSubscriptionURL ($serviceId: ID!, $variantName: String!, $subscriptionUrl: String) {
 	service(id: $serviceId) {
 		variant(name: $variantName) {
-			delete {
-				deleted
+			updateSubscriptionURL(subscriptionUrl: $subscriptionUrl) {
+				... Variant
 			}
 		}
 	}
 }
+fragment Variant on GraphVariant {
+	id
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
+	}
+	links {
+		id
+		title
+		type
+		url
+	}
+	graphId
+}
 `,
-		Variables: &__deleteVariantInput{
-			ServiceId:   serviceId,
-			VariantName: variantName,
+		Variables: &__updateVariantSubscriptionURLInput{
+			ServiceId:       serviceId,
+			VariantName:     variantName,
+			SubscriptionUrl: subscriptionUrl,
 		},
 	}
 	var err error
 
-	var data deleteVariantResponse
+	var data updateVariantSubscriptionURLResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1707,34 +18462,58 @@ mutation deleteVariant ($serviceId: ID!, $variantName: String!) {
 	return &data, err
 }
 
-func getService(
+func updateVariantURL(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-) (*getServiceResponse, error) {
+	serviceId string,
+	variantName string,
+	url *string,
+) (*updateVariantURLResponse, error) {
 	req := &graphql.Request{
-		OpName: "getService",
+		OpName: "updateVariantURL",
 		Query: `
-query getService ($id: ID!) {
-	service(id: $id) {
-		... Service
+mutation updateVariantURL ($serviceId: ID!, $variantName: String!, $url: String) {
+	service(id: $serviceId) {
+		variant(name: $variantName) {
+			updateURL(url: $url) {
+				... Variant
+			}
+		}
 	}
 }
-fragment Service on Service {
+fragment Variant on GraphVariant {
 	id
-	title
-	onboardingArchitecture
-	accountId
-	description
+	name
+	isPublic
+	isPubliclyListed
+	isProtected
+	url
+	subscriptionUrl
+	sendCookies
+	preflightScript
+	postflightScript
+	sharedHeaders
+	readme {
+		content
+	}
+	links {
+		id
+		title
+		type
+		url
+	}
+	graphId
 }
 `,
-		Variables: &__getServiceInput{
-			Id: id,
+		Variables: &__updateVariantURLInput{
+			ServiceId:   serviceId,
+			VariantName: variantName,
+			Url:         url,
 		},
 	}
 	var err error
 
-	var data getServiceResponse
+	var data updateVariantURLResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1746,38 +18525,73 @@ fragment Service on Service {
 	return &data, err
 }
 
-func getVariant(
+func upsertContractVariant(
 	ctx context.Context,
 	client graphql.Client,
 	serviceId string,
 	variantName string,
-) (*getVariantResponse, error) {
+	sourceVariant string,
+	include []string,
+	exclude []string,
+	hideUnreachableTypes bool,
+) (*upsertContractVariantResponse, error) {
 	req := &graphql.Request{
-		OpName: "getVariant",
+		OpName: "upsertContractVariant",
 		Query: `
-query getVariant ($serviceId: ID!, $variantName: String!) {
+mutation upsertContractVariant ($serviceId: ID!, $variantName: String!, $sourceVariant: String!, $include: [String!]!, $exclude: [String!]!, $hideUnreachableTypes: Boolean!) {
 	service(id: $serviceId) {
-		variant(name: $variantName) {
-			... Variant
+		upsertContractVariant(contractVariantName: $variantName, sourceVariant: $sourceVariant, filterConfig: {include:$include,exclude:$exclude,hideUnreachableTypes:$hideUnreachableTypes}) {
+			__typename
+			... on ContractVariantUpsertSuccess {
+				contractVariant {
+					... ContractVariant
+				}
+			}
+			... on ContractVariantUpsertErrors {
+				errorMessages
+			}
 		}
 	}
 }
-fragment Variant on GraphVariant {
+fragment ContractVariant on GraphVariant {
 	id
 	name
-	isPublic
-	url
 	graphId
+	sourceVariant {
+		name
+	}
+	contractFilterConfig {
+		include
+		exclude
+		hideUnreachableTypes
+	}
+	latestLaunch {
+		status
+		build {
+			result {
+				__typename
+				... on BuildFailure {
+					errorMessages {
+						message
+					}
+				}
+			}
+		}
+	}
 }
 `,
-		Variables: &__getVariantInput{
-			ServiceId:   serviceId,
-			VariantName: variantName,
+		Variables: &__upsertContractVariantInput{
+			ServiceId:            serviceId,
+			VariantName:          variantName,
+			SourceVariant:        sourceVariant,
+			Include:              include,
+			Exclude:              exclude,
+			HideUnreachableTypes: hideUnreachableTypes,
 		},
 	}
 	var err error
 
-	var data getVariantResponse
+	var data upsertContractVariantResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1789,35 +18603,38 @@ fragment Variant on GraphVariant {
 	return &data, err
 }
 
-func listKeys(
+func upsertPagerDutyChannel(
 	ctx context.Context,
 	client graphql.Client,
 	serviceId string,
-) (*listKeysResponse, error) {
+	id *string,
+	channel PagerDutyChannelInput,
+) (*upsertPagerDutyChannelResponse, error) {
 	req := &graphql.Request{
-		OpName: "listKeys",
+		OpName: "upsertPagerDutyChannel",
 		Query: `
-query listKeys ($serviceId: ID!) {
+mutation upsertPagerDutyChannel ($serviceId: ID!, $id: ID, $channel: PagerDutyChannelInput!) {
 	service(id: $serviceId) {
-		apiKeys {
-			... Key
+		upsertPagerDutyChannel(id: $id, channel: $channel) {
+			... PagerDutyChannel
 		}
 	}
 }
-fragment Key on GraphApiKey {
+fragment PagerDutyChannel on PagerDutyChannel {
 	id
-	keyName
-	role
-	token
+	name
+	routingKey
 }
 `,
-		Variables: &__listKeysInput{
+		Variables: &__upsertPagerDutyChannelInput{
 			ServiceId: serviceId,
+			Id:        id,
+			Channel:   channel,
 		},
 	}
 	var err error
 
-	var data listKeysResponse
+	var data upsertPagerDutyChannelResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1829,39 +18646,50 @@ fragment Key on GraphApiKey {
 	return &data, err
 }
 
-func updateKey(
+func upsertQueryTrigger(
 	ctx context.Context,
 	client graphql.Client,
 	serviceId string,
-	keyId string,
-	keyName string,
-) (*updateKeyResponse, error) {
+	id *string,
+	trigger QueryTriggerInput,
+) (*upsertQueryTriggerResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateKey",
+		OpName: "upsertQueryTrigger",
 		Query: `
-mutation updateKey ($serviceId: ID!, $keyId: ID!, $keyName: String) {
+mutation upsertQueryTrigger ($serviceId: ID!, $id: ID, $trigger: QueryTriggerInput!) {
 	service(id: $serviceId) {
-		renameKey(id: $keyId, newKeyName: $keyName) {
-			... Key
+		upsertQueryTrigger(id: $id, trigger: $trigger) {
+			... QueryTrigger
 		}
 	}
 }
-fragment Key on GraphApiKey {
+fragment QueryTrigger on QueryTrigger {
 	id
-	keyName
-	role
-	token
+	variant
+	metric
+	comparisonOperator
+	threshold
+	percentile
+	window
+	scope
+	operationNames
+	excludedOperationNames
+	enabled
+	channels {
+		__typename
+		id
+	}
 }
 `,
-		Variables: &__updateKeyInput{
+		Variables: &__upsertQueryTriggerInput{
 			ServiceId: serviceId,
-			KeyId:     keyId,
-			KeyName:   keyName,
+			Id:        id,
+			Trigger:   trigger,
 		},
 	}
 	var err error
 
-	var data updateKeyResponse
+	var data upsertQueryTriggerResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1873,38 +18701,49 @@ fragment Key on GraphApiKey {
 	return &data, err
 }
 
-func updateServiceDescription(
+func upsertRegistrySubscription(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-	description string,
-) (*updateServiceDescriptionResponse, error) {
+	serviceId string,
+	id *string,
+	channelId string,
+	variant string,
+	options SubscriptionOptionsInput,
+) (*upsertRegistrySubscriptionResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateServiceDescription",
+		OpName: "upsertRegistrySubscription",
 		Query: `
-mutation updateServiceDescription ($id: ID!, $description: String!) {
-	service(id: $id) {
-		updateDescription(description: $description) {
-			... Service
+mutation upsertRegistrySubscription ($serviceId: ID!, $id: ID, $channelId: ID!, $variant: String!, $options: SubscriptionOptionsInput!) {
+	service(id: $serviceId) {
+		upsertRegistrySubscription(id: $id, channelID: $channelId, variant: $variant, options: $options) {
+			... RegistrySubscription
 		}
 	}
 }
-fragment Service on Service {
+fragment RegistrySubscription on RegistrySubscription {
 	id
-	title
-	onboardingArchitecture
-	accountId
-	description
+	variant
+	enabled
+	channel {
+		__typename
+		id
+	}
+	options {
+		schemaUpdates
+	}
 }
 `,
-		Variables: &__updateServiceDescriptionInput{
-			Id:          id,
-			Description: description,
+		Variables: &__upsertRegistrySubscriptionInput{
+			ServiceId: serviceId,
+			Id:        id,
+			ChannelId: channelId,
+			Variant:   variant,
+			Options:   options,
 		},
 	}
 	var err error
 
-	var data updateServiceDescriptionResponse
+	var data upsertRegistrySubscriptionResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1916,38 +18755,49 @@ fragment Service on Service {
 	return &data, err
 }
 
-func updateServiceTitle(
+func upsertScheduledSummary(
 	ctx context.Context,
 	client graphql.Client,
-	id string,
-	title string,
-) (*updateServiceTitleResponse, error) {
+	serviceId string,
+	id *string,
+	channelId string,
+	variant string,
+	timezone string,
+	enabled bool,
+) (*upsertScheduledSummaryResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateServiceTitle",
+		OpName: "upsertScheduledSummary",
 		Query: `
-mutation updateServiceTitle ($id: ID!, $title: String!) {
-	service(id: $id) {
-		updateTitle(title: $title) {
-			... Service
+mutation upsertScheduledSummary ($serviceId: ID!, $id: ID, $channelId: ID!, $variant: String!, $timezone: String!, $enabled: Boolean!) {
+	service(id: $serviceId) {
+		upsertScheduledSummary(id: $id, channelID: $channelId, variant: $variant, timezone: $timezone, enabled: $enabled) {
+			... ScheduledSummary
 		}
 	}
 }
-fragment Service on Service {
+fragment ScheduledSummary on ScheduledSummary {
 	id
-	title
-	onboardingArchitecture
-	accountId
-	description
+	variant
+	timezone
+	enabled
+	channels {
+		__typename
+		id
+	}
 }
 `,
-		Variables: &__updateServiceTitleInput{
-			Id:    id,
-			Title: title,
+		Variables: &__upsertScheduledSummaryInput{
+			ServiceId: serviceId,
+			Id:        id,
+			ChannelId: channelId,
+			Variant:   variant,
+			Timezone:  timezone,
+			Enabled:   enabled,
 		},
 	}
 	var err error
 
-	var data updateServiceTitleResponse
+	var data upsertScheduledSummaryResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -1959,42 +18809,38 @@ fragment Service on Service {
 	return &data, err
 }
 
-func updateVariantIsPublic(
+func upsertSlackChannel(
 	ctx context.Context,
 	client graphql.Client,
 	serviceId string,
-	variantName string,
-	isPublic bool,
-) (*updateVariantIsPublicResponse, error) {
+	id *string,
+	channel SlackChannelInput,
+) (*upsertSlackChannelResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateVariantIsPublic",
+		OpName: "upsertSlackChannel",
 		Query: `
-mutation updateVariantIsPublic ($serviceId: ID!, $variantName: String!, $isPublic: Boolean!) {
+mutation upsertSlackChannel ($serviceId: ID!, $id: ID, $channel: SlackChannelInput!) {
 	service(id: $serviceId) {
-		variant(name: $variantName) {
-			updateVariantIsPublic(isPublic: $isPublic) {
-				... Variant
-			}
+		upsertSlackChannel(id: $id, channel: $channel) {
+			... SlackChannel
 		}
 	}
 }
-fragment Variant on GraphVariant {
+fragment SlackChannel on SlackChannel {
 	id
 	name
-	isPublic
 	url
-	graphId
 }
 `,
-		Variables: &__updateVariantIsPublicInput{
-			ServiceId:   serviceId,
-			VariantName: variantName,
-			IsPublic:    isPublic,
+		Variables: &__upsertSlackChannelInput{
+			ServiceId: serviceId,
+			Id:        id,
+			Channel:   channel,
 		},
 	}
 	var err error
 
-	var data updateVariantIsPublicResponse
+	var data upsertSlackChannelResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -2006,42 +18852,43 @@ fragment Variant on GraphVariant {
 	return &data, err
 }
 
-func updateVariantURL(
+func upsertWebhookChannel(
 	ctx context.Context,
 	client graphql.Client,
 	serviceId string,
-	variantName string,
-	url *string,
-) (*updateVariantURLResponse, error) {
+	id *string,
+	name string,
+	secretToken *string,
+	url string,
+) (*upsertWebhookChannelResponse, error) {
 	req := &graphql.Request{
-		OpName: "updateVariantURL",
+		OpName: "upsertWebhookChannel",
 		Query: `
-mutation updateVariantURL ($serviceId: ID!, $variantName: String!, $url: String) {
+mutation upsertWebhookChannel ($serviceId: ID!, $id: ID, $name: String!, $secretToken: String, $url: String!) {
 	service(id: $serviceId) {
-		variant(name: $variantName) {
-			updateURL(url: $url) {
-				... Variant
-			}
+		upsertWebhookChannel(id: $id, name: $name, secretToken: $secretToken, url: $url) {
+			... WebhookChannel
 		}
 	}
 }
-fragment Variant on GraphVariant {
+fragment WebhookChannel on WebhookChannel {
 	id
 	name
-	isPublic
 	url
-	graphId
+	secretToken
 }
 `,
-		Variables: &__updateVariantURLInput{
+		Variables: &__upsertWebhookChannelInput{
 			ServiceId:   serviceId,
-			VariantName: variantName,
+			Id:          id,
+			Name:        name,
+			SecretToken: secretToken,
 			Url:         url,
 		},
 	}
 	var err error
 
-	var data updateVariantURLResponse
+	var data upsertWebhookChannelResponse
 	resp := &graphql.Response{Data: &data}
 
 	err = client.MakeRequest(
@@ -2,14 +2,21 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/Khan/genqlient/graphql"
 )
@@ -17,6 +24,16 @@ import (
 var (
 	envVarName          = "APOLLO_GRAPHQL_TOKEN"
 	errMissingAuthToken = "Required token could not be found. Please set the token using an input variable in the provider configuration block or by using the `" + envVarName + "` environment variable."
+
+	endpointEnvVarName = "APOLLO_GRAPHQL_ENDPOINT"
+	defaultEndpoint    = "https://graphql.api.apollographql.com/api/graphql"
+
+	defaultMaxRetries   int64 = 3
+	defaultRetryWaitMin int64 = 1
+	defaultRetryWaitMax int64 = 30
+
+	defaultRequestsPerSecond     int64 = 10
+	defaultMaxConcurrentRequests int64 = 10
 )
 
 var _ provider.Provider = &ApolloGraphQLProvider{}
@@ -29,7 +46,14 @@ type ApolloGraphQLProvider struct {
 }
 
 type ApolloGraphQLProviderModel struct {
-	Token types.String `tfsdk:"token"`
+	Token        types.String `tfsdk:"token"`
+	Endpoint     types.String `tfsdk:"endpoint"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax types.Int64  `tfsdk:"retry_wait_max"`
+
+	RequestsPerSecond     types.Int64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64 `tfsdk:"max_concurrent_requests"`
 }
 
 func (p *ApolloGraphQLProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -44,6 +68,45 @@ func (p *ApolloGraphQLProvider) Schema(ctx context.Context, req provider.SchemaR
 				MarkdownDescription: "The token used to authenticate with Apollo GraphQL.",
 				Optional:            true,
 			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "The URL of the Apollo GraphQL Platform API. Defaults to `" + defaultEndpoint + "`.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of times a request is retried after a transient failure. Defaults to `%d`.", defaultMaxRetries),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Minimum time in seconds to wait before retrying a request. Defaults to `%d`.", defaultRetryWaitMin),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum time in seconds to wait before retrying a request. Defaults to `%d`.", defaultRetryWaitMax),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"requests_per_second": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of requests per second sent to the API across all resources. Defaults to `%d`.", defaultRequestsPerSecond),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of requests in flight to the API at the same time. Defaults to `%d`.", defaultMaxConcurrentRequests),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -75,14 +138,94 @@ func (p *ApolloGraphQLProvider) Configure(ctx context.Context, req provider.Conf
 		return
 	}
 
+	endpoint := ""
+
+	if !data.Endpoint.IsNull() {
+		endpoint = data.Endpoint.ValueString()
+	}
+
+	// If an endpoint wasn't set in the provider configuration block, try and fetch
+	// it from the environment variable before falling back to the default.
+	if endpoint == "" {
+		endpoint = os.Getenv(endpointEnvVarName)
+	}
+
+	if endpoint == "" {
+		endpoint = defaultEndpoint
+	}
+
+	if err := validateEndpoint(endpoint); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("endpoint"),
+			"Invalid API endpoint",
+			fmt.Sprintf("The endpoint %q is not valid: %s. Please set an absolute URL using the `endpoint` argument in the provider configuration block or the `%s` environment variable.", endpoint, err, endpointEnvVarName),
+		)
+		return
+	}
+
+	maxRetries := defaultMaxRetries
+	retryWaitMin := defaultRetryWaitMin
+	retryWaitMax := defaultRetryWaitMax
+
+	if !data.MaxRetries.IsNull() {
+		maxRetries = data.MaxRetries.ValueInt64()
+	}
+
+	if !data.RetryWaitMin.IsNull() {
+		retryWaitMin = data.RetryWaitMin.ValueInt64()
+	}
+
+	if !data.RetryWaitMax.IsNull() {
+		retryWaitMax = data.RetryWaitMax.ValueInt64()
+	}
+
+	if retryWaitMin > retryWaitMax {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid retry configuration",
+			fmt.Sprintf("retry_wait_min (%d) must not be greater than retry_wait_max (%d).", retryWaitMin, retryWaitMax),
+		)
+		return
+	}
+
+	requestsPerSecond := defaultRequestsPerSecond
+	maxConcurrentRequests := defaultMaxConcurrentRequests
+
+	if !data.RequestsPerSecond.IsNull() {
+		requestsPerSecond = data.RequestsPerSecond.ValueInt64()
+	}
+
+	if !data.MaxConcurrentRequests.IsNull() {
+		maxConcurrentRequests = data.MaxConcurrentRequests.ValueInt64()
+	}
+
+	tflog.Debug(ctx, "configuring Apollo GraphQL client", map[string]interface{}{
+		"endpoint":                endpoint,
+		"max_retries":             maxRetries,
+		"retry_wait_min":          retryWaitMin,
+		"retry_wait_max":          retryWaitMax,
+		"requests_per_second":     requestsPerSecond,
+		"max_concurrent_requests": maxConcurrentRequests,
+	})
+
 	httpClient := http.Client{
-		Transport: &authedTransport{
-			token:   token,
-			wrapped: http.DefaultTransport,
+		Transport: &metaTransport{
+			wrapped: &retryTransport{
+				maxRetries: int(maxRetries),
+				waitMin:    time.Duration(retryWaitMin) * time.Second,
+				waitMax:    time.Duration(retryWaitMax) * time.Second,
+				// Every attempt, including retries, goes through the limiter.
+				wrapped: newLimitTransport(int(requestsPerSecond), int(maxConcurrentRequests), &authedTransport{
+					token:   token,
+					wrapped: http.DefaultTransport,
+				}),
+			},
 		},
 	}
 
-	client := graphql.NewClient("https://graphql.api.apollographql.com/api/graphql", &httpClient)
+	client := graphql.Client(&apiClient{
+		wrapped: graphql.NewClient(endpoint, &httpClient),
+	})
 
 	resp.DataSourceData = &client
 	resp.ResourceData = &client
@@ -93,6 +236,24 @@ func (p *ApolloGraphQLProvider) Resources(ctx context.Context) []func() resource
 		NewGraphResource,
 		NewVariantResource,
 		NewKeyResource,
+		NewSubgraphResource,
+		NewContractVariantResource,
+		NewPersistedQueryListResource,
+		NewPersistedQueryListLinkResource,
+		NewPersistedQueryListOperationsResource,
+		NewWebhookChannelResource,
+		NewSlackChannelResource,
+		NewPagerDutyChannelResource,
+		NewRegistrySubscriptionResource,
+		NewQueryTriggerResource,
+		NewScheduledSummaryResource,
+		NewLinterConfigurationResource,
+		NewCheckConfigurationResource,
+		NewVariantCheckConfigurationResource,
+		NewOrganizationInvitationResource,
+		NewOrganizationMemberResource,
+		NewOrganizationInviteLinkResource,
+		NewGraphUserPermissionResource,
 	}
 }
 
@@ -107,3 +268,21 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+func validateEndpoint(endpoint string) error {
+	u, err := url.Parse(endpoint)
+
+	if err != nil {
+		return err
+	}
+
+	if !u.IsAbs() || u.Host == "" {
+		return fmt.Errorf("must be an absolute URL")
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+
+	return nil
+}
@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"fmt"
+	"net/http"
 	"os"
 	"testing"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -21,3 +26,62 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("APOLLO_GRAPHQL_TOKEN must be set for acceptance tests")
 	}
 }
+
+// testAccClient returns a client for making changes behind Terraform's back,
+// e.g. to simulate a resource being deleted in Studio.
+func testAccClient() graphql.Client {
+	httpClient := http.Client{
+		Transport: &authedTransport{
+			token:   os.Getenv("APOLLO_GRAPHQL_TOKEN"),
+			wrapped: http.DefaultTransport,
+		},
+	}
+
+	return graphql.NewClient(defaultEndpoint, &httpClient)
+}
+
+// testAccGraphImportStateId returns the graph_id:id import identifier of a
+// resource which is only unique within its graph.
+func testAccGraphImportStateId(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", name)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.Attributes["graph_id"], rs.Primary.ID), nil
+	}
+}
+
+func TestValidateEndpoint(t *testing.T) {
+	cases := []struct {
+		name     string
+		endpoint string
+		valid    bool
+	}{
+		{"default", defaultEndpoint, true},
+		{"http", "http://localhost:4000/api/graphql", true},
+		{"trailing slash", "https://graphql.api.apollographql.com/api/graphql/", true},
+		{"without path", "https://proxy.example.com", true},
+		{"relative", "/api/graphql", false},
+		{"without scheme", "graphql.api.apollographql.com/api/graphql", false},
+		{"without host", "https:///api/graphql", false},
+		{"unsupported scheme", "ftp://graphql.api.apollographql.com/api/graphql", false},
+		{"unparsable", "https://graphql.api.apollographql.com:port/api", false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateEndpoint(c.endpoint)
+
+			if c.valid && err != nil {
+				t.Errorf("expected %q to be valid, got error: %s", c.endpoint, err)
+			}
+
+			if !c.valid && err == nil {
+				t.Errorf("expected %q to be invalid", c.endpoint)
+			}
+		})
+	}
+}
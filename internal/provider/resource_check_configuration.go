@@ -0,0 +1,445 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CheckConfigurationResource{}
+var _ resource.ResourceWithImportState = &CheckConfigurationResource{}
+
+func NewCheckConfigurationResource() resource.Resource {
+	return &CheckConfigurationResource{}
+}
+
+type CheckConfigurationResource struct {
+	client *graphql.Client
+}
+
+type CheckConfigurationResourceModel struct {
+	Id                                types.String  `tfsdk:"id"`
+	GraphId                           types.String  `tfsdk:"graph_id"`
+	TimeRangeSeconds                  types.Int64   `tfsdk:"time_range_seconds"`
+	OperationCountThreshold           types.Int64   `tfsdk:"operation_count_threshold"`
+	OperationCountThresholdPercentage types.Float64 `tfsdk:"operation_count_threshold_percentage"`
+	IncludeBaseVariant                types.Bool    `tfsdk:"include_base_variant"`
+	IncludedVariants                  types.Set     `tfsdk:"included_variants"`
+	ExcludedClients                   types.Set     `tfsdk:"excluded_clients"`
+	ExcludedOperations                types.Set     `tfsdk:"excluded_operations"`
+	DowngradeStaticChecks             types.Bool    `tfsdk:"downgrade_static_checks"`
+	DowngradeDefaultValueChange       types.Bool    `tfsdk:"downgrade_default_value_change"`
+}
+
+// clientFilterAttrTypes are the attribute types of an excluded client.
+var clientFilterAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"version": types.StringType,
+}
+
+func (r *CheckConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_configuration"
+}
+
+func (r *CheckConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL operation check configuration of a graph. Settings which aren't configured keep their current value, and destroying the resource leaves the configuration of the graph as is.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the check configuration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the check configuration belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"time_range_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Only operations executed in the last given seconds are checked.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"operation_count_threshold": schema.Int64Attribute{
+				MarkdownDescription: "Operations executed fewer times than this in the time range are ignored.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"operation_count_threshold_percentage": schema.Float64Attribute{
+				MarkdownDescription: "Operations making up a smaller share of the requests in the time range than this are ignored, between `0` and `0.05`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Float64{
+					float64validator.Between(0, 0.05),
+				},
+			},
+			"include_base_variant": schema.BoolAttribute{
+				MarkdownDescription: "Whether operations of the checked variant are included.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"included_variants": schema.SetAttribute{
+				MarkdownDescription: "Names of the variants whose operations are checked.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"excluded_clients": schema.SetNestedAttribute{
+				MarkdownDescription: "Clients whose operations are ignored.",
+				Optional:            true,
+				Computed:            true,
+				NestedObject:        clientFilterSchema(),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"excluded_operations": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the operations which are ignored.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"downgrade_static_checks": schema.BoolAttribute{
+				MarkdownDescription: "Whether a check run against zero operations passes instead of failing.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"downgrade_default_value_change": schema.BoolAttribute{
+				MarkdownDescription: "Whether changing a default value passes the check, failing only when it is removed.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CheckConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CheckConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *CheckConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := upsertCheckConfiguration(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create check configuration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a check configuration")
+
+	data.Id = data.GraphId
+
+	setCheckConfiguration(data, configuration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CheckConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *CheckConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := readCheckConfiguration(ctx, *r.client, data.GraphId.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "check configuration not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read check configuration, got error: %s", err))
+		return
+	}
+
+	data.Id = data.GraphId
+
+	setCheckConfiguration(data, configuration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CheckConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *CheckConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := upsertCheckConfiguration(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update check configuration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a check configuration")
+
+	setCheckConfiguration(data, configuration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CheckConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The check configuration can't be removed from a graph, so it is only
+	// removed from the state.
+	tflog.Trace(ctx, "deleted a check configuration")
+}
+
+func (r *CheckConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), req.ID)...)
+}
+
+func readCheckConfiguration(ctx context.Context, client graphql.Client, serviceId string) (*CheckConfiguration, error) {
+	response, err := getCheckConfiguration(ctx, client, serviceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil || response.Service.CheckConfiguration == nil {
+		return nil, errNotFound
+	}
+
+	return &response.Service.CheckConfiguration.CheckConfiguration, nil
+}
+
+// upsertCheckConfiguration updates the configured settings of the check
+// configuration. Settings which are unknown are sent as null, which leaves
+// them unchanged.
+func upsertCheckConfiguration(ctx context.Context, client graphql.Client, data *CheckConfigurationResourceModel) (*CheckConfiguration, error) {
+	var includedVariants []string
+	var excludedClients []ClientFilterInput
+	var excludedOperations []ExcludedOperationInput
+
+	if !data.IncludedVariants.IsNull() && !data.IncludedVariants.IsUnknown() {
+		includedVariants = stringsFromSet(data.IncludedVariants)
+	}
+
+	if !data.ExcludedClients.IsNull() && !data.ExcludedClients.IsUnknown() {
+		excludedClients = clientFiltersFromSet(data.ExcludedClients)
+	}
+
+	if !data.ExcludedOperations.IsNull() && !data.ExcludedOperations.IsUnknown() {
+		excludedOperations = []ExcludedOperationInput{}
+
+		for _, id := range stringsFromSet(data.ExcludedOperations) {
+			excludedOperations = append(excludedOperations, ExcludedOperationInput{ID: id})
+		}
+	}
+
+	response, err := updateCheckConfiguration(
+		ctx,
+		client,
+		data.GraphId.ValueString(),
+		int64Pointer(data.TimeRangeSeconds),
+		intPointer(data.OperationCountThreshold),
+		float64Pointer(data.OperationCountThresholdPercentage),
+		boolPointer(data.IncludeBaseVariant),
+		includedVariants,
+		excludedClients,
+		excludedOperations,
+		boolPointer(data.DowngradeStaticChecks),
+		boolPointer(data.DowngradeDefaultValueChange),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Service.UpdateCheckConfiguration.CheckConfiguration, nil
+}
+
+func setCheckConfiguration(data *CheckConfigurationResourceModel, configuration *CheckConfiguration) {
+	data.TimeRangeSeconds = types.Int64Value(configuration.TimeRangeSeconds)
+	data.OperationCountThreshold = types.Int64Value(int64(configuration.OperationCountThreshold))
+	data.OperationCountThresholdPercentage = types.Float64Value(configuration.OperationCountThresholdPercentage)
+	data.IncludeBaseVariant = types.BoolValue(configuration.IncludeBaseVariant)
+	data.IncludedVariants = setFromStrings(configuration.IncludedVariants)
+	data.DowngradeStaticChecks = types.BoolValue(configuration.DowngradeStaticChecks)
+	data.DowngradeDefaultValueChange = types.BoolValue(configuration.DowngradeDefaultValueChange)
+
+	clients := []ClientFilterInput{}
+
+	for _, client := range configuration.ExcludedClients {
+		clients = append(clients, ClientFilterInput{Name: client.Name, Version: client.Version})
+	}
+
+	data.ExcludedClients = setFromClientFilters(clients)
+
+	operations := []string{}
+
+	for _, operation := range configuration.ExcludedOperations {
+		operations = append(operations, operation.ID)
+	}
+
+	data.ExcludedOperations = setFromStrings(operations)
+}
+
+func clientFilterSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the client.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the client. All versions are matched when not set.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func clientFiltersFromSet(set types.Set) []ClientFilterInput {
+	clients := []ClientFilterInput{}
+
+	for _, value := range set.Elements() {
+		attributes := value.(types.Object).Attributes()
+
+		clients = append(clients, ClientFilterInput{
+			Name:    attributes["name"].(types.String).ValueString(),
+			Version: attributes["version"].(types.String).ValueStringPointer(),
+		})
+	}
+
+	return clients
+}
+
+func setFromClientFilters(clients []ClientFilterInput) types.Set {
+	elements := []attr.Value{}
+
+	for _, client := range clients {
+		elements = append(elements, types.ObjectValueMust(clientFilterAttrTypes, map[string]attr.Value{
+			"name":    types.StringValue(client.Name),
+			"version": types.StringPointerValue(client.Version),
+		}))
+	}
+
+	return types.SetValueMust(types.ObjectType{AttrTypes: clientFilterAttrTypes}, elements)
+}
+
+// The following helpers return nil for values which are null or unknown, so
+// that the API leaves the corresponding settings unchanged.
+
+func intPointer(value types.Int64) *int {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	result := int(value.ValueInt64())
+
+	return &result
+}
+
+func int64Pointer(value types.Int64) *int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return value.ValueInt64Pointer()
+}
+
+func float64Pointer(value types.Float64) *float64 {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return value.ValueFloat64Pointer()
+}
+
+func boolPointer(value types.Bool) *bool {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return value.ValueBoolPointer()
+}
@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCheckConfigurationResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckConfigurationResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "graph_id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "time_range_seconds", "86400"),
+					resource.TestCheckResourceAttrSet("apollographql_check_configuration.test", "operation_count_threshold"),
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "excluded_clients.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("apollographql_check_configuration.test", "excluded_clients.*", map[string]string{"name": "ios", "version": "1.0.0"}),
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "downgrade_static_checks", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_check_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccCheckConfigurationResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "time_range_seconds", "604800"),
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "operation_count_threshold", "10"),
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "operation_count_threshold_percentage", "0.01"),
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "included_variants.#", "1"),
+					resource.TestCheckTypeSetElemAttr("apollographql_check_configuration.test", "included_variants.*", "current"),
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "excluded_clients.#", "0"),
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "downgrade_static_checks", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccCheckConfigurationResourceDrift(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccCheckConfigurationResourceConfigDefault(),
+			},
+			// Change outside of terraform and expect it to be reverted
+			{
+				PreConfig: func() {
+					timeRangeSeconds := int64(3600)
+					downgradeStaticChecks := false
+
+					if _, err := updateCheckConfiguration(context.Background(), testAccClient(), "Test-w4a5n4", &timeRangeSeconds, nil, nil, nil, nil, []ClientFilterInput{}, nil, &downgradeStaticChecks, nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccCheckConfigurationResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "time_range_seconds", "86400"),
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "excluded_clients.#", "1"),
+					resource.TestCheckResourceAttr("apollographql_check_configuration.test", "downgrade_static_checks", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckConfigurationResourceConfigDefault() string {
+	return `
+resource "apollographql_check_configuration" "test" {
+  graph_id = "Test-w4a5n4"
+  time_range_seconds = 86400
+  excluded_clients = [
+    {
+      name = "ios"
+      version = "1.0.0"
+    }
+  ]
+  downgrade_static_checks = true
+}
+`
+}
+
+func testAccCheckConfigurationResourceConfigNonDefault() string {
+	return `
+resource "apollographql_check_configuration" "test" {
+  graph_id = "Test-w4a5n4"
+  time_range_seconds = 604800
+  operation_count_threshold = 10
+  operation_count_threshold_percentage = 0.01
+  included_variants = ["current"]
+  excluded_clients = []
+  downgrade_static_checks = false
+}
+`
+}
@@ -0,0 +1,345 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ContractVariantResource{}
+var _ resource.ResourceWithImportState = &ContractVariantResource{}
+
+func NewContractVariantResource() resource.Resource {
+	return &ContractVariantResource{}
+}
+
+type ContractVariantResource struct {
+	client *graphql.Client
+}
+
+type ContractVariantResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	GraphId              types.String `tfsdk:"graph_id"`
+	SourceVariant        types.String `tfsdk:"source_variant"`
+	IncludeTags          types.Set    `tfsdk:"include_tags"`
+	ExcludeTags          types.Set    `tfsdk:"exclude_tags"`
+	HideUnreachableTypes types.Bool   `tfsdk:"hide_unreachable_types"`
+	LaunchStatus         types.String `tfsdk:"launch_status"`
+	FilterErrors         types.List   `tfsdk:"filter_errors"`
+}
+
+func (r *ContractVariantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_contract_variant"
+}
+
+func (r *ContractVariantResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL contract variant, filtered from a source variant by tags.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the contract variant.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the contract variant.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the contract variant belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"source_variant": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant the contract is derived from.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"include_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of schema elements to include in the contract schema.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"exclude_tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of schema elements to exclude from the contract schema.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"hide_unreachable_types": schema.BoolAttribute{
+				MarkdownDescription: "Whether to hide types which are unreachable from the contract schema.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"launch_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the latest launch of the contract variant.",
+				Computed:            true,
+			},
+			"filter_errors": schema.ListAttribute{
+				MarkdownDescription: "Errors from filtering the contract schema in the latest launch.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *ContractVariantResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ContractVariantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ContractVariantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variant, err := upsertContract(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create contract variant, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a contract variant")
+
+	setContractVariant(data, variant)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ContractVariantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ContractVariantResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variant, err := readContractVariant(ctx, *r.client, data.GraphId.ValueString(), data.Name.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "contract variant not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "name": data.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read contract variant, got error: %s", err))
+		return
+	}
+
+	setContractVariant(data, variant)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ContractVariantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ContractVariantResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	variant, err := upsertContract(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update contract variant, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a contract variant")
+
+	setContractVariant(data, variant)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ContractVariantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ContractVariantResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteVariant(ctx, *r.client, data.GraphId.ValueString(), data.Name.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete contract variant, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a contract variant")
+}
+
+func (r *ContractVariantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: graph_id:name. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), parts[0])...)
+}
+
+func readContractVariant(ctx context.Context, client graphql.Client, serviceId string, variantName string) (*ContractVariant, error) {
+	response, err := getContractVariant(ctx, client, serviceId, variantName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil || response.Service.Variant == nil {
+		return nil, errNotFound
+	}
+
+	variant := response.Service.Variant.ContractVariant
+
+	if variant.SourceVariant == nil || variant.ContractFilterConfig == nil {
+		return nil, fmt.Errorf("Variant %s is not a contract variant", variantName)
+	}
+
+	return &variant, nil
+}
+
+func upsertContract(ctx context.Context, client graphql.Client, data *ContractVariantResourceModel) (*ContractVariant, error) {
+	include := []string{}
+	exclude := []string{}
+
+	for _, tag := range data.IncludeTags.Elements() {
+		include = append(include, tag.(types.String).ValueString())
+	}
+
+	for _, tag := range data.ExcludeTags.Elements() {
+		exclude = append(exclude, tag.(types.String).ValueString())
+	}
+
+	sourceVariant := fmt.Sprintf("%s@%s", data.GraphId.ValueString(), data.SourceVariant.ValueString())
+
+	response, err := upsertContractVariant(ctx, client, data.GraphId.ValueString(), data.Name.ValueString(), sourceVariant, include, exclude, data.HideUnreachableTypes.ValueBool())
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch result := response.Service.UpsertContractVariant.(type) {
+	case *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertSuccess:
+		return &result.ContractVariant.ContractVariant, nil
+	case *upsertContractVariantServiceServiceMutationUpsertContractVariantContractVariantUpsertErrors:
+		return nil, fmt.Errorf("%s", strings.Join(result.ErrorMessages, "; "))
+	}
+
+	return nil, fmt.Errorf("Unable to upsert contract variant: %s", data.Name.ValueString())
+}
+
+func setContractVariant(data *ContractVariantResourceModel, variant *ContractVariant) {
+	data.Id = types.StringValue(variant.Id)
+	data.Name = types.StringValue(variant.Name)
+	data.GraphId = types.StringValue(variant.GraphId)
+
+	if variant.SourceVariant != nil {
+		data.SourceVariant = types.StringValue(variant.SourceVariant.Name)
+	}
+
+	if variant.ContractFilterConfig != nil {
+		include := []attr.Value{}
+		exclude := []attr.Value{}
+
+		for _, tag := range variant.ContractFilterConfig.Include {
+			include = append(include, types.StringValue(tag))
+		}
+
+		for _, tag := range variant.ContractFilterConfig.Exclude {
+			exclude = append(exclude, types.StringValue(tag))
+		}
+
+		data.IncludeTags = types.SetValueMust(types.StringType, include)
+		data.ExcludeTags = types.SetValueMust(types.StringType, exclude)
+		data.HideUnreachableTypes = types.BoolValue(variant.ContractFilterConfig.HideUnreachableTypes)
+	}
+
+	filterErrors := []attr.Value{}
+
+	data.LaunchStatus = types.StringNull()
+
+	if launch := variant.LatestLaunch; launch != nil {
+		data.LaunchStatus = types.StringValue(string(launch.Status))
+
+		if launch.Build != nil {
+			if failure, ok := launch.Build.Result.(*ContractVariantLatestLaunchBuildResultBuildFailure); ok {
+				for _, e := range failure.ErrorMessages {
+					filterErrors = append(filterErrors, types.StringValue(e.Message))
+				}
+			}
+		}
+	}
+
+	data.FilterErrors = types.ListValueMust(types.StringType, filterErrors)
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccContractVariantResourceDefault(t *testing.T) {
+	id := fmt.Sprintf("todo-supergraph-%s", uuid.New().String())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccContractVariantResourceConfigDefault(id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_contract_variant.test", "id", fmt.Sprintf("%s@public", id)),
+					resource.TestCheckResourceAttr("apollographql_contract_variant.test", "name", "public"),
+					resource.TestCheckResourceAttr("apollographql_contract_variant.test", "graph_id", id),
+					resource.TestCheckResourceAttr("apollographql_contract_variant.test", "source_variant", "current"),
+					resource.TestCheckResourceAttr("apollographql_contract_variant.test", "include_tags.#", "0"),
+					resource.TestCheckResourceAttr("apollographql_contract_variant.test", "exclude_tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("apollographql_contract_variant.test", "exclude_tags.*", "internal"),
+					resource.TestCheckResourceAttr("apollographql_contract_variant.test", "hide_unreachable_types", "false"),
+					resource.TestCheckResourceAttrSet("apollographql_contract_variant.test", "launch_status"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "apollographql_contract_variant.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s:public", id),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"launch_status", "filter_errors"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccContractVariantResourceConfigNonDefault(id),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_contract_variant.test", "id", fmt.Sprintf("%s@public", id)),
+					resource.TestCheckResourceAttr("apollographql_contract_variant.test", "include_tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("apollographql_contract_variant.test", "include_tags.*", "public"),
+					resource.TestCheckResourceAttr("apollographql_contract_variant.test", "exclude_tags.#", "0"),
+					resource.TestCheckResourceAttr("apollographql_contract_variant.test", "hide_unreachable_types", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccContractVariantResourceConfigBase(id string) string {
+	return fmt.Sprintf(`
+resource "apollographql_graph" "test" {
+  id = "%s"
+  title = "Todo Supergraph"
+  onboarding_architecture = "SUPERGRAPH"
+
+  organization_id = "pksunkara"
+}
+
+resource "apollographql_subgraph" "test" {
+  graph_id = apollographql_graph.test.id
+  variant = "current"
+  name = "todos"
+  url = "https://todos.example.com"
+  revision = "1"
+  schema = <<-EOT
+    extend schema @link(url: "https://specs.apollo.dev/federation/v2.0", import: ["@tag"])
+
+    type Query {
+      todos: [String] @tag(name: "public")
+      drafts: [String] @tag(name: "internal")
+    }
+  EOT
+}
+`, id)
+}
+
+func testAccContractVariantResourceConfigDefault(id string) string {
+	return testAccContractVariantResourceConfigBase(id) + `
+resource "apollographql_contract_variant" "test" {
+  graph_id = apollographql_graph.test.id
+  name = "public"
+  source_variant = apollographql_subgraph.test.variant
+
+  exclude_tags = ["internal"]
+}
+`
+}
+
+func testAccContractVariantResourceConfigNonDefault(id string) string {
+	return testAccContractVariantResourceConfigBase(id) + `
+resource "apollographql_contract_variant" "test" {
+  graph_id = apollographql_graph.test.id
+  name = "public"
+  source_variant = apollographql_subgraph.test.variant
+
+  include_tags = ["public"]
+  hide_unreachable_types = true
+}
+`
+}
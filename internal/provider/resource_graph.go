@@ -2,13 +2,17 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"os"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -19,6 +23,7 @@ import (
 
 var _ resource.Resource = &GraphResource{}
 var _ resource.ResourceWithImportState = &GraphResource{}
+var _ resource.ResourceWithModifyPlan = &GraphResource{}
 
 func NewGraphResource() resource.Resource {
 	return &GraphResource{}
@@ -33,7 +38,12 @@ type GraphResourceModel struct {
 	Title                  types.String `tfsdk:"title"`
 	OnboardingArchitecture types.String `tfsdk:"onboarding_architecture"`
 	OrganizationId         types.String `tfsdk:"organization_id"`
+	AllowTransfer          types.Bool   `tfsdk:"allow_transfer"`
 	Description            types.String `tfsdk:"description"`
+	HiddenFromUninvited    types.Bool   `tfsdk:"hidden_from_uninvited_non_admin_members"`
+	Readme                 types.String `tfsdk:"readme"`
+	ReadmeFile             types.String `tfsdk:"readme_file"`
+	ReadmeHash             types.String `tfsdk:"readme_hash"`
 }
 
 func (r *GraphResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -74,20 +84,61 @@ func (r *GraphResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"organization_id": schema.StringAttribute{
-				MarkdownDescription: "Identifier of the organization the graph belongs to.",
+				MarkdownDescription: "Identifier of the organization the graph belongs to. Changing it replaces the graph unless `allow_transfer` is set.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceUnlessTransfer,
+						"Changing the organization replaces the graph unless allow_transfer is set.",
+						"Changing the organization replaces the graph unless `allow_transfer` is set.",
+					),
 				},
 				Validators: []validator.String{
 					stringvalidator.UTF8LengthAtLeast(1),
 				},
 			},
+			"allow_transfer": schema.BoolAttribute{
+				MarkdownDescription: "Whether changing `organization_id` transfers the graph to the new organization, keeping its history and keys, instead of replacing it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Description of the graph.",
 				Optional:            true,
 				Computed:            true,
 			},
+			"hidden_from_uninvited_non_admin_members": schema.BoolAttribute{
+				MarkdownDescription: "Whether the graph is hidden from members of the organization who aren't admins and haven't been given a role on it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"readme": schema.StringAttribute{
+				MarkdownDescription: "README of the graph, in Markdown. Read from the graph unless `readme_file` is set. Conflicts with `readme_file`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("readme_file")),
+				},
+			},
+			"readme_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a Markdown file with the README of the graph. Only the hash of its content is kept in the state. Conflicts with `readme`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"readme_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the README of the graph.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -112,6 +163,45 @@ func (r *GraphResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
+// ModifyPlan plans the hash of the configured README, so that a changed
+// README file shows up in the plan without its content.
+func (r *GraphResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *GraphResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The content of a README file is never kept in the state.
+	if !data.ReadmeFile.IsNull() {
+		data.Readme = types.StringNull()
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("readme"), data.Readme)...)
+	}
+
+	if data.Readme.IsUnknown() || data.ReadmeFile.IsUnknown() {
+		return
+	}
+
+	content, err := readmeContent(data)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("readme_file"), "Invalid README File", fmt.Sprintf("Unable to read README file, got error: %s", err))
+		return
+	}
+
+	if content != nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("readme_hash"), hashReadme(*content))...)
+	}
+}
+
 func (r *GraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *GraphResourceModel
 
@@ -121,22 +211,40 @@ func (r *GraphResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	response, err := createService(ctx, *r.client, data.Id.ValueString(), data.Title.ValueString(), data.OnboardingArchitecture.ValueString(), data.OrganizationId.ValueString(), data.Description.ValueString())
+	content, err := readmeContent(data)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("readme_file"), "Invalid README File", fmt.Sprintf("Unable to read README file, got error: %s", err))
+		return
+	}
+
+	response, err := createService(ctx, *r.client, data.Id.ValueString(), data.Title.ValueString(), data.OnboardingArchitecture.ValueString(), data.OrganizationId.ValueString(), data.Description.ValueString(), data.HiddenFromUninvited.ValueBool())
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create graph, got error: %s", err))
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create graph, got error: %s", err))
 		return
 	}
 
 	tflog.Trace(ctx, "created a graph")
 
-	service := response.NewService.Service
+	setGraph(data, &response.NewService.Service)
 
-	data.Id = types.StringValue(service.Id)
-	data.Title = types.StringValue(service.Title)
-	data.OnboardingArchitecture = types.StringValue(service.OnboardingArchitecture)
-	data.OrganizationId = types.StringValue(service.AccountId)
-	data.Description = types.StringValue(service.Description)
+	// Save the graph before updating its README, so that it is still tracked
+	// if the update fails.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || content == nil {
+		return
+	}
+
+	service, err := updateGraphReadme(ctx, *r.client, data.Id.ValueString(), *content)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update graph, got error: %s", err))
+		return
+	}
+
+	setGraph(data, service)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -150,20 +258,20 @@ func (r *GraphResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	response, err := getService(ctx, *r.client, data.Id.ValueString())
+	service, err := readService(ctx, *r.client, data.Id.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "graph not found, removing from state", map[string]interface{}{"id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read graph, got error: %s", err))
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read graph, got error: %s", err))
 		return
 	}
 
-	service := response.Service.Service
-
-	data.Id = types.StringValue(service.Id)
-	data.Title = types.StringValue(service.Title)
-	data.OnboardingArchitecture = types.StringValue(service.OnboardingArchitecture)
-	data.OrganizationId = types.StringValue(service.AccountId)
-	data.Description = types.StringValue(service.Description)
+	setGraph(data, service)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -184,11 +292,20 @@ func (r *GraphResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if data.OrganizationId.ValueString() != state.OrganizationId.ValueString() {
+		err := transfer(ctx, *r.client, data)
+
+		if err != nil {
+			resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to transfer graph, got error: %s", err))
+			return
+		}
+	}
+
 	if data.Title.ValueString() != state.Title.ValueString() {
 		err := updateTitle(ctx, *r.client, data)
 
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update graph, got error: %s", err))
+			resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update graph, got error: %s", err))
 			return
 		}
 	}
@@ -197,11 +314,52 @@ func (r *GraphResource) Update(ctx context.Context, req resource.UpdateRequest,
 		err := updateDescription(ctx, *r.client, data)
 
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update graph, got error: %s", err))
+			resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update graph, got error: %s", err))
+			return
+		}
+	}
+
+	if data.HiddenFromUninvited.ValueBool() != state.HiddenFromUninvited.ValueBool() {
+		err := updateHiddenFromUninvited(ctx, *r.client, data)
+
+		if err != nil {
+			resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update graph, got error: %s", err))
 			return
 		}
 	}
 
+	if !data.ReadmeHash.Equal(state.ReadmeHash) || !data.Readme.Equal(state.Readme) {
+		content, err := readmeContent(data)
+
+		if err == nil && content != nil {
+			var service *Service
+
+			service, err = updateGraphReadme(ctx, *r.client, data.Id.ValueString(), *content)
+
+			if err == nil {
+				setReadme(data, service)
+			}
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update graph, got error: %s", err))
+			return
+		}
+	}
+
+	// The README is unknown when readme_file is removed without configuring
+	// readme, so it is read back from the graph.
+	if data.Readme.IsUnknown() {
+		service, err := readService(ctx, *r.client, data.Id.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read graph, got error: %s", err))
+			return
+		}
+
+		setReadme(data, service)
+	}
+
 	tflog.Trace(ctx, "updated a graph")
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -218,8 +376,8 @@ func (r *GraphResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 	_, err := deleteService(ctx, *r.client, data.Id.ValueString())
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete graph, got error: %s", err))
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete graph, got error: %s", err))
 		return
 	}
 
@@ -228,6 +386,25 @@ func (r *GraphResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 func (r *GraphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// allow_transfer only lives in the configuration, so start from its default.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_transfer"), false)...)
+}
+
+func readService(ctx context.Context, client graphql.Client, id string) (*Service, error) {
+	response, err := getService(ctx, client, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil {
+		return nil, errNotFound
+	}
+
+	service := response.Service.Service
+
+	return &service, nil
 }
 
 func updateTitle(ctx context.Context, client graphql.Client, data *GraphResourceModel) error {
@@ -253,3 +430,112 @@ func updateDescription(ctx context.Context, client graphql.Client, data *GraphRe
 
 	return nil
 }
+
+func transfer(ctx context.Context, client graphql.Client, data *GraphResourceModel) error {
+	response, err := transferService(ctx, client, data.Id.ValueString(), data.OrganizationId.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	if response.Service.Transfer == nil {
+		return fmt.Errorf("graph was not transferred to organization %q", data.OrganizationId.ValueString())
+	}
+
+	data.OrganizationId = types.StringValue(response.Service.Transfer.AccountId)
+
+	return nil
+}
+
+// requiresReplaceUnlessTransfer replaces the graph when its organization
+// changes, unless the transfer has been opted into.
+func requiresReplaceUnlessTransfer(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var allowTransfer types.Bool
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allow_transfer"), &allowTransfer)...)
+
+	resp.RequiresReplace = !allowTransfer.ValueBool()
+}
+
+func updateHiddenFromUninvited(ctx context.Context, client graphql.Client, data *GraphResourceModel) error {
+	response, err := updateServiceHiddenFromUninvitedNonAdminAccountMembers(ctx, client, data.Id.ValueString(), data.HiddenFromUninvited.ValueBool())
+
+	if err != nil {
+		return err
+	}
+
+	data.HiddenFromUninvited = types.BoolValue(response.Service.UpdateHiddenFromUninvitedNonAdminAccountMembers.HiddenFromUninvitedNonAdminAccountMembers)
+
+	return nil
+}
+
+func updateGraphReadme(ctx context.Context, client graphql.Client, id string, content string) (*Service, error) {
+	response, err := updateServiceReadme(ctx, client, id, content)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service.UpdateReadme == nil {
+		return nil, fmt.Errorf("Unable to find graph with id: %s: %w", id, errNotFound)
+	}
+
+	return &response.Service.UpdateReadme.Service, nil
+}
+
+func setGraph(data *GraphResourceModel, service *Service) {
+	data.Id = types.StringValue(service.Id)
+	data.Title = types.StringValue(service.Title)
+	data.OnboardingArchitecture = types.StringValue(service.OnboardingArchitecture)
+	data.OrganizationId = types.StringValue(service.AccountId)
+	data.Description = types.StringValue(service.Description)
+	data.HiddenFromUninvited = types.BoolValue(service.HiddenFromUninvitedNonAdminAccountMembers)
+
+	setReadme(data, service)
+}
+
+// readmeContent returns the configured README, read from the file if one is
+// given, or nil when the README isn't managed.
+func readmeContent(data *GraphResourceModel) (*string, error) {
+	if !data.ReadmeFile.IsNull() {
+		content, err := os.ReadFile(data.ReadmeFile.ValueString())
+
+		if err != nil {
+			return nil, err
+		}
+
+		readme := string(content)
+
+		return &readme, nil
+	}
+
+	if !data.Readme.IsNull() && !data.Readme.IsUnknown() {
+		return data.Readme.ValueStringPointer(), nil
+	}
+
+	return nil, nil
+}
+
+// setReadme sets the hash of the README of the graph, and its content unless
+// it is read from a file.
+func setReadme(data *GraphResourceModel, service *Service) {
+	content := ""
+
+	if service.Readme != nil {
+		content = service.Readme.Content
+	}
+
+	data.ReadmeHash = types.StringValue(hashReadme(content))
+
+	if data.ReadmeFile.IsNull() {
+		data.Readme = types.StringValue(content)
+	} else {
+		data.Readme = types.StringNull()
+	}
+}
+
+func hashReadme(content string) string {
+	hash := sha256.Sum256([]byte(content))
+
+	return hex.EncodeToString(hash[:])
+}
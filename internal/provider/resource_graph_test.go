@@ -1,11 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 var id = fmt.Sprintf("todo-api-%s", uuid.New().String())
@@ -24,6 +28,10 @@ func TestAccGraphResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("apollographql_graph.test", "onboarding_architecture", "MONOLITH"),
 					resource.TestCheckResourceAttr("apollographql_graph.test", "organization_id", "pksunkara"),
 					resource.TestCheckResourceAttr("apollographql_graph.test", "description", ""),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "allow_transfer", "false"),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "hidden_from_uninvited_non_admin_members", "false"),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "readme", ""),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "readme_hash", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"),
 				),
 			},
 			// ImportState testing
@@ -41,6 +49,9 @@ func TestAccGraphResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("apollographql_graph.test", "onboarding_architecture", "MONOLITH"),
 					resource.TestCheckResourceAttr("apollographql_graph.test", "organization_id", "pksunkara"),
 					resource.TestCheckResourceAttr("apollographql_graph.test", "description", ""),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "hidden_from_uninvited_non_admin_members", "false"),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "readme", ""),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "readme_hash", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"),
 				),
 			},
 			// Update and Read testing
@@ -52,6 +63,9 @@ func TestAccGraphResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("apollographql_graph.test", "onboarding_architecture", "MONOLITH"),
 					resource.TestCheckResourceAttr("apollographql_graph.test", "organization_id", "pksunkara"),
 					resource.TestCheckResourceAttr("apollographql_graph.test", "description", "API for our todo app"),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "hidden_from_uninvited_non_admin_members", "true"),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "readme", "# Todo API"),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "readme_hash", hashReadme("# Todo API")),
 				),
 			},
 			// ImportState testing
@@ -65,6 +79,116 @@ func TestAccGraphResourceDefault(t *testing.T) {
 	})
 }
 
+func TestAccGraphResourceReadmeFile(t *testing.T) {
+	id := fmt.Sprintf("todo-api-%s", uuid.New().String())
+	file := filepath.Join(t.TempDir(), "README.md")
+
+	write := func(content string) {
+		if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() { write("# Todo API") },
+				Config:    testAccGraphResourceConfigReadmeFile(id, file),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_graph.test", "id", id),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "readme_file", file),
+					resource.TestCheckNoResourceAttr("apollographql_graph.test", "readme"),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "readme_hash", hashReadme("# Todo API")),
+				),
+			},
+			// Update and Read testing
+			{
+				PreConfig: func() { write("# Todo API\n\nAPI for our todo app") },
+				Config:    testAccGraphResourceConfigReadmeFile(id, file),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_graph.test", "id", id),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "readme_hash", hashReadme("# Todo API\n\nAPI for our todo app")),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGraphResourceTransfer(t *testing.T) {
+	id := fmt.Sprintf("todo-api-%s", uuid.New().String())
+	organization := os.Getenv("APOLLO_GRAPHQL_TRANSFER_ORGANIZATION")
+
+	if organization == "" {
+		t.Skip("APOLLO_GRAPHQL_TRANSFER_ORGANIZATION must be set for transfer acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphResourceConfigTransfer(id, "pksunkara"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_graph.test", "id", id),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "organization_id", "pksunkara"),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "allow_transfer", "true"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccGraphResourceConfigTransfer(id, organization),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("apollographql_graph.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_graph.test", "id", id),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "organization_id", organization),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGraphResourceDisappears(t *testing.T) {
+	id := fmt.Sprintf("todo-api-%s", uuid.New().String())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphResourceConfigDefault(id, "Todo API"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_graph.test", "id", id),
+				),
+			},
+			// Delete outside of terraform and expect it to be recreated
+			{
+				PreConfig: func() {
+					if _, err := deleteService(context.Background(), testAccClient(), id); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccGraphResourceConfigDefault(id, "Todo API"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_graph.test", "id", id),
+					resource.TestCheckResourceAttr("apollographql_graph.test", "title", "Todo API"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccGraphResourceConfigDefault(id string, title string) string {
 	return fmt.Sprintf(`
 resource "apollographql_graph" "test" {
@@ -84,6 +208,33 @@ resource "apollographql_graph" "test" {
 
   organization_id = "pksunkara"
   description = "API for our todo app"
+
+  hidden_from_uninvited_non_admin_members = true
+  readme = "# Todo API"
 }
 `, id, title)
 }
+
+func testAccGraphResourceConfigReadmeFile(id string, file string) string {
+	return fmt.Sprintf(`
+resource "apollographql_graph" "test" {
+  id = "%s"
+  title = "Todo API"
+
+  organization_id = "pksunkara"
+  readme_file = "%s"
+}
+`, id, file)
+}
+
+func testAccGraphResourceConfigTransfer(id string, organization string) string {
+	return fmt.Sprintf(`
+resource "apollographql_graph" "test" {
+  id = "%s"
+  title = "Todo API"
+
+  organization_id = "%s"
+  allow_transfer = true
+}
+`, id, organization)
+}
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &GraphUserPermissionResource{}
+var _ resource.ResourceWithImportState = &GraphUserPermissionResource{}
+
+func NewGraphUserPermissionResource() resource.Resource {
+	return &GraphUserPermissionResource{}
+}
+
+type GraphUserPermissionResource struct {
+	client *graphql.Client
+}
+
+type GraphUserPermissionResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	GraphId    types.String `tfsdk:"graph_id"`
+	UserId     types.String `tfsdk:"user_id"`
+	Permission types.String `tfsdk:"permission"`
+}
+
+func (r *GraphUserPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_user_permission"
+}
+
+func (r *GraphUserPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL graph user permission, which overrides the organization role of a user on a graph. Destroying it restores the organization role.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the permission, in the form `graph_id:user_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"permission": schema.StringAttribute{
+				MarkdownDescription: "Role of the user on the graph.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(graphRoles...),
+				},
+			},
+		},
+	}
+}
+
+func (r *GraphUserPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GraphUserPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *GraphUserPermissionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := overrideUserPermission(ctx, *r.client, data.GraphId.ValueString(), data.UserId.ValueString(), data.Permission.ValueStringPointer())
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create graph user permission, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a graph user permission")
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.GraphId.ValueString(), data.UserId.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphUserPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *GraphUserPermissionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permission, err := readGraphUserPermission(ctx, *r.client, data.GraphId.ValueString(), data.UserId.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "graph user permission not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "user_id": data.UserId.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read graph user permission, got error: %s", err))
+		return
+	}
+
+	data.Permission = types.StringValue(permission.Role)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphUserPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *GraphUserPermissionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := overrideUserPermission(ctx, *r.client, data.GraphId.ValueString(), data.UserId.ValueString(), data.Permission.ValueStringPointer())
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update graph user permission, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a graph user permission")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GraphUserPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *GraphUserPermissionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A null permission removes the override, restoring the organization role.
+	err := overrideUserPermission(ctx, *r.client, data.GraphId.ValueString(), data.UserId.ValueString(), nil)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete graph user permission, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a graph user permission")
+}
+
+func (r *GraphUserPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: graph_id:user_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+}
+
+func overrideUserPermission(ctx context.Context, client graphql.Client, serviceId string, userId string, permission *string) error {
+	response, err := updateGraphUserPermission(ctx, client, serviceId, userId, permission)
+
+	if err != nil {
+		return err
+	}
+
+	if response.Service.OverrideUserPermission == nil {
+		return fmt.Errorf("Unable to find graph with id: %s: %w", serviceId, errNotFound)
+	}
+
+	return nil
+}
+
+func readGraphUserPermission(ctx context.Context, client graphql.Client, serviceId string, userId string) (*GraphUserPermission, error) {
+	response, err := getGraphUserPermissions(ctx, client, serviceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil {
+		return nil, errNotFound
+	}
+
+	for _, permission := range response.Service.RoleOverrides {
+		if permission.User.Id == userId {
+			return &permission.GraphUserPermission, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unable to find permission for user id: %s: %w", userId, errNotFound)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGraphUserPermissionResourceDefault(t *testing.T) {
+	userId := os.Getenv("APOLLO_GRAPHQL_MEMBER_USER_ID")
+
+	if userId == "" {
+		t.Skip("APOLLO_GRAPHQL_MEMBER_USER_ID must be set for graph user permission acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccGraphUserPermissionResourceConfig(userId, "CONTRIBUTOR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_graph_user_permission.test", "id", fmt.Sprintf("Test-w4a5n4:%s", userId)),
+					resource.TestCheckResourceAttr("apollographql_graph_user_permission.test", "graph_id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_graph_user_permission.test", "user_id", userId),
+					resource.TestCheckResourceAttr("apollographql_graph_user_permission.test", "permission", "CONTRIBUTOR"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_graph_user_permission.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccGraphUserPermissionResourceConfig(userId, "OBSERVER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_graph_user_permission.test", "permission", "OBSERVER"),
+				),
+			},
+			// Change outside of terraform and expect it to be reverted
+			{
+				PreConfig: func() {
+					permission := "DOCUMENTER"
+
+					if _, err := updateGraphUserPermission(context.Background(), testAccClient(), "Test-w4a5n4", userId, &permission); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccGraphUserPermissionResourceConfig(userId, "OBSERVER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_graph_user_permission.test", "permission", "OBSERVER"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccGraphUserPermissionResourceConfig(userId string, permission string) string {
+	return fmt.Sprintf(`
+resource "apollographql_graph_user_permission" "test" {
+  graph_id = "Test-w4a5n4"
+  user_id = "%s"
+  permission = "%s"
+}
+`, userId, permission)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -19,6 +20,7 @@ import (
 
 var _ resource.Resource = &KeyResource{}
 var _ resource.ResourceWithImportState = &KeyResource{}
+var _ resource.ResourceWithModifyPlan = &KeyResource{}
 
 func NewKeyResource() resource.Resource {
 	return &KeyResource{}
@@ -28,12 +30,31 @@ type KeyResource struct {
 	client *graphql.Client
 }
 
+// graphRoles are the UserPermission roles which can be given on a graph.
+var graphRoles = []string{
+	"GRAPH_ADMIN",
+	"CONSUMER",
+	"CONTRIBUTOR",
+	"DOCUMENTER",
+	"OBSERVER",
+	"PERSISTED_QUERY_PUBLISHER",
+}
+
+// organizationRoles are the UserPermission roles which can be given on an
+// organization.
+var organizationRoles = append([]string{"ORG_ADMIN", "BILLING_MANAGER"}, graphRoles...)
+
 type KeyResourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
-	Role    types.String `tfsdk:"role"`
-	GraphId types.String `tfsdk:"graph_id"`
-	Token   types.String `tfsdk:"token"`
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Role                types.String `tfsdk:"role"`
+	GraphId             types.String `tfsdk:"graph_id"`
+	Token               types.String `tfsdk:"token"`
+	RotationId          types.String `tfsdk:"rotation_id"`
+	RotationGracePeriod types.String `tfsdk:"rotation_grace_period"`
+	RotatedAt           types.String `tfsdk:"rotated_at"`
+	PreviousId          types.String `tfsdk:"previous_id"`
+	PreviousToken       types.String `tfsdk:"previous_token"`
 }
 
 func (r *KeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -59,22 +80,19 @@ func (r *KeyResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				},
 			},
 			"role": schema.StringAttribute{
-				MarkdownDescription: "Role of the key.",
+				MarkdownDescription: "Role of the key. Changing it replaces the key, or rotates it when `rotation_id` is set.",
 				Optional:            true,
 				Computed:            true,
 				Default:             stringdefault.StaticString("GRAPH_ADMIN"),
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIf(
+						requiresReplaceUnlessRotating,
+						"Changing the role replaces the key unless rotation_id is set.",
+						"Changing the role replaces the key unless `rotation_id` is set.",
+					),
 				},
 				Validators: []validator.String{
-					stringvalidator.OneOf(
-						"GRAPH_ADMIN",
-						"CONSUMER",
-						"CONTRIBUTOR",
-						"DOCUMENTER",
-						"OBSERVER",
-						"PERSISTED_QUERY_PUBLISHER",
-					),
+					stringvalidator.OneOf(graphRoles...),
 				},
 			},
 			"graph_id": schema.StringAttribute{
@@ -95,6 +113,39 @@ func (r *KeyResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"rotation_id": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary value which, when changed or set, rotates the key: a new key is created and the current one is kept as the previous key, so that its users can be moved over before it is removed.",
+				Optional:            true,
+			},
+			"rotation_grace_period": schema.StringAttribute{
+				MarkdownDescription: "Duration, such as `72h`, after a rotation at which the previous key is removed on the next apply. Without it, the previous key is kept until the next rotation.",
+				Optional:            true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"rotated_at": schema.StringAttribute{
+				MarkdownDescription: "Time the key was last rotated, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the previous key, kept after a rotation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_token": schema.StringAttribute{
+				MarkdownDescription: "Token of the previous key, kept after a rotation.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
@@ -119,6 +170,40 @@ func (r *KeyResource) Configure(ctx context.Context, req resource.ConfigureReque
 	r.client = client
 }
 
+// ModifyPlan plans a new key when the key is rotated, and the removal of the
+// previous key once its grace period has passed.
+func (r *KeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data *KeyResourceModel
+	var state *KeyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if keyRotates(data, state) {
+		data.Id = types.StringUnknown()
+		data.Token = types.StringUnknown()
+		data.RotatedAt = types.StringUnknown()
+		data.PreviousId = state.Id
+		data.PreviousToken = state.Token
+	} else if previousKeyExpired(data, time.Now()) {
+		data.PreviousId = types.StringNull()
+		data.PreviousToken = types.StringNull()
+	} else {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &data)...)
+}
+
 func (r *KeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data *KeyResourceModel
 
@@ -131,7 +216,7 @@ func (r *KeyResource) Create(ctx context.Context, req resource.CreateRequest, re
 	response, err := createKey(ctx, *r.client, data.GraphId.ValueString(), data.Name.ValueString(), data.Role.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create key, got error: %s", err))
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create key, got error: %s", err))
 		return
 	}
 
@@ -143,6 +228,9 @@ func (r *KeyResource) Create(ctx context.Context, req resource.CreateRequest, re
 	data.Name = types.StringValue(key.KeyName)
 	data.Role = types.StringValue(key.Role)
 	data.Token = types.StringValue(key.Token)
+	data.RotatedAt = types.StringNull()
+	data.PreviousId = types.StringNull()
+	data.PreviousToken = types.StringNull()
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -158,8 +246,14 @@ func (r *KeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 	key, err := readKey(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
 
+	if isNotFound(err) {
+		tflog.Warn(ctx, "key not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read key, got error: %s", err))
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read key, got error: %s", err))
 		return
 	}
 
@@ -167,11 +261,25 @@ func (r *KeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	data.Name = types.StringValue(key.KeyName)
 	data.Role = types.StringValue(key.Role)
 
+	if !data.PreviousId.IsNull() {
+		_, err := readKey(ctx, *r.client, data.GraphId.ValueString(), data.PreviousId.ValueString())
+
+		if isNotFound(err) {
+			tflog.Warn(ctx, "previous key not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "id": data.PreviousId.ValueString()})
+			data.PreviousId = types.StringNull()
+			data.PreviousToken = types.StringNull()
+		} else if err != nil {
+			resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read key, got error: %s", err))
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *KeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *KeyResourceModel
+	var state *KeyResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 
@@ -179,10 +287,65 @@ func (r *KeyResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The new key is created before the previous one is removed, so that
+	// there is always a valid key to move its users to.
+	if keyRotates(data, state) {
+		response, err := createKey(ctx, *r.client, data.GraphId.ValueString(), data.Name.ValueString(), data.Role.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to rotate key, got error: %s", err))
+			return
+		}
+
+		key := response.Service.NewKey.Key
+
+		data.Id = types.StringValue(key.Id)
+		data.Name = types.StringValue(key.KeyName)
+		data.Role = types.StringValue(key.Role)
+		data.Token = types.StringValue(key.Token)
+		data.RotatedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+		data.PreviousId = state.Id
+		data.PreviousToken = state.Token
+
+		// Save the new key before removing the previous one, so that it
+		// isn't lost if the removal fails.
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+		if !state.PreviousId.IsNull() {
+			_, err = deleteKey(ctx, *r.client, data.GraphId.ValueString(), state.PreviousId.ValueString())
+
+			if err != nil {
+				resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete previous key, got error: %s", err))
+				return
+			}
+		}
+
+		tflog.Trace(ctx, "rotated a key")
+
+		return
+	}
+
+	if data.PreviousId.IsNull() && !state.PreviousId.IsNull() {
+		_, err := deleteKey(ctx, *r.client, data.GraphId.ValueString(), state.PreviousId.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete previous key, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "deleted a previous key")
+	}
+
 	response, err := updateKey(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString(), data.Name.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update key, got error: %s", err))
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update key, got error: %s", err))
 		return
 	}
 
@@ -206,11 +369,21 @@ func (r *KeyResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	_, err := deleteKey(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+	// The previous key is deleted first, so that a failure leaves the current
+	// key in place and the previous one is still tracked by the state.
+	ids := []string{data.Id.ValueString()}
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete key, got error: %s", err))
-		return
+	if !data.PreviousId.IsNull() {
+		ids = []string{data.PreviousId.ValueString(), data.Id.ValueString()}
+	}
+
+	for _, id := range ids {
+		_, err := deleteKey(ctx, *r.client, data.GraphId.ValueString(), id)
+
+		if err != nil && !isNotFound(err) {
+			resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete key, got error: %s", err))
+			return
+		}
 	}
 
 	tflog.Trace(ctx, "deleted a key")
@@ -227,11 +400,81 @@ func readKey(ctx context.Context, client graphql.Client, serviceId string, keyId
 		return nil, err
 	}
 
+	if response.Service == nil {
+		return nil, errNotFound
+	}
+
 	for _, key := range response.Service.ApiKeys {
 		if key.Id == keyId {
 			return &key.Key, nil
 		}
 	}
 
-	return nil, fmt.Errorf("Unable to find key with id: %s", keyId)
+	return nil, fmt.Errorf("Unable to find key with id: %s: %w", keyId, errNotFound)
+}
+
+// keyRotates reports whether the planned changes rotate the key instead of
+// updating it in place.
+func keyRotates(data *KeyResourceModel, state *KeyResourceModel) bool {
+	if data.RotationId.IsNull() {
+		return false
+	}
+
+	return !data.RotationId.Equal(state.RotationId) || !data.Role.Equal(state.Role)
+}
+
+// previousKeyExpired reports whether the grace period of the previous key has
+// passed.
+func previousKeyExpired(data *KeyResourceModel, now time.Time) bool {
+	if data.PreviousId.IsNull() || data.RotationGracePeriod.IsNull() || data.RotatedAt.IsNull() {
+		return false
+	}
+
+	gracePeriod, err := time.ParseDuration(data.RotationGracePeriod.ValueString())
+
+	if err != nil {
+		return false
+	}
+
+	rotatedAt, err := time.Parse(time.RFC3339, data.RotatedAt.ValueString())
+
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(rotatedAt.Add(gracePeriod))
+}
+
+// requiresReplaceUnlessRotating replaces the key when its role changes,
+// unless it is rotated instead.
+func requiresReplaceUnlessRotating(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var rotationId types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotation_id"), &rotationId)...)
+
+	resp.RequiresReplace = rotationId.IsNull()
+}
+
+// durationValidator validates that a string is a positive duration, as
+// accepted by time.ParseDuration.
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return "value must be a positive duration such as 72h"
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a positive duration such as `72h`"
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if duration, err := time.ParseDuration(value); err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Duration", fmt.Sprintf("Expected a positive duration such as 72h, got: %q", value))
+	}
 }
@@ -1,9 +1,14 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -50,6 +55,152 @@ func TestAccKeyResourceDefault(t *testing.T) {
 	})
 }
 
+func TestAccKeyResourceRotation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccKeyResourceConfigRotation("GRAPH_ADMIN", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_key.test", "id"),
+					resource.TestCheckResourceAttrSet("apollographql_key.test", "token"),
+					resource.TestCheckResourceAttr("apollographql_key.test", "rotation_id", "1"),
+					resource.TestCheckNoResourceAttr("apollographql_key.test", "rotated_at"),
+					resource.TestCheckNoResourceAttr("apollographql_key.test", "previous_id"),
+					resource.TestCheckNoResourceAttr("apollographql_key.test", "previous_token"),
+				),
+			},
+			// Rotation testing
+			{
+				Config: testAccKeyResourceConfigRotation("GRAPH_ADMIN", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_key.test", "id"),
+					resource.TestCheckResourceAttrSet("apollographql_key.test", "token"),
+					resource.TestCheckResourceAttr("apollographql_key.test", "rotation_id", "2"),
+					resource.TestCheckResourceAttrSet("apollographql_key.test", "rotated_at"),
+					resource.TestCheckResourceAttrSet("apollographql_key.test", "previous_id"),
+					resource.TestCheckResourceAttrSet("apollographql_key.test", "previous_token"),
+				),
+			},
+			// Rotation by role testing
+			{
+				Config: testAccKeyResourceConfigRotation("CONTRIBUTOR", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_key.test", "role", "CONTRIBUTOR"),
+					resource.TestCheckResourceAttrSet("apollographql_key.test", "previous_id"),
+					resource.TestCheckResourceAttrSet("apollographql_key.test", "previous_token"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestKeyRotates(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    KeyResourceModel
+		state   KeyResourceModel
+		rotates bool
+	}{
+		{
+			name:    "without rotation id",
+			data:    KeyResourceModel{RotationId: types.StringNull(), Role: types.StringValue("CONSUMER")},
+			state:   KeyResourceModel{RotationId: types.StringNull(), Role: types.StringValue("GRAPH_ADMIN")},
+			rotates: false,
+		},
+		{
+			name:    "unchanged",
+			data:    KeyResourceModel{RotationId: types.StringValue("1"), Role: types.StringValue("GRAPH_ADMIN")},
+			state:   KeyResourceModel{RotationId: types.StringValue("1"), Role: types.StringValue("GRAPH_ADMIN")},
+			rotates: false,
+		},
+		{
+			name:    "rotation id set",
+			data:    KeyResourceModel{RotationId: types.StringValue("1"), Role: types.StringValue("GRAPH_ADMIN")},
+			state:   KeyResourceModel{RotationId: types.StringNull(), Role: types.StringValue("GRAPH_ADMIN")},
+			rotates: true,
+		},
+		{
+			name:    "rotation id changed",
+			data:    KeyResourceModel{RotationId: types.StringValue("2"), Role: types.StringValue("GRAPH_ADMIN")},
+			state:   KeyResourceModel{RotationId: types.StringValue("1"), Role: types.StringValue("GRAPH_ADMIN")},
+			rotates: true,
+		},
+		{
+			name:    "role changed",
+			data:    KeyResourceModel{RotationId: types.StringValue("1"), Role: types.StringValue("CONSUMER")},
+			state:   KeyResourceModel{RotationId: types.StringValue("1"), Role: types.StringValue("GRAPH_ADMIN")},
+			rotates: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if rotates := keyRotates(&c.data, &c.state); rotates != c.rotates {
+				t.Errorf("got %t, want %t", rotates, c.rotates)
+			}
+		})
+	}
+}
+
+func TestPreviousKeyExpired(t *testing.T) {
+	now := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name        string
+		previousId  types.String
+		gracePeriod types.String
+		rotatedAt   types.String
+		expired     bool
+	}{
+		{name: "without previous key", previousId: types.StringNull(), gracePeriod: types.StringValue("24h"), rotatedAt: types.StringValue("2024-01-01T00:00:00Z"), expired: false},
+		{name: "without grace period", previousId: types.StringValue("key"), gracePeriod: types.StringNull(), rotatedAt: types.StringValue("2024-01-01T00:00:00Z"), expired: false},
+		{name: "within grace period", previousId: types.StringValue("key"), gracePeriod: types.StringValue("24h"), rotatedAt: types.StringValue("2024-01-09T12:00:00Z"), expired: false},
+		{name: "after grace period", previousId: types.StringValue("key"), gracePeriod: types.StringValue("24h"), rotatedAt: types.StringValue("2024-01-08T00:00:00Z"), expired: true},
+		{name: "at end of grace period", previousId: types.StringValue("key"), gracePeriod: types.StringValue("24h"), rotatedAt: types.StringValue("2024-01-09T00:00:00Z"), expired: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data := KeyResourceModel{PreviousId: c.previousId, RotationGracePeriod: c.gracePeriod, RotatedAt: c.rotatedAt}
+
+			if expired := previousKeyExpired(&data, now); expired != c.expired {
+				t.Errorf("got %t, want %t", expired, c.expired)
+			}
+		})
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	cases := []struct {
+		value string
+		valid bool
+	}{
+		{value: "72h", valid: true},
+		{value: "1h30m", valid: true},
+		{value: "", valid: false},
+		{value: "0s", valid: false},
+		{value: "-1h", valid: false},
+		{value: "3d", valid: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("rotation_grace_period"), ConfigValue: types.StringValue(c.value)}
+			resp := validator.StringResponse{}
+
+			durationValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() == c.valid {
+				t.Errorf("got valid %t, want %t", !resp.Diagnostics.HasError(), c.valid)
+			}
+		})
+	}
+}
+
 func testAccKeyResourceConfigDefault(name string) string {
 	return fmt.Sprintf(`
 resource "apollographql_key" "test" {
@@ -58,3 +209,16 @@ resource "apollographql_key" "test" {
 }
 `, name)
 }
+
+func testAccKeyResourceConfigRotation(role string, rotationId string) string {
+	return fmt.Sprintf(`
+resource "apollographql_key" "test" {
+  name = "router"
+  role = "%s"
+  graph_id = "Test-w4a5n4"
+
+  rotation_id = "%s"
+  rotation_grace_period = "72h"
+}
+`, role, rotationId)
+}
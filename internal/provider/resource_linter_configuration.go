@@ -0,0 +1,426 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &LinterConfigurationResource{}
+var _ resource.ResourceWithImportState = &LinterConfigurationResource{}
+
+func NewLinterConfigurationResource() resource.Resource {
+	return &LinterConfigurationResource{}
+}
+
+type LinterConfigurationResource struct {
+	client *graphql.Client
+}
+
+// lintRules are the names of the lint rules known to the API.
+var lintRules = []string{
+	string(LintRuleAllElementsRequireDescription),
+	string(LintRuleContactDirectiveMissing),
+	string(LintRuleDefinedTypesAreUnused),
+	string(LintRuleDeprecatedDirectiveMissingReason),
+	string(LintRuleDirectiveComposition),
+	string(LintRuleDirectiveNamesShouldBeCamelCase),
+	string(LintRuleDoesNotParse),
+	string(LintRuleEnumPrefix),
+	string(LintRuleEnumSuffix),
+	string(LintRuleEnumUsedAsInputWithoutSuffix),
+	string(LintRuleEnumUsedAsOutputDespiteSuffix),
+	string(LintRuleEnumValuesShouldBeScreamingSnakeCase),
+	string(LintRuleFieldNamesShouldBeCamelCase),
+	string(LintRuleFromSubgraphDoesNotExist),
+	string(LintRuleInconsistentArgumentPresence),
+	string(LintRuleInconsistentButCompatibleArgumentType),
+	string(LintRuleInconsistentButCompatibleFieldType),
+	string(LintRuleInconsistentDefaultValuePresence),
+	string(LintRuleInconsistentDescription),
+	string(LintRuleInconsistentEntity),
+	string(LintRuleInconsistentEnumValueForInputEnum),
+	string(LintRuleInconsistentEnumValueForOutputEnum),
+	string(LintRuleInconsistentExecutableDirectiveLocations),
+	string(LintRuleInconsistentExecutableDirectivePresence),
+	string(LintRuleInconsistentExecutableDirectiveRepeatable),
+	string(LintRuleInconsistentInputObjectField),
+	string(LintRuleInconsistentInterfaceValueTypeField),
+	string(LintRuleInconsistentNonRepeatableDirectiveArguments),
+	string(LintRuleInconsistentObjectValueTypeField),
+	string(LintRuleInconsistentRuntimeTypesForShareableReturn),
+	string(LintRuleInconsistentTypeSystemDirectiveLocations),
+	string(LintRuleInconsistentTypeSystemDirectiveRepeatable),
+	string(LintRuleInconsistentUnionMember),
+	string(LintRuleInputArgumentNamesShouldBeCamelCase),
+	string(LintRuleInputTypeSuffix),
+	string(LintRuleInterfacePrefix),
+	string(LintRuleInterfaceSuffix),
+	string(LintRuleMergedNonRepeatableDirectiveArguments),
+	string(LintRuleNoExecutableDirectiveIntersection),
+	string(LintRuleObjectPrefix),
+	string(LintRuleObjectSuffix),
+	string(LintRuleOverriddenFieldCanBeRemoved),
+	string(LintRuleOverrideDirectiveCanBeRemoved),
+	string(LintRuleQueryDocumentDeclaration),
+	string(LintRuleRestyFieldNames),
+	string(LintRuleTagDirectiveUsesUnknownName),
+	string(LintRuleTypeNamesShouldBePascalCase),
+	string(LintRuleTypePrefix),
+	string(LintRuleTypeSuffix),
+	string(LintRuleUnusedEnumType),
+}
+
+type LinterConfigurationResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	GraphId            types.String `tfsdk:"graph_id"`
+	Rules              types.Map    `tfsdk:"rules"`
+	AllowedTagNames    types.Set    `tfsdk:"allowed_tag_names"`
+	IgnoreDeprecated   types.Bool   `tfsdk:"ignore_deprecated"`
+	IgnoreInaccessible types.Bool   `tfsdk:"ignore_inaccessible"`
+}
+
+func (r *LinterConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_linter_configuration"
+}
+
+func (r *LinterConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL schema linter configuration of a graph. Only the configured settings are managed, and destroying the resource leaves the configuration of the graph as is.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the linter configuration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the linter configuration belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"rules": schema.MapAttribute{
+				MarkdownDescription: "Levels of lint rules, keyed by rule name such as `FIELD_NAMES_SHOULD_BE_CAMEL_CASE`. Levels are one of `ERROR`, `WARNING` or `IGNORED`. Only the configured rules are managed. Rules which aren't configured, including rules removed from this map, keep their current level instead of being reset to their default.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.OneOf(lintRules...),
+					),
+					mapvalidator.ValueStringsAre(
+						stringvalidator.OneOf(
+							string(LintDiagnosticLevelError),
+							string(LintDiagnosticLevelWarning),
+							string(LintDiagnosticLevelIgnored),
+						),
+					),
+				},
+			},
+			"allowed_tag_names": schema.SetAttribute{
+				MarkdownDescription: "Names of the `@tag` directives allowed in the schema.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ignore_deprecated": schema.BoolAttribute{
+				MarkdownDescription: "Whether `@deprecated` elements are ignored by the linter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ignore_inaccessible": schema.BoolAttribute{
+				MarkdownDescription: "Whether `@inaccessible` elements are ignored by the linter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LinterConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LinterConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *LinterConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateLinter(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create linter configuration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a linter configuration")
+
+	data.Id = data.GraphId
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LinterConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *LinterConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := readLinterConfiguration(ctx, *r.client, data.GraphId.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "graph not found, removing linter configuration from state", map[string]interface{}{"graph_id": data.GraphId.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read linter configuration, got error: %s", err))
+		return
+	}
+
+	data.Id = data.GraphId
+
+	if err := setLinterConfiguration(data, configuration); err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read linter configuration, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LinterConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *LinterConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := updateLinter(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update linter configuration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a linter configuration")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LinterConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The linter configuration can't be removed from a graph, so it is only
+	// removed from the state.
+	tflog.Trace(ctx, "deleted a linter configuration")
+}
+
+func (r *LinterConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), req.ID)...)
+}
+
+func readLinterConfiguration(ctx context.Context, client graphql.Client, serviceId string) (*LinterConfiguration, error) {
+	response, err := getLinterConfiguration(ctx, client, serviceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil {
+		return nil, errNotFound
+	}
+
+	configuration := response.Service.LinterConfiguration.LinterConfiguration
+
+	return &configuration, nil
+}
+
+// updateLinter reads the current linter configuration and sends only the
+// changes needed to reach the planned one, since the API takes deltas.
+func updateLinter(ctx context.Context, client graphql.Client, data *LinterConfigurationResourceModel) error {
+	current, err := readLinterConfiguration(ctx, client, data.GraphId.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	changes, changed := linterChanges(current, data)
+
+	if changed {
+		tflog.Debug(ctx, "updating linter configuration", map[string]interface{}{"rules": len(changes.Rules), "allowed_tag_name_additions": changes.AllowedTagNameAdditions, "allowed_tag_name_removals": changes.AllowedTagNameRemovals})
+
+		response, err := updateLinterConfiguration(ctx, client, data.GraphId.ValueString(), changes)
+
+		if err != nil {
+			return err
+		}
+
+		current = &response.Service.UpdateLinterConfiguration.LinterConfiguration
+	}
+
+	return setLinterConfiguration(data, current)
+}
+
+// linterChanges returns the changes from the current linter configuration to
+// the desired one, and whether there are any.
+func linterChanges(current *LinterConfiguration, data *LinterConfigurationResourceModel) (GraphLinterConfigurationChangesInput, bool) {
+	changes := GraphLinterConfigurationChangesInput{}
+	changed := false
+
+	levels := map[string]string{}
+
+	for _, rule := range current.Rules {
+		levels[string(rule.Rule)] = string(rule.Level)
+	}
+
+	rules := make([]string, 0, len(data.Rules.Elements()))
+
+	for rule := range data.Rules.Elements() {
+		rules = append(rules, rule)
+	}
+
+	// Keep the changes in a stable order for logging and tests.
+	sort.Strings(rules)
+
+	for _, rule := range rules {
+		level := data.Rules.Elements()[rule].(types.String).ValueString()
+
+		if levels[rule] != level {
+			changes.Rules = append(changes.Rules, LinterRuleLevelConfigurationChangesInput{Rule: LintRule(rule), Level: LintDiagnosticLevel(level)})
+			changed = true
+		}
+	}
+
+	if !data.AllowedTagNames.IsNull() && !data.AllowedTagNames.IsUnknown() {
+		existing := map[string]bool{}
+		desired := map[string]bool{}
+
+		for _, tag := range current.AllowedTagNames {
+			existing[tag] = true
+		}
+
+		for _, tag := range stringsFromSet(data.AllowedTagNames) {
+			desired[tag] = true
+
+			if !existing[tag] {
+				changes.AllowedTagNameAdditions = append(changes.AllowedTagNameAdditions, tag)
+				changed = true
+			}
+		}
+
+		for _, tag := range current.AllowedTagNames {
+			if !desired[tag] {
+				changes.AllowedTagNameRemovals = append(changes.AllowedTagNameRemovals, tag)
+				changed = true
+			}
+		}
+
+		sort.Strings(changes.AllowedTagNameAdditions)
+		sort.Strings(changes.AllowedTagNameRemovals)
+	}
+
+	if !data.IgnoreDeprecated.IsNull() && !data.IgnoreDeprecated.IsUnknown() && data.IgnoreDeprecated.ValueBool() != current.IgnoreDeprecated {
+		changes.IgnoreDeprecated = data.IgnoreDeprecated.ValueBoolPointer()
+		changed = true
+	}
+
+	if !data.IgnoreInaccessible.IsNull() && !data.IgnoreInaccessible.IsUnknown() && data.IgnoreInaccessible.ValueBool() != current.IgnoreInaccessible {
+		changes.IgnoreInaccessible = data.IgnoreInaccessible.ValueBoolPointer()
+		changed = true
+	}
+
+	return changes, changed
+}
+
+func setLinterConfiguration(data *LinterConfigurationResourceModel, configuration *LinterConfiguration) error {
+	data.AllowedTagNames = setFromStrings(configuration.AllowedTagNames)
+	data.IgnoreDeprecated = types.BoolValue(configuration.IgnoreDeprecated)
+	data.IgnoreInaccessible = types.BoolValue(configuration.IgnoreInaccessible)
+
+	// Only the configured rules are kept, since the graph has a level for
+	// every rule.
+	if data.Rules.IsNull() || data.Rules.IsUnknown() {
+		return nil
+	}
+
+	rules := map[string]attr.Value{}
+
+	for _, rule := range configuration.Rules {
+		if _, ok := data.Rules.Elements()[string(rule.Rule)]; ok {
+			rules[string(rule.Rule)] = types.StringValue(string(rule.Level))
+		}
+	}
+
+	missing := []string{}
+
+	for rule := range data.Rules.Elements() {
+		if _, ok := rules[rule]; !ok {
+			missing = append(missing, rule)
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+
+		return fmt.Errorf("Unable to find level of lint rules: %s", strings.Join(missing, ", "))
+	}
+
+	data.Rules = types.MapValueMust(types.StringType, rules)
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLinterConfigurationResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccLinterConfigurationResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_linter_configuration.test", "id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_linter_configuration.test", "graph_id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_linter_configuration.test", "rules.%", "1"),
+					resource.TestCheckResourceAttr("apollographql_linter_configuration.test", "rules.FIELD_NAMES_SHOULD_BE_CAMEL_CASE", "ERROR"),
+					resource.TestCheckResourceAttr("apollographql_linter_configuration.test", "allowed_tag_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr("apollographql_linter_configuration.test", "allowed_tag_names.*", "public"),
+					resource.TestCheckResourceAttr("apollographql_linter_configuration.test", "ignore_deprecated", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "apollographql_linter_configuration.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rules"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccLinterConfigurationResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_linter_configuration.test", "rules.%", "2"),
+					resource.TestCheckResourceAttr("apollographql_linter_configuration.test", "rules.FIELD_NAMES_SHOULD_BE_CAMEL_CASE", "WARNING"),
+					resource.TestCheckResourceAttr("apollographql_linter_configuration.test", "rules.TYPE_NAMES_SHOULD_BE_PASCAL_CASE", "IGNORED"),
+					resource.TestCheckResourceAttr("apollographql_linter_configuration.test", "allowed_tag_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr("apollographql_linter_configuration.test", "allowed_tag_names.*", "internal"),
+					resource.TestCheckTypeSetElemAttr("apollographql_linter_configuration.test", "allowed_tag_names.*", "partner"),
+					resource.TestCheckResourceAttr("apollographql_linter_configuration.test", "ignore_deprecated", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestLintRules(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "generated.go", nil, 0)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generated := []string{}
+
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.GenDecl)
+
+		if !ok || decl.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range decl.Specs {
+			spec := spec.(*ast.ValueSpec)
+
+			if ident, ok := spec.Type.(*ast.Ident); !ok || ident.Name != "LintRule" {
+				continue
+			}
+
+			value, err := strconv.Unquote(spec.Values[0].(*ast.BasicLit).Value)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			generated = append(generated, value)
+		}
+	}
+
+	if !reflect.DeepEqual(lintRules, generated) {
+		t.Errorf("expected lint rules %v, got %v", generated, lintRules)
+	}
+}
+
+func TestLinterChanges(t *testing.T) {
+	current := &LinterConfiguration{
+		AllowedTagNames:    []string{"internal", "public"},
+		IgnoreDeprecated:   false,
+		IgnoreInaccessible: true,
+		Rules: []LinterConfigurationRulesLinterRuleLevelConfiguration{
+			{Rule: "FIELD_NAMES_SHOULD_BE_CAMEL_CASE", Level: LintDiagnosticLevelWarning},
+			{Rule: "TYPE_NAMES_SHOULD_BE_PASCAL_CASE", Level: LintDiagnosticLevelError},
+		},
+	}
+
+	cases := []struct {
+		name    string
+		data    LinterConfigurationResourceModel
+		changes GraphLinterConfigurationChangesInput
+		changed bool
+	}{
+		{
+			name: "unconfigured",
+			data: LinterConfigurationResourceModel{
+				Rules:              types.MapNull(types.StringType),
+				AllowedTagNames:    types.SetUnknown(types.StringType),
+				IgnoreDeprecated:   types.BoolUnknown(),
+				IgnoreInaccessible: types.BoolUnknown(),
+			},
+			changes: GraphLinterConfigurationChangesInput{},
+			changed: false,
+		},
+		{
+			name: "unchanged",
+			data: LinterConfigurationResourceModel{
+				Rules:              types.MapValueMust(types.StringType, map[string]attr.Value{"FIELD_NAMES_SHOULD_BE_CAMEL_CASE": types.StringValue("WARNING")}),
+				AllowedTagNames:    setFromStrings([]string{"public", "internal"}),
+				IgnoreDeprecated:   types.BoolValue(false),
+				IgnoreInaccessible: types.BoolValue(true),
+			},
+			changes: GraphLinterConfigurationChangesInput{},
+			changed: false,
+		},
+		{
+			name: "changed",
+			data: LinterConfigurationResourceModel{
+				Rules: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"FIELD_NAMES_SHOULD_BE_CAMEL_CASE": types.StringValue("WARNING"),
+					"TYPE_NAMES_SHOULD_BE_PASCAL_CASE": types.StringValue("IGNORED"),
+				}),
+				AllowedTagNames:    setFromStrings([]string{"public", "partner"}),
+				IgnoreDeprecated:   types.BoolValue(true),
+				IgnoreInaccessible: types.BoolValue(true),
+			},
+			changes: GraphLinterConfigurationChangesInput{
+				AllowedTagNameAdditions: []string{"partner"},
+				AllowedTagNameRemovals:  []string{"internal"},
+				IgnoreDeprecated:        types.BoolValue(true).ValueBoolPointer(),
+				Rules: []LinterRuleLevelConfigurationChangesInput{
+					{Rule: "TYPE_NAMES_SHOULD_BE_PASCAL_CASE", Level: LintDiagnosticLevelIgnored},
+				},
+			},
+			changed: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			changes, changed := linterChanges(current, &c.data)
+
+			if changed != c.changed {
+				t.Errorf("got changed %t, want %t", changed, c.changed)
+			}
+
+			if !reflect.DeepEqual(changes, c.changes) {
+				t.Errorf("got %+v, want %+v", changes, c.changes)
+			}
+		})
+	}
+}
+
+func testAccLinterConfigurationResourceConfigDefault() string {
+	return `
+resource "apollographql_linter_configuration" "test" {
+  graph_id = "Test-w4a5n4"
+  rules = {
+    FIELD_NAMES_SHOULD_BE_CAMEL_CASE = "ERROR"
+  }
+  allowed_tag_names = ["public"]
+  ignore_deprecated = true
+}
+`
+}
+
+func testAccLinterConfigurationResourceConfigNonDefault() string {
+	return `
+resource "apollographql_linter_configuration" "test" {
+  graph_id = "Test-w4a5n4"
+  rules = {
+    FIELD_NAMES_SHOULD_BE_CAMEL_CASE = "WARNING"
+    TYPE_NAMES_SHOULD_BE_PASCAL_CASE = "IGNORED"
+  }
+  allowed_tag_names = ["internal", "partner"]
+  ignore_deprecated = false
+}
+`
+}
+
+func TestSetLinterConfiguration(t *testing.T) {
+	configuration := &LinterConfiguration{
+		Rules: []LinterConfigurationRulesLinterRuleLevelConfiguration{
+			{Rule: "FIELD_NAMES_SHOULD_BE_CAMEL_CASE", Level: LintDiagnosticLevelWarning},
+			{Rule: "TYPE_NAMES_SHOULD_BE_PASCAL_CASE", Level: LintDiagnosticLevelError},
+		},
+	}
+
+	cases := []struct {
+		name  string
+		rules map[string]attr.Value
+		valid bool
+	}{
+		{"configured", map[string]attr.Value{"FIELD_NAMES_SHOULD_BE_CAMEL_CASE": types.StringValue("ERROR")}, true},
+		{"missing", map[string]attr.Value{"ENUM_PREFIX": types.StringValue("ERROR")}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data := &LinterConfigurationResourceModel{Rules: types.MapValueMust(types.StringType, c.rules)}
+
+			err := setLinterConfiguration(data, configuration)
+
+			if c.valid && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if !c.valid && err == nil {
+				t.Errorf("expected an error for rules missing from the configuration")
+			}
+		})
+	}
+}
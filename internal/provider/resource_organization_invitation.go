@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OrganizationInvitationResource{}
+var _ resource.ResourceWithImportState = &OrganizationInvitationResource{}
+
+func NewOrganizationInvitationResource() resource.Resource {
+	return &OrganizationInvitationResource{}
+}
+
+type OrganizationInvitationResource struct {
+	client *graphql.Client
+}
+
+type OrganizationInvitationResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	Email          types.String `tfsdk:"email"`
+	Role           types.String `tfsdk:"role"`
+	ResendTrigger  types.Map    `tfsdk:"resend_trigger"`
+	Status         types.String `tfsdk:"status"`
+}
+
+func (r *OrganizationInvitationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invitation"
+}
+
+func (r *OrganizationInvitationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL organization invitation. Destroying an accepted invitation doesn't remove the member from the organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the invitation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the organization to invite to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the invitee.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the invitee in the organization.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("CONSUMER"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(organizationRoles...),
+				},
+			},
+			"resend_trigger": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which, when changed, send the invitation email again.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the invitation, either `PENDING` or `ACCEPTED`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationInvitationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *OrganizationInvitationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createOrganizationInvitation(ctx, *r.client, data.OrganizationId.ValueString(), data.Email.ValueString(), data.Role.ValueString())
+
+	if err == nil && response.Account.Invite == nil {
+		err = fmt.Errorf("no invitation was sent to %s", data.Email.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create organization invitation, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an organization invitation")
+
+	setOrganizationInvitation(data, &response.Account.Invite.OrganizationInvitation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OrganizationInvitationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	invitation, err := readOrganizationInvitation(ctx, *r.client, data.OrganizationId.ValueString(), data.Id.ValueString(), data.Email.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "organization invitation not found, removing from state", map[string]interface{}{"organization_id": data.OrganizationId.ValueString(), "email": data.Email.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read organization invitation, got error: %s", err))
+		return
+	}
+
+	setOrganizationInvitation(data, invitation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *OrganizationInvitationResourceModel
+	var state *OrganizationInvitationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the resend trigger can change in place, and an accepted invitation
+	// can't be sent again.
+	if !data.ResendTrigger.Equal(state.ResendTrigger) && data.Status.ValueString() != "ACCEPTED" {
+		response, err := resendOrganizationInvitation(ctx, *r.client, data.OrganizationId.ValueString(), data.Id.ValueString())
+
+		if err == nil && response.Account.ResendInvitation == nil {
+			err = fmt.Errorf("invitation was not sent again to %s", data.Email.ValueString())
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to resend organization invitation, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "resent an organization invitation")
+
+		setOrganizationInvitation(data, &response.Account.ResendInvitation.OrganizationInvitation)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OrganizationInvitationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteOrganizationInvitation(ctx, *r.client, data.OrganizationId.ValueString(), data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete organization invitation, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an organization invitation")
+}
+
+func (r *OrganizationInvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization_id:email. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), parts[1])...)
+}
+
+// readOrganizationInvitation finds the invitation with the given id, or an
+// invitation sent to the given email when the id isn't known yet, e.g. after
+// an import.
+func readOrganizationInvitation(ctx context.Context, client graphql.Client, accountId string, id string, email string) (*OrganizationInvitation, error) {
+	response, err := getOrganizationInvitations(ctx, client, accountId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Account == nil {
+		return nil, errNotFound
+	}
+
+	var found *OrganizationInvitation
+
+	for _, invitation := range response.Account.Invitations {
+		invitation := invitation.OrganizationInvitation
+
+		if id != "" && invitation.Id == id {
+			return &invitation, nil
+		}
+
+		if id == "" && strings.EqualFold(invitation.Email, email) {
+			found = &invitation
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("Unable to find invitation for email: %s: %w", email, errNotFound)
+	}
+
+	return found, nil
+}
+
+func setOrganizationInvitation(data *OrganizationInvitationResourceModel, invitation *OrganizationInvitation) {
+	data.Id = types.StringValue(invitation.Id)
+	data.Role = types.StringValue(invitation.Role)
+
+	// Keep the configured casing of the email, which the API may normalize.
+	if !strings.EqualFold(data.Email.ValueString(), invitation.Email) {
+		data.Email = types.StringValue(invitation.Email)
+	}
+
+	if invitation.AcceptedAt != nil {
+		data.Status = types.StringValue("ACCEPTED")
+	} else {
+		data.Status = types.StringValue("PENDING")
+	}
+}
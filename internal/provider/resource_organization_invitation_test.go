@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationInvitationResourceDefault(t *testing.T) {
+	email := fmt.Sprintf("todo-%s@example.com", uuid.New().String())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationInvitationResourceConfigDefault(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_organization_invitation.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_organization_invitation.test", "organization_id", "pksunkara"),
+					resource.TestCheckResourceAttr("apollographql_organization_invitation.test", "email", email),
+					resource.TestCheckResourceAttr("apollographql_organization_invitation.test", "role", "CONSUMER"),
+					resource.TestCheckNoResourceAttr("apollographql_organization_invitation.test", "resend_trigger"),
+					resource.TestCheckResourceAttr("apollographql_organization_invitation.test", "status", "PENDING"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_organization_invitation.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("pksunkara:%s", email),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationInvitationResourceConfigNonDefault(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_organization_invitation.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_organization_invitation.test", "role", "DOCUMENTER"),
+					resource.TestCheckResourceAttr("apollographql_organization_invitation.test", "resend_trigger.reminder", "1"),
+					resource.TestCheckResourceAttr("apollographql_organization_invitation.test", "status", "PENDING"),
+				),
+			},
+			// Resend testing
+			{
+				Config: testAccOrganizationInvitationResourceConfigResend(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_organization_invitation.test", "resend_trigger.reminder", "2"),
+					resource.TestCheckResourceAttr("apollographql_organization_invitation.test", "status", "PENDING"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccOrganizationInvitationResourceConfigDefault(email string) string {
+	return fmt.Sprintf(`
+resource "apollographql_organization_invitation" "test" {
+  organization_id = "pksunkara"
+  email = "%s"
+}
+`, email)
+}
+
+func testAccOrganizationInvitationResourceConfigNonDefault(email string) string {
+	return fmt.Sprintf(`
+resource "apollographql_organization_invitation" "test" {
+  organization_id = "pksunkara"
+  email = "%s"
+  role = "DOCUMENTER"
+
+  resend_trigger = {
+    reminder = "1"
+  }
+}
+`, email)
+}
+
+func testAccOrganizationInvitationResourceConfigResend(email string) string {
+	return fmt.Sprintf(`
+resource "apollographql_organization_invitation" "test" {
+  organization_id = "pksunkara"
+  email = "%s"
+  role = "DOCUMENTER"
+
+  resend_trigger = {
+    reminder = "2"
+  }
+}
+`, email)
+}
@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// studioURL is where users open the invite links. It isn't derived from the
+// provider endpoint, since the API and Studio are served from different hosts.
+const studioURL = "https://studio.apollographql.com"
+
+var _ resource.Resource = &OrganizationInviteLinkResource{}
+var _ resource.ResourceWithImportState = &OrganizationInviteLinkResource{}
+
+func NewOrganizationInviteLinkResource() resource.Resource {
+	return &OrganizationInviteLinkResource{}
+}
+
+type OrganizationInviteLinkResource struct {
+	client *graphql.Client
+}
+
+type OrganizationInviteLinkResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	OrganizationId  types.String `tfsdk:"organization_id"`
+	Role            types.String `tfsdk:"role"`
+	RotationTrigger types.Map    `tfsdk:"rotation_trigger"`
+	JoinToken       types.String `tfsdk:"join_token"`
+	Link            types.String `tfsdk:"link"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+}
+
+func (r *OrganizationInviteLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_invite_link"
+}
+
+func (r *OrganizationInviteLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL organization invite link, which anyone can use to join the organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the invite link, which is a hash of its join token.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the organization to join.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role given to users joining with the link.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("CONSUMER"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(organizationRoles...),
+				},
+			},
+			"rotation_trigger": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which, when changed, revoke the link and create a new one.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"join_token": schema.StringAttribute{
+				MarkdownDescription: "Join token of the link, which is used to join the organization.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"link": schema.StringAttribute{
+				MarkdownDescription: "URL of the link in Studio, which users open to join the organization. It always points at `https://studio.apollographql.com`, regardless of the provider `endpoint`.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Time the link was created, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationInviteLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationInviteLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *OrganizationInviteLinkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createOrganizationInviteLink(ctx, *r.client, data.OrganizationId.ValueString(), data.Role.ValueString())
+
+	if err == nil && response.Account.CreateStaticInvitation == nil {
+		err = fmt.Errorf("no invite link was created for organization %s", data.OrganizationId.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create organization invite link, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an organization invite link")
+
+	setOrganizationInviteLink(data, &response.Account.CreateStaticInvitation.OrganizationInviteLink)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInviteLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OrganizationInviteLinkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	link, err := readOrganizationInviteLink(ctx, *r.client, data.OrganizationId.ValueString(), data.JoinToken.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "organization invite link not found, removing from state", map[string]interface{}{"organization_id": data.OrganizationId.ValueString(), "id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read organization invite link, got error: %s", err))
+		return
+	}
+
+	setOrganizationInviteLink(data, link)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInviteLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every attribute either requires replacement or is computed, so there is
+	// nothing to update.
+	var data *OrganizationInviteLinkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationInviteLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OrganizationInviteLinkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteOrganizationInviteLink(ctx, *r.client, data.OrganizationId.ValueString(), data.JoinToken.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete organization invite link, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an organization invite link")
+}
+
+func (r *OrganizationInviteLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The join token is a secret, so it is read back from the organization
+	// instead of being part of the import identifier.
+	resource.ImportStatePassthroughID(ctx, path.Root("organization_id"), req, resp)
+}
+
+// readOrganizationInviteLink finds the invite link with the given join token,
+// or the only invite link of the organization when the token isn't known yet,
+// e.g. after an import.
+func readOrganizationInviteLink(ctx context.Context, client graphql.Client, accountId string, joinToken string) (*OrganizationInviteLink, error) {
+	response, err := getOrganizationInviteLinks(ctx, client, accountId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Account == nil {
+		return nil, errNotFound
+	}
+
+	links := response.Account.StaticInvitations
+
+	if joinToken == "" {
+		if len(links) > 1 {
+			return nil, fmt.Errorf("organization %s has %d invite links, expected only one", accountId, len(links))
+		}
+
+		if len(links) == 1 {
+			return &links[0].OrganizationInviteLink, nil
+		}
+	}
+
+	for _, link := range links {
+		if link.JoinToken == joinToken {
+			return &link.OrganizationInviteLink, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unable to find invite link: %w", errNotFound)
+}
+
+func setOrganizationInviteLink(data *OrganizationInviteLinkResourceModel, link *OrganizationInviteLink) {
+	// The join token is a secret, so the id is derived from it instead.
+	hash := sha256.Sum256([]byte(link.JoinToken))
+
+	data.Id = types.StringValue(hex.EncodeToString(hash[:]))
+	data.Role = types.StringValue(link.Role)
+	data.JoinToken = types.StringValue(link.JoinToken)
+	data.Link = types.StringValue(fmt.Sprintf("%s/join?%s", studioURL, url.Values{"accountId": {data.OrganizationId.ValueString()}, "joinToken": {link.JoinToken}}.Encode()))
+	data.CreatedAt = types.StringValue(link.CreatedAt.Format(time.RFC3339))
+}
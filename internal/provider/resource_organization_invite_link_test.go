@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccOrganizationInviteLinkResourceDefault(t *testing.T) {
+	var joinToken string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationInviteLinkResourceConfig("2024-Q1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_organization_invite_link.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_organization_invite_link.test", "organization_id", "pksunkara"),
+					resource.TestCheckResourceAttr("apollographql_organization_invite_link.test", "role", "CONSUMER"),
+					resource.TestCheckResourceAttr("apollographql_organization_invite_link.test", "rotation_trigger.quarter", "2024-Q1"),
+					resource.TestCheckResourceAttrSet("apollographql_organization_invite_link.test", "join_token"),
+					resource.TestCheckResourceAttrSet("apollographql_organization_invite_link.test", "link"),
+					resource.TestCheckResourceAttrSet("apollographql_organization_invite_link.test", "created_at"),
+					testAccOrganizationInviteLinkJoinToken(&joinToken),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "apollographql_organization_invite_link.test",
+				ImportState:             true,
+				ImportStateId:           "pksunkara",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_trigger"},
+			},
+			// Rotation testing
+			{
+				Config: testAccOrganizationInviteLinkResourceConfig("2024-Q2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_organization_invite_link.test", "rotation_trigger.quarter", "2024-Q2"),
+					func(s *terraform.State) error {
+						if s.RootModule().Resources["apollographql_organization_invite_link.test"].Primary.Attributes["join_token"] == joinToken {
+							return fmt.Errorf("join token was not rotated")
+						}
+
+						return nil
+					},
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccOrganizationInviteLinkJoinToken(joinToken *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["apollographql_organization_invite_link.test"]
+
+		if !ok {
+			return fmt.Errorf("Not found: apollographql_organization_invite_link.test")
+		}
+
+		*joinToken = rs.Primary.Attributes["join_token"]
+
+		return nil
+	}
+}
+
+func testAccOrganizationInviteLinkResourceConfig(quarter string) string {
+	return fmt.Sprintf(`
+resource "apollographql_organization_invite_link" "test" {
+  organization_id = "pksunkara"
+
+  rotation_trigger = {
+    quarter = "%s"
+  }
+}
+`, quarter)
+}
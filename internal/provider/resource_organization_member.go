@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &OrganizationMemberResource{}
+var _ resource.ResourceWithImportState = &OrganizationMemberResource{}
+
+func NewOrganizationMemberResource() resource.Resource {
+	return &OrganizationMemberResource{}
+}
+
+type OrganizationMemberResource struct {
+	client *graphql.Client
+}
+
+type OrganizationMemberResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	OrganizationId types.String `tfsdk:"organization_id"`
+	UserId         types.String `tfsdk:"user_id"`
+	Role           types.String `tfsdk:"role"`
+	AllowRemoval   types.Bool   `tfsdk:"allow_removal"`
+	Email          types.String `tfsdk:"email"`
+}
+
+func (r *OrganizationMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_member"
+}
+
+func (r *OrganizationMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL organization member. The user must already have joined the organization.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the member, in the form `organization_id:user_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the organization.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the member in the organization.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(organizationRoles...),
+				},
+			},
+			"allow_removal": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the resource removes the member from the organization. Otherwise it is only removed from the state. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the member.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *OrganizationMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := readOrganizationMember(ctx, *r.client, data.OrganizationId.ValueString(), data.UserId.ValueString())
+
+	if err == nil && member.Permission != data.Role.ValueString() {
+		err = updateMemberRole(ctx, *r.client, data)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create organization member, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created an organization member")
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.OrganizationId.ValueString(), data.UserId.ValueString()))
+	data.Email = types.StringPointerValue(member.User.Email)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *OrganizationMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	member, err := readOrganizationMember(ctx, *r.client, data.OrganizationId.ValueString(), data.UserId.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "organization member not found, removing from state", map[string]interface{}{"organization_id": data.OrganizationId.ValueString(), "user_id": data.UserId.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read organization member, got error: %s", err))
+		return
+	}
+
+	data.Role = types.StringValue(member.Permission)
+	data.Email = types.StringPointerValue(member.User.Email)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *OrganizationMemberResourceModel
+	var state *OrganizationMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Role.ValueString() != state.Role.ValueString() {
+		err := updateMemberRole(ctx, *r.client, data)
+
+		if err != nil {
+			resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update organization member, got error: %s", err))
+			return
+		}
+
+		tflog.Trace(ctx, "updated an organization member")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *OrganizationMemberResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without an explicit opt-in, the member is only removed from the state.
+	if !data.AllowRemoval.ValueBool() {
+		tflog.Warn(ctx, "organization member removed from state without removing it from the organization", map[string]interface{}{"organization_id": data.OrganizationId.ValueString(), "user_id": data.UserId.ValueString()})
+		return
+	}
+
+	_, err := deleteOrganizationMember(ctx, *r.client, data.OrganizationId.ValueString(), data.UserId.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete organization member, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted an organization member")
+}
+
+func (r *OrganizationMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: organization_id:user_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("allow_removal"), false)...)
+}
+
+func updateMemberRole(ctx context.Context, client graphql.Client, data *OrganizationMemberResourceModel) error {
+	response, err := updateOrganizationMember(ctx, client, data.OrganizationId.ValueString(), data.UserId.ValueString(), data.Role.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	if response.Account.UpdateUserPermission == nil {
+		return fmt.Errorf("Unable to find member with user id: %s: %w", data.UserId.ValueString(), errNotFound)
+	}
+
+	return nil
+}
+
+func readOrganizationMember(ctx context.Context, client graphql.Client, accountId string, userId string) (*OrganizationMember, error) {
+	response, err := getOrganizationMembers(ctx, client, accountId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Account == nil {
+		return nil, errNotFound
+	}
+
+	for _, member := range response.Account.Memberships {
+		if member.User.Id == userId {
+			return &member.OrganizationMember, nil
+		}
+	}
+
+	return nil, fmt.Errorf("Unable to find member with user id: %s: %w", userId, errNotFound)
+}
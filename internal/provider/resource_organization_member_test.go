@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationMemberResourceDefault(t *testing.T) {
+	userId := os.Getenv("APOLLO_GRAPHQL_MEMBER_USER_ID")
+
+	if userId == "" {
+		t.Skip("APOLLO_GRAPHQL_MEMBER_USER_ID must be set for organization member acceptance tests")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationMemberResourceConfig(userId, "CONSUMER"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_organization_member.test", "id", fmt.Sprintf("pksunkara:%s", userId)),
+					resource.TestCheckResourceAttr("apollographql_organization_member.test", "organization_id", "pksunkara"),
+					resource.TestCheckResourceAttr("apollographql_organization_member.test", "user_id", userId),
+					resource.TestCheckResourceAttr("apollographql_organization_member.test", "role", "CONSUMER"),
+					resource.TestCheckResourceAttr("apollographql_organization_member.test", "allow_removal", "false"),
+					resource.TestCheckResourceAttrSet("apollographql_organization_member.test", "email"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_organization_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationMemberResourceConfig(userId, "CONTRIBUTOR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_organization_member.test", "role", "CONTRIBUTOR"),
+				),
+			},
+			// Change outside of terraform and expect it to be reverted
+			{
+				PreConfig: func() {
+					if _, err := updateOrganizationMember(context.Background(), testAccClient(), "pksunkara", userId, "OBSERVER"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccOrganizationMemberResourceConfig(userId, "CONTRIBUTOR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_organization_member.test", "role", "CONTRIBUTOR"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccOrganizationMemberResourceConfig(userId string, role string) string {
+	return fmt.Sprintf(`
+resource "apollographql_organization_member" "test" {
+  organization_id = "pksunkara"
+  user_id = "%s"
+  role = "%s"
+}
+`, userId, role)
+}
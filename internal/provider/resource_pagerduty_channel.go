@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &PagerDutyChannelResource{}
+var _ resource.ResourceWithImportState = &PagerDutyChannelResource{}
+
+func NewPagerDutyChannelResource() resource.Resource {
+	return &PagerDutyChannelResource{}
+}
+
+type PagerDutyChannelResource struct {
+	client *graphql.Client
+}
+
+type PagerDutyChannelResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	GraphId    types.String `tfsdk:"graph_id"`
+	Name       types.String `tfsdk:"name"`
+	RoutingKey types.String `tfsdk:"routing_key"`
+}
+
+func (r *PagerDutyChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pagerduty_channel"
+}
+
+func (r *PagerDutyChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL PagerDuty notification channel.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the channel belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the channel.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"routing_key": schema.StringAttribute{
+				MarkdownDescription: "Integration key of the PagerDuty service the notifications are sent to.",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *PagerDutyChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PagerDutyChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PagerDutyChannelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := upsertPagerDutyChannel(ctx, *r.client, data.GraphId.ValueString(), nil, PagerDutyChannelInput{Name: data.Name.ValueString(), RoutingKey: data.RoutingKey.ValueString()})
+
+	if err == nil && response.Service.UpsertPagerDutyChannel == nil {
+		err = fmt.Errorf("Unable to create PagerDuty channel for graph: %s", data.GraphId.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create PagerDuty channel, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a PagerDuty channel")
+
+	channel := response.Service.UpsertPagerDutyChannel.PagerDutyChannel
+
+	data.Id = types.StringValue(channel.Id)
+	data.Name = types.StringValue(channel.Name)
+	data.RoutingKey = types.StringValue(channel.RoutingKey)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PagerDutyChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PagerDutyChannelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := readPagerDutyChannel(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if removeMissingChannel(ctx, resp, err, "PagerDuty", data.GraphId.ValueString(), data.Id.ValueString()) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read PagerDuty channel, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(channel.Id)
+	data.Name = types.StringValue(channel.Name)
+	data.RoutingKey = types.StringValue(channel.RoutingKey)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PagerDutyChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *PagerDutyChannelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := upsertPagerDutyChannel(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueStringPointer(), PagerDutyChannelInput{Name: data.Name.ValueString(), RoutingKey: data.RoutingKey.ValueString()})
+
+	if err == nil && response.Service.UpsertPagerDutyChannel == nil {
+		err = fmt.Errorf("Unable to update PagerDuty channel: %s", data.Id.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update PagerDuty channel, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a PagerDuty channel")
+
+	channel := response.Service.UpsertPagerDutyChannel.PagerDutyChannel
+
+	data.Name = types.StringValue(channel.Name)
+	data.RoutingKey = types.StringValue(channel.RoutingKey)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PagerDutyChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *PagerDutyChannelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteChannel(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete PagerDuty channel, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a PagerDuty channel")
+}
+
+func (r *PagerDutyChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importChannelState(ctx, req, resp)
+}
+
+func readPagerDutyChannel(ctx context.Context, client graphql.Client, serviceId string, id string) (*PagerDutyChannel, error) {
+	response, err := getPagerDutyChannel(ctx, client, serviceId, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil {
+		return nil, errNotFound
+	}
+
+	for _, item := range response.Service.Channels {
+		if channel, ok := item.(*getPagerDutyChannelServiceChannelsPagerDutyChannel); ok && channel.Id == id {
+			return &channel.PagerDutyChannel, nil
+		}
+	}
+
+	return nil, errNotFound
+}
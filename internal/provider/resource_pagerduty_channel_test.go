@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPagerDutyChannelResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPagerDutyChannelResourceConfig("On-call", "R0123456789ABCDEFGHIJKLMNOPQRSTU"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_pagerduty_channel.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_pagerduty_channel.test", "graph_id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_pagerduty_channel.test", "name", "On-call"),
+					resource.TestCheckResourceAttr("apollographql_pagerduty_channel.test", "routing_key", "R0123456789ABCDEFGHIJKLMNOPQRSTU"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_pagerduty_channel.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccGraphImportStateId("apollographql_pagerduty_channel.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccPagerDutyChannelResourceConfig("Platform On-call", "R9876543210ABCDEFGHIJKLMNOPQRSTU"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_pagerduty_channel.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_pagerduty_channel.test", "name", "Platform On-call"),
+					resource.TestCheckResourceAttr("apollographql_pagerduty_channel.test", "routing_key", "R9876543210ABCDEFGHIJKLMNOPQRSTU"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPagerDutyChannelResourceConfig(name string, routingKey string) string {
+	return fmt.Sprintf(`
+resource "apollographql_pagerduty_channel" "test" {
+  graph_id = "Test-w4a5n4"
+  name = "%s"
+  routing_key = "%s"
+}
+`, name, routingKey)
+}
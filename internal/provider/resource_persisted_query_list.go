@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &PersistedQueryListResource{}
+var _ resource.ResourceWithImportState = &PersistedQueryListResource{}
+
+func NewPersistedQueryListResource() resource.Resource {
+	return &PersistedQueryListResource{}
+}
+
+type PersistedQueryListResource struct {
+	client *graphql.Client
+}
+
+type PersistedQueryListResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	GraphId     types.String `tfsdk:"graph_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *PersistedQueryListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_persisted_query_list"
+}
+
+func (r *PersistedQueryListResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL persisted query list.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the persisted query list.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the persisted query list belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the persisted query list.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the persisted query list.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (r *PersistedQueryListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PersistedQueryListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PersistedQueryListResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := createPersistedQueryList(ctx, *r.client, data.GraphId.ValueString(), data.Name.ValueString(), data.Description.ValueString())
+
+	if err == nil {
+		err = resultError("createPersistedQueryList", response.Service.CreatePersistedQueryList)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create persisted query list, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a persisted query list")
+
+	result := response.Service.CreatePersistedQueryList.(*createPersistedQueryListServiceServiceMutationCreatePersistedQueryListCreatePersistedQueryListResult)
+	list := result.PersistedQueryList.PersistedQueryList
+
+	data.Id = types.StringValue(list.Id)
+	data.Name = types.StringValue(list.Name)
+	data.Description = types.StringValue(list.Description)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedQueryListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PersistedQueryListResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := readPersistedQueryList(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "persisted query list not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read persisted query list, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(list.Id)
+	data.Name = types.StringValue(list.Name)
+	data.Description = types.StringValue(list.Description)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedQueryListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *PersistedQueryListResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := updatePersistedQueryList(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString(), data.Name.ValueString(), data.Description.ValueString())
+
+	if err == nil {
+		err = resultError("updatePersistedQueryList", response.Service.PersistedQueryList.UpdateMetadata)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update persisted query list, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a persisted query list")
+
+	result := response.Service.PersistedQueryList.UpdateMetadata.(*updatePersistedQueryListServiceServiceMutationPersistedQueryListPersistedQueryListMutationUpdateMetadataUpdatePersistedQueryListMetadataResult)
+	list := result.PersistedQueryList.PersistedQueryList
+
+	data.Name = types.StringValue(list.Name)
+	data.Description = types.StringValue(list.Description)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedQueryListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *PersistedQueryListResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := deletePersistedQueryList(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if err == nil {
+		err = resultError("deletePersistedQueryList", response.Service.PersistedQueryList.Delete)
+	}
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete persisted query list, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a persisted query list")
+}
+
+func (r *PersistedQueryListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: graph_id:id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), parts[0])...)
+}
+
+func readPersistedQueryList(ctx context.Context, client graphql.Client, serviceId string, id string) (*PersistedQueryList, error) {
+	response, err := getPersistedQueryList(ctx, client, serviceId, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil || response.Service.PersistedQueryList == nil {
+		return nil, errNotFound
+	}
+
+	list := response.Service.PersistedQueryList.PersistedQueryList
+
+	return &list, nil
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &PersistedQueryListLinkResource{}
+var _ resource.ResourceWithImportState = &PersistedQueryListLinkResource{}
+
+func NewPersistedQueryListLinkResource() resource.Resource {
+	return &PersistedQueryListLinkResource{}
+}
+
+type PersistedQueryListLinkResource struct {
+	client *graphql.Client
+}
+
+type PersistedQueryListLinkResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	GraphId              types.String `tfsdk:"graph_id"`
+	Variant              types.String `tfsdk:"variant"`
+	PersistedQueryListId types.String `tfsdk:"persisted_query_list_id"`
+}
+
+func (r *PersistedQueryListLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_persisted_query_list_link"
+}
+
+func (r *PersistedQueryListLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Link between a variant and the persisted query list it serves. A variant is linked to at most one list, so creating this resource replaces any existing link of the variant.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the link.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the variant belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"persisted_query_list_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the persisted query list linked to the variant.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *PersistedQueryListLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PersistedQueryListLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PersistedQueryListLinkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := setLinkedPersistedQueryList(ctx, *r.client, data.GraphId.ValueString(), data.Variant.ValueString(), data.PersistedQueryListId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create persisted query list link, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a persisted query list link")
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.GraphId.ValueString(), data.Variant.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedQueryListLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PersistedQueryListLinkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := readLinkedPersistedQueryList(ctx, *r.client, data.GraphId.ValueString(), data.Variant.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "persisted query list link not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "variant": data.Variant.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read persisted query list link, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.GraphId.ValueString(), data.Variant.ValueString()))
+	data.PersistedQueryListId = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedQueryListLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *PersistedQueryListLinkResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := setLinkedPersistedQueryList(ctx, *r.client, data.GraphId.ValueString(), data.Variant.ValueString(), data.PersistedQueryListId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update persisted query list link, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a persisted query list link")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedQueryListLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *PersistedQueryListLinkResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := removeLinkedPersistedQueryList(ctx, *r.client, data.GraphId.ValueString(), data.Variant.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete persisted query list link, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a persisted query list link")
+}
+
+func (r *PersistedQueryListLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: graph_id:variant. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variant"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), parts[0])...)
+}
+
+// readLinkedPersistedQueryList returns the identifier of the persisted query
+// list linked to the variant.
+func readLinkedPersistedQueryList(ctx context.Context, client graphql.Client, serviceId string, variantName string) (string, error) {
+	response, err := getVariantPersistedQueryList(ctx, client, serviceId, variantName)
+
+	if err != nil {
+		return "", err
+	}
+
+	if response.Service == nil || response.Service.Variant == nil || response.Service.Variant.PersistedQueryList == nil {
+		return "", errNotFound
+	}
+
+	return response.Service.Variant.PersistedQueryList.Id, nil
+}
+
+// setLinkedPersistedQueryList links the variant to the persisted query list. The API refuses to link
+// a variant which is already linked, so any existing link is removed first.
+func setLinkedPersistedQueryList(ctx context.Context, client graphql.Client, serviceId string, variantName string, id string) error {
+	current, err := readLinkedPersistedQueryList(ctx, client, serviceId, variantName)
+
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+
+	if current == id {
+		return nil
+	}
+
+	if current != "" {
+		if err := removeLinkedPersistedQueryList(ctx, client, serviceId, variantName); err != nil {
+			return err
+		}
+	}
+
+	response, err := linkPersistedQueryList(ctx, client, serviceId, variantName, id)
+
+	if err != nil {
+		return err
+	}
+
+	return resultError("linkPersistedQueryList", response.Service.Variant.LinkPersistedQueryList)
+}
+
+func removeLinkedPersistedQueryList(ctx context.Context, client graphql.Client, serviceId string, variantName string) error {
+	response, err := unlinkPersistedQueryList(ctx, client, serviceId, variantName)
+
+	if err != nil {
+		return err
+	}
+
+	result := response.Service.Variant.UnlinkPersistedQueryList
+
+	// The result is missing when the variant itself no longer exists.
+	if result == nil {
+		return errNotFound
+	}
+
+	if _, ok := result.(*unlinkPersistedQueryListServiceServiceMutationVariantGraphVariantMutationUnlinkPersistedQueryListVariantAlreadyUnlinkedError); ok {
+		return nil
+	}
+
+	return resultError("unlinkPersistedQueryList", result)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPersistedQueryListLinkResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPersistedQueryListLinkResourceConfig("web"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list_link.test", "id", "Test-w4a5n4:PQL"),
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list_link.test", "graph_id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list_link.test", "variant", "PQL"),
+					resource.TestCheckResourceAttrPair("apollographql_persisted_query_list_link.test", "persisted_query_list_id", "apollographql_persisted_query_list.web", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_persisted_query_list_link.test",
+				ImportState:       true,
+				ImportStateId:     "Test-w4a5n4:PQL",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccPersistedQueryListLinkResourceConfig("mobile"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list_link.test", "id", "Test-w4a5n4:PQL"),
+					resource.TestCheckResourceAttrPair("apollographql_persisted_query_list_link.test", "persisted_query_list_id", "apollographql_persisted_query_list.mobile", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPersistedQueryListLinkResourceConfig(list string) string {
+	return `
+resource "apollographql_variant" "test" {
+  name = "PQL"
+  graph_id = "Test-w4a5n4"
+}
+
+resource "apollographql_persisted_query_list" "web" {
+  graph_id = "Test-w4a5n4"
+  name = "Web"
+}
+
+resource "apollographql_persisted_query_list" "mobile" {
+  graph_id = "Test-w4a5n4"
+  name = "Mobile"
+}
+
+resource "apollographql_persisted_query_list_link" "test" {
+  graph_id = apollographql_variant.test.graph_id
+  variant = apollographql_variant.test.name
+  persisted_query_list_id = apollographql_persisted_query_list.` + list + `.id
+}
+`
+}
@@ -0,0 +1,433 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &PersistedQueryListOperationsResource{}
+var _ resource.ResourceWithImportState = &PersistedQueryListOperationsResource{}
+var _ resource.ResourceWithModifyPlan = &PersistedQueryListOperationsResource{}
+
+func NewPersistedQueryListOperationsResource() resource.Resource {
+	return &PersistedQueryListOperationsResource{}
+}
+
+type PersistedQueryListOperationsResource struct {
+	client *graphql.Client
+}
+
+type PersistedQueryListOperationsResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	GraphId              types.String `tfsdk:"graph_id"`
+	PersistedQueryListId types.String `tfsdk:"persisted_query_list_id"`
+	Manifest             types.String `tfsdk:"manifest"`
+	OperationIds         types.Set    `tfsdk:"operation_ids"`
+}
+
+// persistedQueryManifest is the manifest generated by Apollo's client tooling
+// such as `generate-persisted-query-manifest`.
+type persistedQueryManifest struct {
+	Operations []persistedQueryManifestOperation `json:"operations"`
+}
+
+type persistedQueryManifestOperation struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Body string `json:"body"`
+}
+
+func (r *PersistedQueryListOperationsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_persisted_query_list_operations"
+}
+
+func (r *PersistedQueryListOperationsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Operations of an Apollo GraphQL persisted query list, published from a persisted query manifest. Operations in the list which are not in the manifest are removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the operations.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the persisted query list belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"persisted_query_list_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the persisted query list.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"manifest": schema.StringAttribute{
+				MarkdownDescription: "Path to the persisted query manifest JSON file.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"operation_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the operations in the persisted query list.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *PersistedQueryListOperationsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan plans the operation IDs from the manifest, so that the operations
+// being added and removed show up in the plan.
+func (r *PersistedQueryListOperationsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var manifest types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("manifest"), &manifest)...)
+
+	if resp.Diagnostics.HasError() || manifest.IsUnknown() {
+		return
+	}
+
+	operations, err := readManifest(manifest.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid Manifest", fmt.Sprintf("Unable to read persisted query manifest, got error: %s", err))
+		return
+	}
+
+	ids := make([]string, 0, len(operations))
+
+	for _, operation := range operations {
+		ids = append(ids, operation.Id)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("operation_ids"), ids)...)
+}
+
+func (r *PersistedQueryListOperationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *PersistedQueryListOperationsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The list may already contain operations published outside of terraform.
+	current, err := readOperationIds(ctx, *r.client, data.GraphId.ValueString(), data.PersistedQueryListId.ValueString())
+
+	if err == nil {
+		err = publishManifest(ctx, *r.client, data, current)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create persisted query list operations, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created persisted query list operations")
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.GraphId.ValueString(), data.PersistedQueryListId.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedQueryListOperationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *PersistedQueryListOperationsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ids, err := readOperationIds(ctx, *r.client, data.GraphId.ValueString(), data.PersistedQueryListId.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "persisted query list not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "persisted_query_list_id": data.PersistedQueryListId.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read persisted query list operations, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.GraphId.ValueString(), data.PersistedQueryListId.ValueString()))
+	data.OperationIds = setFromStrings(ids)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedQueryListOperationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *PersistedQueryListOperationsResourceModel
+	var state *PersistedQueryListOperationsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current []string
+
+	resp.Diagnostics.Append(state.OperationIds.ElementsAs(ctx, &current, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := publishManifest(ctx, *r.client, data, current)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update persisted query list operations, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated persisted query list operations")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PersistedQueryListOperationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *PersistedQueryListOperationsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var ids []string
+
+	resp.Diagnostics.Append(data.OperationIds.ElementsAs(ctx, &ids, false)...)
+
+	if resp.Diagnostics.HasError() || len(ids) == 0 {
+		return
+	}
+
+	response, err := publishOperations(ctx, *r.client, data.GraphId.ValueString(), data.PersistedQueryListId.ValueString(), nil, ids)
+
+	if err == nil {
+		err = resultError("publishOperations", response.Service.PersistedQueryList.PublishOperations)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete persisted query list operations, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted persisted query list operations")
+}
+
+func (r *PersistedQueryListOperationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: graph_id:persisted_query_list_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("persisted_query_list_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), parts[0])...)
+}
+
+func readManifest(name string) ([]persistedQueryManifestOperation, error) {
+	content, err := os.ReadFile(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest persistedQueryManifest
+
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+
+	for _, operation := range manifest.Operations {
+		if operation.Id == "" {
+			return nil, fmt.Errorf("operation %q has no id", operation.Name)
+		}
+
+		if seen[operation.Id] {
+			return nil, fmt.Errorf("operation id %q is duplicated", operation.Id)
+		}
+
+		switch OperationType(strings.ToUpper(operation.Type)) {
+		case OperationTypeQuery, OperationTypeMutation, OperationTypeSubscription:
+		default:
+			return nil, fmt.Errorf("operation %q has unknown type %q", operation.Id, operation.Type)
+		}
+
+		seen[operation.Id] = true
+	}
+
+	return manifest.Operations, nil
+}
+
+// readOperationIds returns the identifiers of all operations in the persisted
+// query list.
+func readOperationIds(ctx context.Context, client graphql.Client, serviceId string, id string) ([]string, error) {
+	ids := []string{}
+
+	var after *string
+
+	for {
+		response, err := getPersistedQueryListOperations(ctx, client, serviceId, id, after)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if response.Service == nil || response.Service.PersistedQueryList == nil {
+			return nil, errNotFound
+		}
+
+		operations := response.Service.PersistedQueryList.Operations
+
+		for _, edge := range operations.Edges {
+			ids = append(ids, edge.Node.Id)
+		}
+
+		if !operations.PageInfo.HasNextPage || operations.PageInfo.EndCursor == nil {
+			break
+		}
+
+		after = operations.PageInfo.EndCursor
+	}
+
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+// publishManifest publishes the operations of the manifest which aren't in
+// the list yet and removes the ones which are no longer in the manifest.
+func publishManifest(ctx context.Context, client graphql.Client, data *PersistedQueryListOperationsResourceModel, current []string) error {
+	operations, err := readManifest(data.Manifest.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+
+	for _, id := range current {
+		existing[id] = true
+	}
+
+	desired := map[string]bool{}
+	ids := make([]string, 0, len(operations))
+	added := []PersistedQueryInput{}
+	removed := []string{}
+
+	for _, operation := range operations {
+		desired[operation.Id] = true
+		ids = append(ids, operation.Id)
+
+		if !existing[operation.Id] {
+			added = append(added, PersistedQueryInput{
+				Body: operation.Body,
+				Id:   operation.Id,
+				Name: operation.Name,
+				Type: OperationType(strings.ToUpper(operation.Type)),
+			})
+		}
+	}
+
+	for _, id := range current {
+		if !desired[id] {
+			removed = append(removed, id)
+		}
+	}
+
+	tflog.Debug(ctx, "publishing persisted query list operations", map[string]interface{}{"added": len(added), "removed": len(removed)})
+
+	if len(added) > 0 || len(removed) > 0 {
+		response, err := publishOperations(ctx, client, data.GraphId.ValueString(), data.PersistedQueryListId.ValueString(), added, removed)
+
+		if err == nil {
+			err = resultError("publishOperations", response.Service.PersistedQueryList.PublishOperations)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	data.OperationIds = setFromStrings(ids)
+
+	return nil
+}
+
+func setFromStrings(values []string) types.Set {
+	elements := []attr.Value{}
+
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPersistedQueryListOperationsResourceDefault(t *testing.T) {
+	manifest := filepath.Join(t.TempDir(), "manifest.json")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: testAccWriteManifest(t, manifest, "todos", "drafts"),
+				Config:    testAccPersistedQueryListOperationsResourceConfig(manifest),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_persisted_query_list_operations.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list_operations.test", "graph_id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list_operations.test", "manifest", manifest),
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list_operations.test", "operation_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("apollographql_persisted_query_list_operations.test", "operation_ids.*", "todos"),
+					resource.TestCheckTypeSetElemAttr("apollographql_persisted_query_list_operations.test", "operation_ids.*", "drafts"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "apollographql_persisted_query_list_operations.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccGraphImportStateId("apollographql_persisted_query_list.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"manifest"},
+			},
+			// Update and Read testing
+			{
+				PreConfig: testAccWriteManifest(t, manifest, "todos", "done"),
+				Config:    testAccPersistedQueryListOperationsResourceConfig(manifest),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list_operations.test", "operation_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("apollographql_persisted_query_list_operations.test", "operation_ids.*", "todos"),
+					resource.TestCheckTypeSetElemAttr("apollographql_persisted_query_list_operations.test", "operation_ids.*", "done"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestReadManifest(t *testing.T) {
+	dir := t.TempDir()
+
+	cases := []struct {
+		name     string
+		manifest string
+		err      string
+	}{
+		{
+			name:     "valid",
+			manifest: `{"format":"apollo-persisted-query-manifest","version":1,"operations":[{"id":"a","name":"A","type":"query","body":"query A { a }"},{"id":"b","name":"B","type":"mutation","body":"mutation B { b }"}]}`,
+		},
+		{
+			name:     "duplicate id",
+			manifest: `{"operations":[{"id":"a","name":"A","type":"query","body":"query A { a }"},{"id":"a","name":"B","type":"query","body":"query B { b }"}]}`,
+			err:      `operation id "a" is duplicated`,
+		},
+		{
+			name:     "missing id",
+			manifest: `{"operations":[{"name":"A","type":"query","body":"query A { a }"}]}`,
+			err:      `operation "A" has no id`,
+		},
+		{
+			name:     "unknown type",
+			manifest: `{"operations":[{"id":"a","name":"A","type":"fragment","body":"fragment A on Query { a }"}]}`,
+			err:      `operation "a" has unknown type "fragment"`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			name := filepath.Join(dir, "manifest.json")
+
+			if err := os.WriteFile(name, []byte(c.manifest), 0644); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := readManifest(name)
+
+			if c.err == "" && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if c.err != "" && (err == nil || err.Error() != c.err) {
+				t.Errorf("got error %v, want %q", err, c.err)
+			}
+		})
+	}
+}
+
+func testAccWriteManifest(t *testing.T, name string, operations ...string) func() {
+	return func() {
+		manifest := `{"format":"apollo-persisted-query-manifest","version":1,"operations":[`
+
+		for i, operation := range operations {
+			if i > 0 {
+				manifest += ","
+			}
+
+			manifest += fmt.Sprintf(`{"id":"%s","name":"%s","type":"query","body":"query %s { __typename }"}`, operation, operation, operation)
+		}
+
+		manifest += "]}"
+
+		if err := os.WriteFile(name, []byte(manifest), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccPersistedQueryListOperationsResourceConfig(manifest string) string {
+	return fmt.Sprintf(`
+resource "apollographql_persisted_query_list" "test" {
+  graph_id = "Test-w4a5n4"
+  name = "Operations"
+}
+
+resource "apollographql_persisted_query_list_operations" "test" {
+  graph_id = apollographql_persisted_query_list.test.graph_id
+  persisted_query_list_id = apollographql_persisted_query_list.test.id
+  manifest = "%s"
+}
+`, manifest)
+}
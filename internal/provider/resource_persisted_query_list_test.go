@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPersistedQueryListResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPersistedQueryListResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_persisted_query_list.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list.test", "graph_id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list.test", "name", "Web"),
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list.test", "description", ""),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_persisted_query_list.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccGraphImportStateId("apollographql_persisted_query_list.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccPersistedQueryListResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_persisted_query_list.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list.test", "name", "Web App"),
+					resource.TestCheckResourceAttr("apollographql_persisted_query_list.test", "description", "Operations used by the web app"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccPersistedQueryListResourceConfigDefault() string {
+	return `
+resource "apollographql_persisted_query_list" "test" {
+  graph_id = "Test-w4a5n4"
+  name = "Web"
+}
+`
+}
+
+func testAccPersistedQueryListResourceConfigNonDefault() string {
+	return `
+resource "apollographql_persisted_query_list" "test" {
+  graph_id = "Test-w4a5n4"
+  name = "Web App"
+  description = "Operations used by the web app"
+}
+`
+}
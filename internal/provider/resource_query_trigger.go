@@ -0,0 +1,397 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &QueryTriggerResource{}
+var _ resource.ResourceWithImportState = &QueryTriggerResource{}
+var _ resource.ResourceWithValidateConfig = &QueryTriggerResource{}
+
+func NewQueryTriggerResource() resource.Resource {
+	return &QueryTriggerResource{}
+}
+
+type QueryTriggerResource struct {
+	client *graphql.Client
+}
+
+type QueryTriggerResourceModel struct {
+	Id                     types.String  `tfsdk:"id"`
+	GraphId                types.String  `tfsdk:"graph_id"`
+	Variant                types.String  `tfsdk:"variant"`
+	Metric                 types.String  `tfsdk:"metric"`
+	ComparisonOperator     types.String  `tfsdk:"comparison_operator"`
+	Threshold              types.Float64 `tfsdk:"threshold"`
+	Percentile             types.Float64 `tfsdk:"percentile"`
+	Window                 types.String  `tfsdk:"window"`
+	Scope                  types.String  `tfsdk:"scope"`
+	OperationNames         types.Set     `tfsdk:"operation_names"`
+	ExcludedOperationNames types.Set     `tfsdk:"excluded_operation_names"`
+	ChannelIds             types.Set     `tfsdk:"channel_ids"`
+	Enabled                types.Bool    `tfsdk:"enabled"`
+}
+
+func (r *QueryTriggerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_query_trigger"
+}
+
+func (r *QueryTriggerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL performance alert, notifying channels when an operation metric crosses a threshold.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the query trigger.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the query trigger belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant whose metrics are watched. All variants are watched when not set.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"metric": schema.StringAttribute{
+				MarkdownDescription: "Metric which is compared against the threshold. One of `ERROR_COUNT`, `ERROR_PERCENTAGE`, `REQUEST_COUNT` or `REQUEST_SERVICE_TIME`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(QueryTriggerMetricErrorCount),
+						string(QueryTriggerMetricErrorPercentage),
+						string(QueryTriggerMetricRequestCount),
+						string(QueryTriggerMetricRequestServiceTime),
+					),
+				},
+			},
+			"comparison_operator": schema.StringAttribute{
+				MarkdownDescription: "Operator used to compare the metric against the threshold. One of `EQUALS`, `NOT_EQUALS`, `GREATER_THAN`, `GREATER_THAN_OR_EQUAL_TO`, `LESS_THAN` or `LESS_THAN_OR_EQUAL_TO`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(ComparisonOperatorEquals),
+						string(ComparisonOperatorNotEquals),
+						string(ComparisonOperatorGreaterThan),
+						string(ComparisonOperatorGreaterThanOrEqualTo),
+						string(ComparisonOperatorLessThan),
+						string(ComparisonOperatorLessThanOrEqualTo),
+					),
+				},
+			},
+			"threshold": schema.Float64Attribute{
+				MarkdownDescription: "Threshold the metric is compared against. Latencies are in milliseconds.",
+				Required:            true,
+			},
+			"percentile": schema.Float64Attribute{
+				MarkdownDescription: "Percentile of the request latency, required for the `REQUEST_SERVICE_TIME` metric.",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(0, 100),
+				},
+			},
+			"window": schema.StringAttribute{
+				MarkdownDescription: "Time window the metric is aggregated over. One of `ONE_MINUTE`, `FIVE_MINUTES` or `FIFTEEN_MINUTES`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(QueryTriggerWindowOneMinute),
+						string(QueryTriggerWindowFiveMinutes),
+						string(QueryTriggerWindowFifteenMinutes),
+					),
+				},
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Whether the metric is aggregated over `ALL` operations or triggers on `ANY` single operation. Defaults to `ALL`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(QueryTriggerScopeAll)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(QueryTriggerScopeAll),
+						string(QueryTriggerScopeAny),
+					),
+				},
+			},
+			"operation_names": schema.SetAttribute{
+				MarkdownDescription: "Names of the operations which are watched. All operations are watched when empty.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"excluded_operation_names": schema.SetAttribute{
+				MarkdownDescription: "Names of the operations which are not watched.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"channel_ids": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the channels the alerts are sent to.",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the query trigger is enabled. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *QueryTriggerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data *QueryTriggerResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Metric.ValueString() == string(QueryTriggerMetricRequestServiceTime) && data.Percentile.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("percentile"),
+			"Missing Attribute Configuration",
+			"Expected percentile to be configured for the REQUEST_SERVICE_TIME metric.",
+		)
+	}
+}
+
+func (r *QueryTriggerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *QueryTriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *QueryTriggerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trigger, err := upsertTrigger(ctx, *r.client, nil, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create query trigger, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a query trigger")
+
+	setQueryTrigger(data, trigger)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QueryTriggerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *QueryTriggerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trigger, err := readQueryTrigger(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "query trigger not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read query trigger, got error: %s", err))
+		return
+	}
+
+	setQueryTrigger(data, trigger)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QueryTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *QueryTriggerResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	trigger, err := upsertTrigger(ctx, *r.client, data.Id.ValueStringPointer(), data)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update query trigger, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a query trigger")
+
+	setQueryTrigger(data, trigger)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QueryTriggerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *QueryTriggerResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteQueryTrigger(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete query trigger, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a query trigger")
+}
+
+func (r *QueryTriggerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: graph_id:trigger_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), parts[0])...)
+}
+
+func readQueryTrigger(ctx context.Context, client graphql.Client, serviceId string, id string) (*QueryTrigger, error) {
+	response, err := getQueryTriggers(ctx, client, serviceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil {
+		return nil, errNotFound
+	}
+
+	for _, trigger := range response.Service.QueryTriggers {
+		if trigger.Id == id {
+			return &trigger.QueryTrigger, nil
+		}
+	}
+
+	return nil, errNotFound
+}
+
+func upsertTrigger(ctx context.Context, client graphql.Client, id *string, data *QueryTriggerResourceModel) (*QueryTrigger, error) {
+	trigger := QueryTriggerInput{
+		ChannelIds:             stringsFromSet(data.ChannelIds),
+		ComparisonOperator:     ComparisonOperator(data.ComparisonOperator.ValueString()),
+		Enabled:                data.Enabled.ValueBool(),
+		ExcludedOperationNames: stringsFromSet(data.ExcludedOperationNames),
+		Metric:                 QueryTriggerMetric(data.Metric.ValueString()),
+		OperationNames:         stringsFromSet(data.OperationNames),
+		Percentile:             data.Percentile.ValueFloat64Pointer(),
+		Scope:                  QueryTriggerScope(data.Scope.ValueString()),
+		Threshold:              data.Threshold.ValueFloat64(),
+		Variant:                data.Variant.ValueStringPointer(),
+		Window:                 QueryTriggerWindow(data.Window.ValueString()),
+	}
+
+	response, err := upsertQueryTrigger(ctx, client, data.GraphId.ValueString(), id, trigger)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service.UpsertQueryTrigger == nil {
+		return nil, fmt.Errorf("Unable to upsert query trigger for graph: %s", data.GraphId.ValueString())
+	}
+
+	return &response.Service.UpsertQueryTrigger.QueryTrigger, nil
+}
+
+func setQueryTrigger(data *QueryTriggerResourceModel, trigger *QueryTrigger) {
+	channelIds := []string{}
+
+	for _, channel := range trigger.Channels {
+		channelIds = append(channelIds, channel.GetId())
+	}
+
+	data.Id = types.StringValue(trigger.Id)
+	data.Variant = types.StringPointerValue(trigger.Variant)
+	data.Metric = types.StringValue(string(trigger.Metric))
+	data.ComparisonOperator = types.StringValue(string(trigger.ComparisonOperator))
+	data.Threshold = types.Float64Value(trigger.Threshold)
+	data.Percentile = types.Float64PointerValue(trigger.Percentile)
+	data.Window = types.StringValue(string(trigger.Window))
+	data.Scope = types.StringValue(string(trigger.Scope))
+	data.OperationNames = setFromStrings(trigger.OperationNames)
+	data.ExcludedOperationNames = setFromStrings(trigger.ExcludedOperationNames)
+	data.ChannelIds = setFromStrings(channelIds)
+	data.Enabled = types.BoolValue(trigger.Enabled)
+}
+
+func stringsFromSet(set types.Set) []string {
+	values := []string{}
+
+	for _, value := range set.Elements() {
+		values = append(values, value.(types.String).ValueString())
+	}
+
+	return values
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccQueryTriggerResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccQueryTriggerResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_query_trigger.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "graph_id", "Test-w4a5n4"),
+					resource.TestCheckNoResourceAttr("apollographql_query_trigger.test", "variant"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "metric", "ERROR_PERCENTAGE"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "comparison_operator", "GREATER_THAN"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "threshold", "5"),
+					resource.TestCheckNoResourceAttr("apollographql_query_trigger.test", "percentile"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "window", "FIVE_MINUTES"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "scope", "ALL"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "operation_names.#", "0"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "excluded_operation_names.#", "0"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "channel_ids.#", "1"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_query_trigger.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccGraphImportStateId("apollographql_query_trigger.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccQueryTriggerResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_query_trigger.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "variant", "current"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "metric", "REQUEST_SERVICE_TIME"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "comparison_operator", "GREATER_THAN_OR_EQUAL_TO"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "threshold", "500"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "percentile", "95"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "window", "FIFTEEN_MINUTES"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "scope", "ANY"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "operation_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr("apollographql_query_trigger.test", "operation_names.*", "GetTodos"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "excluded_operation_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr("apollographql_query_trigger.test", "excluded_operation_names.*", "IntrospectionQuery"),
+					resource.TestCheckResourceAttr("apollographql_query_trigger.test", "enabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccQueryTriggerResourceMissingPercentile(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "apollographql_query_trigger" "test" {
+  graph_id = "Test-w4a5n4"
+  metric = "REQUEST_SERVICE_TIME"
+  comparison_operator = "GREATER_THAN"
+  threshold = 500
+  window = "FIVE_MINUTES"
+  channel_ids = []
+}
+`,
+				ExpectError: regexp.MustCompile("Expected percentile to be configured"),
+			},
+		},
+	})
+}
+
+func testAccQueryTriggerResourceConfigBase() string {
+	return `
+resource "apollographql_webhook_channel" "test" {
+  graph_id = "Test-w4a5n4"
+  name = "Alerts"
+  url = "https://example.com/hooks/alerts"
+}
+`
+}
+
+func testAccQueryTriggerResourceConfigDefault() string {
+	return testAccQueryTriggerResourceConfigBase() + `
+resource "apollographql_query_trigger" "test" {
+  graph_id = "Test-w4a5n4"
+  metric = "ERROR_PERCENTAGE"
+  comparison_operator = "GREATER_THAN"
+  threshold = 5
+  window = "FIVE_MINUTES"
+  channel_ids = [apollographql_webhook_channel.test.id]
+}
+`
+}
+
+func testAccQueryTriggerResourceConfigNonDefault() string {
+	return testAccQueryTriggerResourceConfigBase() + `
+resource "apollographql_query_trigger" "test" {
+  graph_id = "Test-w4a5n4"
+  variant = "current"
+  metric = "REQUEST_SERVICE_TIME"
+  comparison_operator = "GREATER_THAN_OR_EQUAL_TO"
+  threshold = 500
+  percentile = 95
+  window = "FIFTEEN_MINUTES"
+  scope = "ANY"
+  operation_names = ["GetTodos"]
+  excluded_operation_names = ["IntrospectionQuery"]
+  channel_ids = [apollographql_webhook_channel.test.id]
+  enabled = false
+}
+`
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &RegistrySubscriptionResource{}
+var _ resource.ResourceWithImportState = &RegistrySubscriptionResource{}
+
+func NewRegistrySubscriptionResource() resource.Resource {
+	return &RegistrySubscriptionResource{}
+}
+
+type RegistrySubscriptionResource struct {
+	client *graphql.Client
+}
+
+type RegistrySubscriptionResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	GraphId       types.String `tfsdk:"graph_id"`
+	Variant       types.String `tfsdk:"variant"`
+	ChannelId     types.String `tfsdk:"channel_id"`
+	SchemaUpdates types.Bool   `tfsdk:"schema_updates"`
+}
+
+func (r *RegistrySubscriptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_registry_subscription"
+}
+
+func (r *RegistrySubscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL subscription of a notification channel to the schema changes of a variant.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the subscription.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the subscription belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant whose schema changes are notified.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the channel the notifications are sent to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"schema_updates": schema.BoolAttribute{
+				MarkdownDescription: "Whether to notify schema updates. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *RegistrySubscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RegistrySubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *RegistrySubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := upsertRegistrySubscription(ctx, *r.client, data.GraphId.ValueString(), nil, data.ChannelId.ValueString(), data.Variant.ValueString(), SubscriptionOptionsInput{SchemaUpdates: data.SchemaUpdates.ValueBool()})
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create registry subscription, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a registry subscription")
+
+	subscription := response.Service.UpsertRegistrySubscription.RegistrySubscription
+
+	data.Id = types.StringValue(subscription.Id)
+	data.SchemaUpdates = types.BoolValue(subscription.Options.SchemaUpdates)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistrySubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *RegistrySubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscription, err := readRegistrySubscription(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "registry subscription not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read registry subscription, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(subscription.Id)
+	data.Variant = types.StringPointerValue(subscription.Variant)
+	data.SchemaUpdates = types.BoolValue(subscription.Options.SchemaUpdates)
+
+	if subscription.Channel != nil {
+		data.ChannelId = types.StringValue(subscription.Channel.GetId())
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistrySubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *RegistrySubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := upsertRegistrySubscription(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueStringPointer(), data.ChannelId.ValueString(), data.Variant.ValueString(), SubscriptionOptionsInput{SchemaUpdates: data.SchemaUpdates.ValueBool()})
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update registry subscription, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a registry subscription")
+
+	subscription := response.Service.UpsertRegistrySubscription.RegistrySubscription
+
+	data.SchemaUpdates = types.BoolValue(subscription.Options.SchemaUpdates)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistrySubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *RegistrySubscriptionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteRegistrySubscription(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete registry subscription, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a registry subscription")
+}
+
+func (r *RegistrySubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: graph_id:subscription_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), parts[0])...)
+}
+
+// readRegistrySubscription looks the subscription up through the channels of
+// the graph, since subscriptions can't be queried by themselves.
+func readRegistrySubscription(ctx context.Context, client graphql.Client, serviceId string, id string) (*RegistrySubscription, error) {
+	response, err := getRegistrySubscriptions(ctx, client, serviceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil {
+		return nil, errNotFound
+	}
+
+	for _, channel := range response.Service.Channels {
+		for _, item := range channel.GetSubscriptions() {
+			if subscription, ok := item.(*getRegistrySubscriptionsServiceChannelsChannelSubscriptionsRegistrySubscription); ok && subscription.Id == id {
+				return &subscription.RegistrySubscription, nil
+			}
+		}
+	}
+
+	return nil, errNotFound
+}
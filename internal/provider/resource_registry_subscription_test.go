@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRegistrySubscriptionResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRegistrySubscriptionResourceConfigDefault("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_registry_subscription.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_registry_subscription.test", "graph_id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_registry_subscription.test", "variant", "Notified"),
+					resource.TestCheckResourceAttrPair("apollographql_registry_subscription.test", "channel_id", "apollographql_webhook_channel.first", "id"),
+					resource.TestCheckResourceAttr("apollographql_registry_subscription.test", "schema_updates", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_registry_subscription.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccGraphImportStateId("apollographql_registry_subscription.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRegistrySubscriptionResourceConfigNonDefault("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_registry_subscription.test", "id"),
+					resource.TestCheckResourceAttrPair("apollographql_registry_subscription.test", "channel_id", "apollographql_webhook_channel.second", "id"),
+					resource.TestCheckResourceAttr("apollographql_registry_subscription.test", "schema_updates", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRegistrySubscriptionResourceConfigBase() string {
+	return `
+resource "apollographql_variant" "test" {
+  name = "Notified"
+  graph_id = "Test-w4a5n4"
+}
+
+resource "apollographql_webhook_channel" "first" {
+  graph_id = "Test-w4a5n4"
+  name = "First"
+  url = "https://example.com/hooks/first"
+}
+
+resource "apollographql_webhook_channel" "second" {
+  graph_id = "Test-w4a5n4"
+  name = "Second"
+  url = "https://example.com/hooks/second"
+}
+`
+}
+
+func testAccRegistrySubscriptionResourceConfigDefault(channel string) string {
+	return testAccRegistrySubscriptionResourceConfigBase() + fmt.Sprintf(`
+resource "apollographql_registry_subscription" "test" {
+  graph_id = apollographql_variant.test.graph_id
+  variant = apollographql_variant.test.name
+  channel_id = apollographql_webhook_channel.%s.id
+}
+`, channel)
+}
+
+func testAccRegistrySubscriptionResourceConfigNonDefault(channel string) string {
+	return testAccRegistrySubscriptionResourceConfigBase() + fmt.Sprintf(`
+resource "apollographql_registry_subscription" "test" {
+  graph_id = apollographql_variant.test.graph_id
+  variant = apollographql_variant.test.name
+  channel_id = apollographql_webhook_channel.%s.id
+  schema_updates = false
+}
+`, channel)
+}
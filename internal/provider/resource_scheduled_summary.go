@@ -0,0 +1,300 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	// Embed the IANA time zone database so that time zones can be validated
+	// on machines without one.
+	_ "time/tzdata"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ScheduledSummaryResource{}
+var _ resource.ResourceWithImportState = &ScheduledSummaryResource{}
+
+func NewScheduledSummaryResource() resource.Resource {
+	return &ScheduledSummaryResource{}
+}
+
+type ScheduledSummaryResource struct {
+	client *graphql.Client
+}
+
+type ScheduledSummaryResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	GraphId   types.String `tfsdk:"graph_id"`
+	Variant   types.String `tfsdk:"variant"`
+	ChannelId types.String `tfsdk:"channel_id"`
+	Timezone  types.String `tfsdk:"timezone"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *ScheduledSummaryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scheduled_summary"
+}
+
+func (r *ScheduledSummaryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL scheduled summary of the usage of a variant, sent daily and weekly to a notification channel.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the scheduled summary.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the scheduled summary belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant which is summarized.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the channel the summary is sent to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "IANA time zone the summary is scheduled in, e.g. `America/New_York`.",
+				Required:            true,
+				Validators: []validator.String{
+					timezoneValidator{},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the summary is sent. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *ScheduledSummaryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ScheduledSummaryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *ScheduledSummaryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := upsertScheduledSummary(ctx, *r.client, data.GraphId.ValueString(), nil, data.ChannelId.ValueString(), data.Variant.ValueString(), data.Timezone.ValueString(), data.Enabled.ValueBool())
+
+	if err == nil && response.Service.UpsertScheduledSummary == nil {
+		err = fmt.Errorf("Unable to create scheduled summary for variant: %s", data.Variant.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create scheduled summary, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a scheduled summary")
+
+	setScheduledSummary(data, &response.Service.UpsertScheduledSummary.ScheduledSummary)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScheduledSummaryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *ScheduledSummaryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	summary, err := readScheduledSummary(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "scheduled summary not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read scheduled summary, got error: %s", err))
+		return
+	}
+
+	setScheduledSummary(data, summary)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScheduledSummaryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *ScheduledSummaryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := upsertScheduledSummary(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueStringPointer(), data.ChannelId.ValueString(), data.Variant.ValueString(), data.Timezone.ValueString(), data.Enabled.ValueBool())
+
+	if err == nil && response.Service.UpsertScheduledSummary == nil {
+		err = fmt.Errorf("Unable to update scheduled summary: %s", data.Id.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update scheduled summary, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a scheduled summary")
+
+	setScheduledSummary(data, &response.Service.UpsertScheduledSummary.ScheduledSummary)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScheduledSummaryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *ScheduledSummaryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteScheduledSummary(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete scheduled summary, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a scheduled summary")
+}
+
+func (r *ScheduledSummaryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: graph_id:summary_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), parts[0])...)
+}
+
+func readScheduledSummary(ctx context.Context, client graphql.Client, serviceId string, id string) (*ScheduledSummary, error) {
+	response, err := getScheduledSummaries(ctx, client, serviceId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil {
+		return nil, errNotFound
+	}
+
+	for _, summary := range response.Service.ScheduledSummaries {
+		if summary.Id == id {
+			return &summary.ScheduledSummary, nil
+		}
+	}
+
+	return nil, errNotFound
+}
+
+func setScheduledSummary(data *ScheduledSummaryResourceModel, summary *ScheduledSummary) {
+	data.Id = types.StringValue(summary.Id)
+	data.Variant = types.StringValue(summary.Variant)
+	data.Timezone = types.StringValue(summary.Timezone)
+	data.Enabled = types.BoolValue(summary.Enabled)
+
+	if len(summary.Channels) > 0 {
+		data.ChannelId = types.StringValue(summary.Channels[0].GetId())
+	}
+}
+
+// timezoneValidator validates that a string is a time zone name from the IANA
+// time zone database.
+type timezoneValidator struct{}
+
+func (v timezoneValidator) Description(ctx context.Context) string {
+	return "value must be an IANA time zone name"
+}
+
+func (v timezoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timezoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	// LoadLocation also accepts these, but they don't name a time zone.
+	if value == "" || value == "Local" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Time Zone", fmt.Sprintf("Expected an IANA time zone name, got: %q", value))
+		return
+	}
+
+	if _, err := time.LoadLocation(value); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Time Zone", fmt.Sprintf("Expected an IANA time zone name, got: %q", value))
+	}
+}
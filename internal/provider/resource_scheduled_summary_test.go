@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScheduledSummaryResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccScheduledSummaryResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_scheduled_summary.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_scheduled_summary.test", "graph_id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_scheduled_summary.test", "variant", "current"),
+					resource.TestCheckResourceAttrPair("apollographql_scheduled_summary.test", "channel_id", "apollographql_webhook_channel.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_scheduled_summary.test", "timezone", "America/New_York"),
+					resource.TestCheckResourceAttr("apollographql_scheduled_summary.test", "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_scheduled_summary.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccGraphImportStateId("apollographql_scheduled_summary.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccScheduledSummaryResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_scheduled_summary.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_scheduled_summary.test", "timezone", "Europe/Berlin"),
+					resource.TestCheckResourceAttr("apollographql_scheduled_summary.test", "enabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccScheduledSummaryResourceInvalidTimezone(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "apollographql_scheduled_summary" "test" {
+  graph_id = "Test-w4a5n4"
+  variant = "current"
+  channel_id = "channel"
+  timezone = "Mars/Olympus_Mons"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Time Zone"),
+			},
+		},
+	})
+}
+
+func TestTimezoneValidator(t *testing.T) {
+	cases := []struct {
+		value string
+		valid bool
+	}{
+		{value: "UTC", valid: true},
+		{value: "America/New_York", valid: true},
+		{value: "Asia/Kolkata", valid: true},
+		{value: "", valid: false},
+		{value: "Local", valid: false},
+		{value: "EST5", valid: false},
+		{value: "Mars/Olympus_Mons", valid: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("timezone"), ConfigValue: types.StringValue(c.value)}
+			resp := validator.StringResponse{}
+
+			timezoneValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() == c.valid {
+				t.Errorf("got valid %t, want %t", !resp.Diagnostics.HasError(), c.valid)
+			}
+		})
+	}
+}
+
+func testAccScheduledSummaryResourceConfigBase() string {
+	return `
+resource "apollographql_webhook_channel" "test" {
+  graph_id = "Test-w4a5n4"
+  name = "Summaries"
+  url = "https://example.com/hooks/summaries"
+}
+`
+}
+
+func testAccScheduledSummaryResourceConfigDefault() string {
+	return testAccScheduledSummaryResourceConfigBase() + `
+resource "apollographql_scheduled_summary" "test" {
+  graph_id = "Test-w4a5n4"
+  variant = "current"
+  channel_id = apollographql_webhook_channel.test.id
+  timezone = "America/New_York"
+}
+`
+}
+
+func testAccScheduledSummaryResourceConfigNonDefault() string {
+	return testAccScheduledSummaryResourceConfigBase() + `
+resource "apollographql_scheduled_summary" "test" {
+  graph_id = "Test-w4a5n4"
+  variant = "current"
+  channel_id = apollographql_webhook_channel.test.id
+  timezone = "Europe/Berlin"
+  enabled = false
+}
+`
+}
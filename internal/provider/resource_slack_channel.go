@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &SlackChannelResource{}
+var _ resource.ResourceWithImportState = &SlackChannelResource{}
+
+func NewSlackChannelResource() resource.Resource {
+	return &SlackChannelResource{}
+}
+
+type SlackChannelResource struct {
+	client *graphql.Client
+}
+
+type SlackChannelResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	GraphId types.String `tfsdk:"graph_id"`
+	Name    types.String `tfsdk:"name"`
+	Url     types.String `tfsdk:"url"`
+}
+
+func (r *SlackChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_slack_channel"
+}
+
+func (r *SlackChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL Slack notification channel.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the channel belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the channel.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Slack incoming webhook URL the notifications are sent to.",
+				Required:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *SlackChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SlackChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SlackChannelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := upsertSlackChannel(ctx, *r.client, data.GraphId.ValueString(), nil, SlackChannelInput{Name: data.Name.ValueString(), Url: data.Url.ValueString()})
+
+	if err == nil && response.Service.UpsertSlackChannel == nil {
+		err = fmt.Errorf("Unable to create Slack channel for graph: %s", data.GraphId.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create Slack channel, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a Slack channel")
+
+	channel := response.Service.UpsertSlackChannel.SlackChannel
+
+	data.Id = types.StringValue(channel.Id)
+	data.Name = types.StringValue(channel.Name)
+	data.Url = types.StringValue(channel.Url)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SlackChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SlackChannelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := readSlackChannel(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if removeMissingChannel(ctx, resp, err, "Slack", data.GraphId.ValueString(), data.Id.ValueString()) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read Slack channel, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(channel.Id)
+	data.Name = types.StringValue(channel.Name)
+	data.Url = types.StringValue(channel.Url)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SlackChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SlackChannelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := upsertSlackChannel(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueStringPointer(), SlackChannelInput{Name: data.Name.ValueString(), Url: data.Url.ValueString()})
+
+	if err == nil && response.Service.UpsertSlackChannel == nil {
+		err = fmt.Errorf("Unable to update Slack channel: %s", data.Id.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update Slack channel, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a Slack channel")
+
+	channel := response.Service.UpsertSlackChannel.SlackChannel
+
+	data.Name = types.StringValue(channel.Name)
+	data.Url = types.StringValue(channel.Url)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SlackChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SlackChannelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteChannel(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete Slack channel, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a Slack channel")
+}
+
+func (r *SlackChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importChannelState(ctx, req, resp)
+}
+
+func readSlackChannel(ctx context.Context, client graphql.Client, serviceId string, id string) (*SlackChannel, error) {
+	response, err := getSlackChannel(ctx, client, serviceId, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil {
+		return nil, errNotFound
+	}
+
+	for _, item := range response.Service.Channels {
+		if channel, ok := item.(*getSlackChannelServiceChannelsSlackChannel); ok && channel.Id == id {
+			return &channel.SlackChannel, nil
+		}
+	}
+
+	return nil, errNotFound
+}
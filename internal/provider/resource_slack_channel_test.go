@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSlackChannelResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSlackChannelResourceConfig("Schema Changes", "https://hooks.slack.com/services/T000/B000/XXXX"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_slack_channel.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_slack_channel.test", "graph_id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_slack_channel.test", "name", "Schema Changes"),
+					resource.TestCheckResourceAttr("apollographql_slack_channel.test", "url", "https://hooks.slack.com/services/T000/B000/XXXX"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_slack_channel.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccGraphImportStateId("apollographql_slack_channel.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSlackChannelResourceConfig("Schema Notifications", "https://hooks.slack.com/services/T000/B000/YYYY"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_slack_channel.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_slack_channel.test", "name", "Schema Notifications"),
+					resource.TestCheckResourceAttr("apollographql_slack_channel.test", "url", "https://hooks.slack.com/services/T000/B000/YYYY"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSlackChannelResourceConfig(name string, url string) string {
+	return fmt.Sprintf(`
+resource "apollographql_slack_channel" "test" {
+  graph_id = "Test-w4a5n4"
+  name = "%s"
+  url = "%s"
+}
+`, name, url)
+}
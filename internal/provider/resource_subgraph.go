@@ -0,0 +1,328 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &SubgraphResource{}
+var _ resource.ResourceWithImportState = &SubgraphResource{}
+
+func NewSubgraphResource() resource.Resource {
+	return &SubgraphResource{}
+}
+
+type SubgraphResource struct {
+	client *graphql.Client
+}
+
+type SubgraphResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	GraphId              types.String `tfsdk:"graph_id"`
+	Variant              types.String `tfsdk:"variant"`
+	Name                 types.String `tfsdk:"name"`
+	Url                  types.String `tfsdk:"url"`
+	Revision             types.String `tfsdk:"revision"`
+	Schema               types.String `tfsdk:"schema"`
+	CompositionErrors    types.List   `tfsdk:"composition_errors"`
+	LaunchId             types.String `tfsdk:"launch_id"`
+	SupergraphSchemaHash types.String `tfsdk:"supergraph_schema_hash"`
+}
+
+func (r *SubgraphResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subgraph"
+}
+
+func (r *SubgraphResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL subgraph published to a variant of a supergraph.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the subgraph.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the subgraph belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant the subgraph is published to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the subgraph.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "Routing URL of the subgraph.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"revision": schema.StringAttribute{
+				MarkdownDescription: "Revision of the subgraph, typically a Git SHA or docker image ID.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"schema": schema.StringAttribute{
+				MarkdownDescription: "Schema of the subgraph in SDL.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"composition_errors": schema.ListAttribute{
+				MarkdownDescription: "Errors from composing the supergraph after the last publish.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"launch_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the launch started by the last publish.",
+				Computed:            true,
+			},
+			"supergraph_schema_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA256 hash of the schema composed by the last publish.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *SubgraphResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SubgraphResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *SubgraphResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := publish(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create subgraph, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a subgraph")
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", data.GraphId.ValueString(), data.Variant.ValueString(), data.Name.ValueString()))
+
+	resp.Diagnostics.Append(compositionWarnings(data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *SubgraphResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subgraph, err := readSubgraph(ctx, *r.client, data.GraphId.ValueString(), data.Variant.ValueString(), data.Name.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "subgraph not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "variant": data.Variant.ValueString(), "name": data.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read subgraph, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s:%s", subgraph.GraphID, subgraph.GraphVariant, subgraph.Name))
+	data.GraphId = types.StringValue(subgraph.GraphID)
+	data.Variant = types.StringValue(subgraph.GraphVariant)
+	data.Name = types.StringValue(subgraph.Name)
+	data.Url = types.StringPointerValue(subgraph.Url)
+	data.Revision = types.StringValue(subgraph.Revision)
+	data.Schema = types.StringValue(subgraph.ActivePartialSchema.Sdl)
+
+	// The composition result is only returned when publishing, so imported
+	// subgraphs start without one.
+	if data.CompositionErrors.IsNull() {
+		data.CompositionErrors = types.ListValueMust(types.StringType, []attr.Value{})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *SubgraphResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := publish(ctx, *r.client, data)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update subgraph, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a subgraph")
+
+	resp.Diagnostics.Append(compositionWarnings(data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SubgraphResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *SubgraphResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteSubgraph(ctx, *r.client, data.GraphId.ValueString(), data.Variant.ValueString(), data.Name.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete subgraph, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a subgraph")
+}
+
+func (r *SubgraphResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: graph_id:variant:name. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variant"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), parts[0])...)
+}
+
+func readSubgraph(ctx context.Context, client graphql.Client, serviceId string, variantName string, name string) (*Subgraph, error) {
+	response, err := getSubgraph(ctx, client, serviceId, variantName, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil || response.Service.Variant == nil || response.Service.Variant.Subgraph == nil {
+		return nil, errNotFound
+	}
+
+	subgraph := response.Service.Variant.Subgraph.Subgraph
+
+	return &subgraph, nil
+}
+
+func publish(ctx context.Context, client graphql.Client, data *SubgraphResourceModel) error {
+	response, err := publishSubgraph(ctx, client, data.GraphId.ValueString(), data.Variant.ValueString(), data.Name.ValueString(), data.Url.ValueString(), data.Revision.ValueString(), data.Schema.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	if response.Service.PublishSubgraph == nil {
+		return fmt.Errorf("Unable to publish subgraph to variant: %s", data.Variant.ValueString())
+	}
+
+	composition := response.Service.PublishSubgraph.SubgraphComposition
+
+	messages := []attr.Value{}
+
+	for _, e := range composition.Errors {
+		messages = append(messages, types.StringValue(e.Message))
+	}
+
+	data.CompositionErrors = types.ListValueMust(types.StringType, messages)
+	data.LaunchId = types.StringNull()
+	data.SupergraphSchemaHash = types.StringNull()
+
+	if composition.Launch != nil {
+		data.LaunchId = types.StringValue(composition.Launch.Id)
+	}
+
+	if composition.CompositionConfig != nil {
+		data.SupergraphSchemaHash = types.StringValue(composition.CompositionConfig.SchemaHash)
+	}
+
+	return nil
+}
+
+func compositionWarnings(data *SubgraphResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, e := range data.CompositionErrors.Elements() {
+		diags.AddAttributeWarning(path.Root("schema"), "Composition Error", fmt.Sprintf("Subgraph %s was published but the supergraph failed to compose: %s", data.Name.ValueString(), e.(types.String).ValueString()))
+	}
+
+	return diags
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSubgraphResourceDefault(t *testing.T) {
+	id := fmt.Sprintf("todo-supergraph-%s", uuid.New().String())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSubgraphResourceConfigDefault(id, "1", "type Query { todos: [String] }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_subgraph.test", "id", fmt.Sprintf("%s:current:todos", id)),
+					resource.TestCheckResourceAttr("apollographql_subgraph.test", "graph_id", id),
+					resource.TestCheckResourceAttr("apollographql_subgraph.test", "variant", "current"),
+					resource.TestCheckResourceAttr("apollographql_subgraph.test", "name", "todos"),
+					resource.TestCheckResourceAttr("apollographql_subgraph.test", "url", "https://todos.example.com"),
+					resource.TestCheckResourceAttr("apollographql_subgraph.test", "revision", "1"),
+					resource.TestCheckResourceAttr("apollographql_subgraph.test", "schema", "type Query { todos: [String] }"),
+					resource.TestCheckResourceAttr("apollographql_subgraph.test", "composition_errors.#", "0"),
+					resource.TestCheckResourceAttrSet("apollographql_subgraph.test", "launch_id"),
+					resource.TestCheckResourceAttrSet("apollographql_subgraph.test", "supergraph_schema_hash"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "apollographql_subgraph.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s:current:todos", id),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"launch_id", "supergraph_schema_hash"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccSubgraphResourceConfigDefault(id, "2", "type Query { todos: [String] done: [String] }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_subgraph.test", "id", fmt.Sprintf("%s:current:todos", id)),
+					resource.TestCheckResourceAttr("apollographql_subgraph.test", "revision", "2"),
+					resource.TestCheckResourceAttr("apollographql_subgraph.test", "schema", "type Query { todos: [String] done: [String] }"),
+					resource.TestCheckResourceAttr("apollographql_subgraph.test", "composition_errors.#", "0"),
+					resource.TestCheckResourceAttrSet("apollographql_subgraph.test", "launch_id"),
+					resource.TestCheckResourceAttrSet("apollographql_subgraph.test", "supergraph_schema_hash"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSubgraphResourceConfigDefault(id string, revision string, schema string) string {
+	return fmt.Sprintf(`
+resource "apollographql_graph" "test" {
+  id = "%s"
+  title = "Todo Supergraph"
+  onboarding_architecture = "SUPERGRAPH"
+
+  organization_id = "pksunkara"
+}
+
+resource "apollographql_subgraph" "test" {
+  graph_id = apollographql_graph.test.id
+  variant = "current"
+  name = "todos"
+  url = "https://todos.example.com"
+  revision = "%s"
+  schema = "%s"
+}
+`, id, revision, schema)
+}
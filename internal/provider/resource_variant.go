@@ -7,11 +7,14 @@ import (
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -30,11 +33,27 @@ type VariantResource struct {
 }
 
 type VariantResourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Name    types.String `tfsdk:"name"`
-	Public  types.Bool   `tfsdk:"public"`
-	Url     types.String `tfsdk:"url"`
-	GraphId types.String `tfsdk:"graph_id"`
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Public           types.Bool   `tfsdk:"public"`
+	PubliclyListed   types.Bool   `tfsdk:"publicly_listed"`
+	IsProtected      types.Bool   `tfsdk:"is_protected"`
+	Url              types.String `tfsdk:"url"`
+	SubscriptionUrl  types.String `tfsdk:"subscription_url"`
+	SendCookies      types.Bool   `tfsdk:"send_cookies"`
+	PreflightScript  types.String `tfsdk:"preflight_script"`
+	PostflightScript types.String `tfsdk:"postflight_script"`
+	SharedHeaders    types.String `tfsdk:"shared_headers"`
+	Readme           types.String `tfsdk:"readme"`
+	Links            types.Set    `tfsdk:"links"`
+	GraphId          types.String `tfsdk:"graph_id"`
+}
+
+// variantLinkAttrTypes are the attribute types of a variant link.
+var variantLinkAttrTypes = map[string]attr.Type{
+	"title": types.StringType,
+	"type":  types.StringType,
+	"url":   types.StringType,
 }
 
 func (r *VariantResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -68,10 +87,85 @@ func (r *VariantResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"publicly_listed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the variant is listed in the public variants directory. Requires the variant to be public.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_protected": schema.BoolAttribute{
+				MarkdownDescription: "Whether the variant is protected.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"url": schema.StringAttribute{
 				MarkdownDescription: "URL of the variant.",
 				Optional:            true,
 			},
+			"subscription_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the variant for subscription operations.",
+				Optional:            true,
+			},
+			"send_cookies": schema.BoolAttribute{
+				MarkdownDescription: "Whether Explorer sends cookies to the variant.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"preflight_script": schema.StringAttribute{
+				MarkdownDescription: "Script Explorer runs before each operation.",
+				Optional:            true,
+			},
+			"postflight_script": schema.StringAttribute{
+				MarkdownDescription: "Script Explorer runs after each operation.",
+				Optional:            true,
+			},
+			"shared_headers": schema.StringAttribute{
+				MarkdownDescription: "Headers Explorer sends with each operation.",
+				Optional:            true,
+			},
+			"readme": schema.StringAttribute{
+				MarkdownDescription: "README of the variant, in Markdown. Defaults to empty.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"links": schema.SetNestedAttribute{
+				MarkdownDescription: "Links shown on the variant in Studio. Links which aren't configured are removed. Defaults to none.",
+				Optional:            true,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Title of the link.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the link, one of `DEVELOPER_PORTAL`, `REPOSITORY` or `OTHER`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(LinkInfoTypeDeveloperPortal),
+									string(LinkInfoTypeRepository),
+									string(LinkInfoTypeOther),
+								),
+							},
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL of the link.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
+						},
+					},
+				},
+				Default: setdefault.StaticValue(types.SetValueMust(types.ObjectType{AttrTypes: variantLinkAttrTypes}, []attr.Value{})),
+			},
 			"graph_id": schema.StringAttribute{
 				MarkdownDescription: "Identifier of the graph the variant belongs to.",
 				Required:            true,
@@ -118,7 +212,7 @@ func (r *VariantResource) Create(ctx context.Context, req resource.CreateRequest
 	_, err := createVariant(ctx, *r.client, data.GraphId.ValueString(), data.Name.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create variant, got error: %s", err))
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create variant, got error: %s", err))
 		return
 	}
 
@@ -127,30 +221,21 @@ func (r *VariantResource) Create(ctx context.Context, req resource.CreateRequest
 	variant, err := readVariant(ctx, *r.client, data.GraphId.ValueString(), data.Name.ValueString())
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variant, got error: %s", err))
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read variant, got error: %s", err))
 		return
 	}
 
-	if data.Public.ValueBool() {
-		err := updatePublic(ctx, *r.client, data)
-
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update variant, got error: %s", err))
-			return
-		}
-
-		tflog.Trace(ctx, "updated a variant")
-	}
+	// Only the settings which differ from those of the created variant are
+	// sent.
+	current := &VariantResourceModel{}
 
-	if !data.Url.IsNull() && !data.Url.IsUnknown() {
-		err := updateUrl(ctx, *r.client, data)
+	setVariantSettings(current, variant)
 
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update variant, got error: %s", err))
-			return
-		}
+	err = updateVariantSettings(ctx, *r.client, data, current)
 
-		tflog.Trace(ctx, "updated a variant")
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update variant, got error: %s", err))
+		return
 	}
 
 	data.Id = types.StringValue(variant.Id)
@@ -171,17 +256,23 @@ func (r *VariantResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	variant, err := readVariant(ctx, *r.client, data.GraphId.ValueString(), data.Name.ValueString())
 
+	if isNotFound(err) {
+		tflog.Warn(ctx, "variant not found, removing from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "name": data.Name.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read variant, got error: %s", err))
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read variant, got error: %s", err))
 		return
 	}
 
 	data.Id = types.StringValue(variant.Id)
 	data.Name = types.StringValue(variant.Name)
-	data.Public = types.BoolValue(variant.IsPublic)
-	data.Url = types.StringPointerValue(variant.Url)
 	data.GraphId = types.StringValue(variant.GraphId)
 
+	setVariantSettings(data, variant)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -201,26 +292,11 @@ func (r *VariantResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	if data.Public.ValueBool() != state.Public.ValueBool() {
-		err := updatePublic(ctx, *r.client, data)
+	err := updateVariantSettings(ctx, *r.client, data, state)
 
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update variant, got error: %s", err))
-			return
-		}
-
-		tflog.Trace(ctx, "updated a variant")
-	}
-
-	if data.Url.ValueStringPointer() != state.Url.ValueStringPointer() {
-		err := updateUrl(ctx, *r.client, data)
-
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update variant, got error: %s", err))
-			return
-		}
-
-		tflog.Trace(ctx, "updated a variant")
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update variant, got error: %s", err))
+		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -237,8 +313,8 @@ func (r *VariantResource) Delete(ctx context.Context, req resource.DeleteRequest
 
 	_, err := deleteVariant(ctx, *r.client, data.GraphId.ValueString(), data.Name.ValueString())
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete variant, got error: %s", err))
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete variant, got error: %s", err))
 		return
 	}
 
@@ -268,11 +344,133 @@ func readVariant(ctx context.Context, client graphql.Client, serviceId string, v
 		return nil, err
 	}
 
+	if response.Service == nil || response.Service.Variant == nil {
+		return nil, errNotFound
+	}
+
 	variant := response.Service.Variant.Variant
 
 	return &variant, nil
 }
 
+// updateVariantSettings sends each setting which differs between the plan and
+// the state with its own mutation.
+func updateVariantSettings(ctx context.Context, client graphql.Client, data *VariantResourceModel, state *VariantResourceModel) error {
+	// A variant can only be listed while it is public, so it is unlisted
+	// before being made private and listed after being made public.
+	if !data.PubliclyListed.ValueBool() && state.PubliclyListed.ValueBool() {
+		if err := updatePubliclyListed(ctx, client, data); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "updated a variant")
+	}
+
+	if data.Public.ValueBool() != state.Public.ValueBool() {
+		if err := updatePublic(ctx, client, data); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "updated a variant")
+	}
+
+	if data.PubliclyListed.ValueBool() && !state.PubliclyListed.ValueBool() {
+		if err := updatePubliclyListed(ctx, client, data); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "updated a variant")
+	}
+
+	if data.IsProtected.ValueBool() != state.IsProtected.ValueBool() {
+		if err := updateIsProtected(ctx, client, data); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "updated a variant")
+	}
+
+	if !data.Url.Equal(state.Url) {
+		if err := updateUrl(ctx, client, data); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "updated a variant")
+	}
+
+	if !data.SubscriptionUrl.Equal(state.SubscriptionUrl) {
+		if err := updateSubscriptionUrl(ctx, client, data); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "updated a variant")
+	}
+
+	if data.SendCookies.ValueBool() != state.SendCookies.ValueBool() {
+		if err := updateSendCookies(ctx, client, data); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "updated a variant")
+	}
+
+	if !data.PreflightScript.Equal(state.PreflightScript) {
+		if err := updatePreflightScript(ctx, client, data); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "updated a variant")
+	}
+
+	if !data.PostflightScript.Equal(state.PostflightScript) {
+		if err := updatePostflightScript(ctx, client, data); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "updated a variant")
+	}
+
+	if !data.SharedHeaders.Equal(state.SharedHeaders) {
+		if err := updateSharedHeaders(ctx, client, data); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "updated a variant")
+	}
+
+	if !data.Readme.Equal(state.Readme) {
+		if err := updateReadme(ctx, client, data); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "updated a variant")
+	}
+
+	if !data.Links.Equal(state.Links) {
+		if err := updateLinks(ctx, client, data); err != nil {
+			return err
+		}
+
+		tflog.Trace(ctx, "updated a variant")
+	}
+
+	return nil
+}
+
+func setVariantSettings(data *VariantResourceModel, variant *Variant) {
+	data.Public = types.BoolValue(variant.IsPublic)
+	data.PubliclyListed = types.BoolValue(variant.IsPubliclyListed)
+	data.IsProtected = types.BoolValue(variant.IsProtected)
+	data.Url = types.StringPointerValue(variant.Url)
+	data.SubscriptionUrl = types.StringPointerValue(variant.SubscriptionUrl)
+	data.SendCookies = types.BoolValue(variant.SendCookies != nil && *variant.SendCookies)
+	data.PreflightScript = types.StringPointerValue(variant.PreflightScript)
+	data.PostflightScript = types.StringPointerValue(variant.PostflightScript)
+	data.SharedHeaders = types.StringPointerValue(variant.SharedHeaders)
+	data.Readme = types.StringValue(variant.Readme.Content)
+	data.Links = setFromVariantLinks(variant.Links)
+}
+
 func updatePublic(ctx context.Context, client graphql.Client, data *VariantResourceModel) error {
 	response, err := updateVariantIsPublic(ctx, client, data.GraphId.ValueString(), data.Name.ValueString(), data.Public.ValueBool())
 
@@ -296,3 +494,187 @@ func updateUrl(ctx context.Context, client graphql.Client, data *VariantResource
 
 	return nil
 }
+
+func updatePubliclyListed(ctx context.Context, client graphql.Client, data *VariantResourceModel) error {
+	response, err := updateVariantIsPubliclyListed(ctx, client, data.GraphId.ValueString(), data.Name.ValueString(), data.PubliclyListed.ValueBool())
+
+	if err != nil {
+		return err
+	}
+
+	data.PubliclyListed = types.BoolValue(response.Service.Variant.UpdateVariantIsPubliclyListed.Variant.IsPubliclyListed)
+
+	return nil
+}
+
+func updateIsProtected(ctx context.Context, client graphql.Client, data *VariantResourceModel) error {
+	response, err := updateVariantIsProtected(ctx, client, data.GraphId.ValueString(), data.Name.ValueString(), data.IsProtected.ValueBool())
+
+	if err != nil {
+		return err
+	}
+
+	data.IsProtected = types.BoolValue(response.Service.Variant.UpdateIsProtected.Variant.IsProtected)
+
+	return nil
+}
+
+func updateSubscriptionUrl(ctx context.Context, client graphql.Client, data *VariantResourceModel) error {
+	response, err := updateVariantSubscriptionURL(ctx, client, data.GraphId.ValueString(), data.Name.ValueString(), data.SubscriptionUrl.ValueStringPointer())
+
+	if err != nil {
+		return err
+	}
+
+	data.SubscriptionUrl = types.StringPointerValue(response.Service.Variant.UpdateSubscriptionURL.Variant.SubscriptionUrl)
+
+	return nil
+}
+
+func updateSendCookies(ctx context.Context, client graphql.Client, data *VariantResourceModel) error {
+	response, err := updateVariantSendCookies(ctx, client, data.GraphId.ValueString(), data.Name.ValueString(), data.SendCookies.ValueBool())
+
+	if err != nil {
+		return err
+	}
+
+	sendCookies := response.Service.Variant.UpdateSendCookies.Variant.SendCookies
+
+	data.SendCookies = types.BoolValue(sendCookies != nil && *sendCookies)
+
+	return nil
+}
+
+func updatePreflightScript(ctx context.Context, client graphql.Client, data *VariantResourceModel) error {
+	response, err := updateVariantPreflightScript(ctx, client, data.GraphId.ValueString(), data.Name.ValueString(), data.PreflightScript.ValueStringPointer())
+
+	if err != nil {
+		return err
+	}
+
+	data.PreflightScript = types.StringPointerValue(response.Service.Variant.UpdatePreflightScript.Variant.PreflightScript)
+
+	return nil
+}
+
+func updatePostflightScript(ctx context.Context, client graphql.Client, data *VariantResourceModel) error {
+	response, err := updateVariantPostflightScript(ctx, client, data.GraphId.ValueString(), data.Name.ValueString(), data.PostflightScript.ValueStringPointer())
+
+	if err != nil {
+		return err
+	}
+
+	data.PostflightScript = types.StringPointerValue(response.Service.Variant.UpdatePostflightScript.Variant.PostflightScript)
+
+	return nil
+}
+
+func updateSharedHeaders(ctx context.Context, client graphql.Client, data *VariantResourceModel) error {
+	response, err := updateVariantSharedHeaders(ctx, client, data.GraphId.ValueString(), data.Name.ValueString(), data.SharedHeaders.ValueStringPointer())
+
+	if err != nil {
+		return err
+	}
+
+	data.SharedHeaders = types.StringPointerValue(response.Service.Variant.UpdateSharedHeaders.Variant.SharedHeaders)
+
+	return nil
+}
+
+func updateReadme(ctx context.Context, client graphql.Client, data *VariantResourceModel) error {
+	response, err := updateVariantReadme(ctx, client, data.GraphId.ValueString(), data.Name.ValueString(), data.Readme.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	data.Readme = types.StringValue(response.Service.Variant.UpdateVariantReadme.Variant.Readme.Content)
+
+	return nil
+}
+
+// updateLinks removes the links of the variant which aren't planned and adds
+// the planned ones which are missing. Links are matched on all of their
+// attributes, since they can't be changed in place.
+func updateLinks(ctx context.Context, client graphql.Client, data *VariantResourceModel) error {
+	variant, err := readVariant(ctx, client, data.GraphId.ValueString(), data.Name.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	planned := map[string]VariantLinksLinkInfo{}
+
+	for _, value := range data.Links.Elements() {
+		attributes := value.(types.Object).Attributes()
+
+		link := VariantLinksLinkInfo{
+			Title: attributes["title"].(types.String).ValueStringPointer(),
+			Type:  LinkInfoType(attributes["type"].(types.String).ValueString()),
+			Url:   attributes["url"].(types.String).ValueString(),
+		}
+
+		planned[variantLinkKey(link)] = link
+	}
+
+	existing := map[string]bool{}
+
+	for _, link := range variant.Links {
+		key := variantLinkKey(link)
+
+		if _, ok := planned[key]; ok && !existing[key] {
+			existing[key] = true
+			continue
+		}
+
+		response, err := removeVariantLink(ctx, client, data.GraphId.ValueString(), data.Name.ValueString(), link.Id)
+
+		if err != nil {
+			return err
+		}
+
+		variant = &response.Service.Variant.RemoveLinkFromVariant.Variant
+	}
+
+	for key, link := range planned {
+		if existing[key] {
+			continue
+		}
+
+		response, err := addVariantLink(ctx, client, data.GraphId.ValueString(), data.Name.ValueString(), link.Title, link.Type, link.Url)
+
+		if err != nil {
+			return err
+		}
+
+		variant = &response.Service.Variant.AddLinkToVariant.Variant
+	}
+
+	data.Links = setFromVariantLinks(variant.Links)
+
+	return nil
+}
+
+func variantLinkKey(link VariantLinksLinkInfo) string {
+	title := ""
+
+	if link.Title != nil {
+		title = *link.Title
+	}
+
+	return fmt.Sprintf("%s %s %q", link.Type, link.Url, title)
+}
+
+func setFromVariantLinks(links []VariantLinksLinkInfo) types.Set {
+	elements := []attr.Value{}
+
+	for _, link := range links {
+		elements = append(elements, types.ObjectValueMust(variantLinkAttrTypes, map[string]attr.Value{
+			"title": types.StringPointerValue(link.Title),
+			"type":  types.StringValue(string(link.Type)),
+			"url":   types.StringValue(link.Url),
+		}))
+	}
+
+	return types.SetValueMust(types.ObjectType{AttrTypes: variantLinkAttrTypes}, elements)
+}
@@ -0,0 +1,488 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &VariantCheckConfigurationResource{}
+var _ resource.ResourceWithImportState = &VariantCheckConfigurationResource{}
+
+func NewVariantCheckConfigurationResource() resource.Resource {
+	return &VariantCheckConfigurationResource{}
+}
+
+type VariantCheckConfigurationResource struct {
+	client *graphql.Client
+}
+
+type VariantCheckConfigurationResourceModel struct {
+	Id                                types.String  `tfsdk:"id"`
+	GraphId                           types.String  `tfsdk:"graph_id"`
+	Variant                           types.String  `tfsdk:"variant"`
+	OperationsChecksEnabled           types.Bool    `tfsdk:"operations_checks_enabled"`
+	TimeRangeSeconds                  types.Int64   `tfsdk:"time_range_seconds"`
+	OperationCountThreshold           types.Int64   `tfsdk:"operation_count_threshold"`
+	OperationCountThresholdPercentage types.Float64 `tfsdk:"operation_count_threshold_percentage"`
+	IncludedVariants                  types.Set     `tfsdk:"included_variants"`
+	ExcludedClients                   types.Set     `tfsdk:"excluded_clients"`
+	AppendGraphExcludedClients        types.Bool    `tfsdk:"append_graph_excluded_clients"`
+	ExcludedOperations                types.Set     `tfsdk:"excluded_operations"`
+	AppendGraphExcludedOperations     types.Bool    `tfsdk:"append_graph_excluded_operations"`
+	DowngradeStaticChecks             types.Bool    `tfsdk:"downgrade_static_checks"`
+	DowngradeDefaultValueChange       types.Bool    `tfsdk:"downgrade_default_value_change"`
+}
+
+func (r *VariantCheckConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variant_check_configuration"
+}
+
+func (r *VariantCheckConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL operation check configuration of a variant, overriding the check configuration of its graph. Settings which aren't configured use the graph settings, and destroying the resource restores them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the check configuration.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the variant belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"variant": schema.StringAttribute{
+				MarkdownDescription: "Name of the variant the check configuration belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"operations_checks_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether operation checks are run for the variant. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"time_range_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Only operations executed in the last given seconds are checked. Uses the graph settings for the time range and thresholds when not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"operation_count_threshold": schema.Int64Attribute{
+				MarkdownDescription: "Operations executed fewer times than this in the time range are ignored.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AlsoRequires(path.MatchRoot("time_range_seconds")),
+				},
+			},
+			"operation_count_threshold_percentage": schema.Float64Attribute{
+				MarkdownDescription: "Operations making up a smaller share of the requests in the time range than this are ignored.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+					float64validator.AlsoRequires(path.MatchRoot("time_range_seconds")),
+				},
+			},
+			"included_variants": schema.SetAttribute{
+				MarkdownDescription: "Names of the variants whose operations are checked. Uses the graph settings when not set.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"excluded_clients": schema.SetNestedAttribute{
+				MarkdownDescription: "Clients whose operations are ignored.",
+				Optional:            true,
+				NestedObject:        clientFilterSchema(),
+			},
+			"append_graph_excluded_clients": schema.BoolAttribute{
+				MarkdownDescription: "Whether the excluded clients of the graph are also ignored. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"excluded_operations": schema.SetAttribute{
+				MarkdownDescription: "Identifiers of the operations which are ignored.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"append_graph_excluded_operations": schema.BoolAttribute{
+				MarkdownDescription: "Whether the excluded operations of the graph are also ignored. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"downgrade_static_checks": schema.BoolAttribute{
+				MarkdownDescription: "Whether a check run against zero operations passes instead of failing. Uses the graph settings for both downgrade settings when neither is set.",
+				Optional:            true,
+			},
+			"downgrade_default_value_change": schema.BoolAttribute{
+				MarkdownDescription: "Whether changing a default value passes the check, failing only when it is removed.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *VariantCheckConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VariantCheckConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *VariantCheckConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := updateVariantChecks(ctx, *r.client, data, nil)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create variant check configuration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a variant check configuration")
+
+	data.Id = types.StringValue(fmt.Sprintf("%s:%s", data.GraphId.ValueString(), data.Variant.ValueString()))
+
+	setVariantCheckConfiguration(data, configuration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariantCheckConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *VariantCheckConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := readVariantCheckConfiguration(ctx, *r.client, data.GraphId.ValueString(), data.Variant.ValueString())
+
+	if isNotFound(err) {
+		tflog.Warn(ctx, "variant not found, removing check configuration from state", map[string]interface{}{"graph_id": data.GraphId.ValueString(), "variant": data.Variant.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read variant check configuration, got error: %s", err))
+		return
+	}
+
+	setVariantCheckConfiguration(data, configuration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariantCheckConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *VariantCheckConfigurationResourceModel
+	var state *VariantCheckConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configuration, err := updateVariantChecks(ctx, *r.client, data, state)
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update variant check configuration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a variant check configuration")
+
+	setVariantCheckConfiguration(data, configuration)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VariantCheckConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *VariantCheckConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The override can't be removed, so every setting is pointed back at the
+	// graph settings instead.
+	defaults := &VariantCheckConfigurationResourceModel{
+		GraphId:                           data.GraphId,
+		Variant:                           data.Variant,
+		OperationsChecksEnabled:           types.BoolValue(true),
+		TimeRangeSeconds:                  types.Int64Null(),
+		OperationCountThreshold:           types.Int64Null(),
+		OperationCountThresholdPercentage: types.Float64Null(),
+		IncludedVariants:                  types.SetNull(types.StringType),
+		ExcludedClients:                   types.SetNull(types.ObjectType{AttrTypes: clientFilterAttrTypes}),
+		AppendGraphExcludedClients:        types.BoolValue(true),
+		ExcludedOperations:                types.SetNull(types.StringType),
+		AppendGraphExcludedOperations:     types.BoolValue(true),
+		DowngradeStaticChecks:             types.BoolNull(),
+		DowngradeDefaultValueChange:       types.BoolNull(),
+	}
+
+	_, err := updateVariantChecks(ctx, *r.client, defaults, nil)
+
+	// A variant which is gone has no check configuration left to restore.
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete variant check configuration, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a variant check configuration")
+}
+
+func (r *VariantCheckConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: graph_id:variant. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variant"), parts[1])...)
+}
+
+func readVariantCheckConfiguration(ctx context.Context, client graphql.Client, serviceId string, variantName string) (*VariantCheckConfiguration, error) {
+	response, err := getVariantCheckConfiguration(ctx, client, serviceId, variantName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil || response.Service.Variant == nil {
+		return nil, errNotFound
+	}
+
+	return &response.Service.Variant.CheckConfiguration.VariantCheckConfiguration, nil
+}
+
+// updateVariantChecks sends the settings which differ between the plan and
+// the state, each group of settings having its own mutation. Every group is
+// sent when there is no state.
+func updateVariantChecks(ctx context.Context, client graphql.Client, data *VariantCheckConfigurationResourceModel, state *VariantCheckConfigurationResourceModel) (*VariantCheckConfiguration, error) {
+	serviceId := data.GraphId.ValueString()
+	variantName := data.Variant.ValueString()
+
+	if state == nil || !data.OperationsChecksEnabled.Equal(state.OperationsChecksEnabled) {
+		response, err := updateVariantCheckConfigurationEnableOperationsCheck(ctx, client, serviceId, variantName, data.OperationsChecksEnabled.ValueBool())
+
+		if err != nil {
+			return nil, err
+		}
+
+		if response.Service.Variant.UpdateCheckConfigurationEnableOperationsCheck == nil {
+			return nil, errNotFound
+		}
+	}
+
+	// The thresholds are computed, so they are only compared when configured.
+	if state == nil || !data.TimeRangeSeconds.Equal(state.TimeRangeSeconds) ||
+		(!data.OperationCountThreshold.IsUnknown() && !data.OperationCountThreshold.Equal(state.OperationCountThreshold)) ||
+		(!data.OperationCountThresholdPercentage.IsUnknown() && !data.OperationCountThresholdPercentage.Equal(state.OperationCountThresholdPercentage)) {
+		_, err := updateVariantCheckConfigurationTimeRange(
+			ctx,
+			client,
+			serviceId,
+			variantName,
+			data.TimeRangeSeconds.IsNull(),
+			int64Pointer(data.TimeRangeSeconds),
+			intPointer(data.OperationCountThreshold),
+			float64Pointer(data.OperationCountThresholdPercentage),
+		)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if state == nil || !data.IncludedVariants.Equal(state.IncludedVariants) {
+		var includedVariants []string
+
+		if !data.IncludedVariants.IsNull() {
+			includedVariants = stringsFromSet(data.IncludedVariants)
+		}
+
+		_, err := updateVariantCheckConfigurationIncludedVariants(ctx, client, serviceId, variantName, data.IncludedVariants.IsNull(), includedVariants)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if state == nil || !data.ExcludedClients.Equal(state.ExcludedClients) || !data.AppendGraphExcludedClients.Equal(state.AppendGraphExcludedClients) {
+		_, err := updateVariantCheckConfigurationExcludedClients(ctx, client, serviceId, variantName, data.AppendGraphExcludedClients.ValueBool(), clientFiltersFromSet(data.ExcludedClients))
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if state == nil || !data.ExcludedOperations.Equal(state.ExcludedOperations) || !data.AppendGraphExcludedOperations.Equal(state.AppendGraphExcludedOperations) {
+		operations := []OperationInfoFilterInput{}
+
+		for _, id := range stringsFromSet(data.ExcludedOperations) {
+			operations = append(operations, OperationInfoFilterInput{Id: id})
+		}
+
+		_, err := updateVariantCheckConfigurationExcludedOperations(ctx, client, serviceId, variantName, data.AppendGraphExcludedOperations.ValueBool(), operations)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if state == nil || !data.DowngradeStaticChecks.Equal(state.DowngradeStaticChecks) || !data.DowngradeDefaultValueChange.Equal(state.DowngradeDefaultValueChange) {
+		useGraphSettings := data.DowngradeStaticChecks.IsNull() && data.DowngradeDefaultValueChange.IsNull()
+
+		// A setting which isn't configured is turned off when the other one is.
+		downgradeStaticChecks := data.DowngradeStaticChecks.ValueBool()
+		downgradeDefaultValueChange := data.DowngradeDefaultValueChange.ValueBool()
+
+		_, err := updateVariantCheckConfigurationDowngradeChecks(ctx, client, serviceId, variantName, useGraphSettings, &downgradeStaticChecks, &downgradeDefaultValueChange)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return readVariantCheckConfiguration(ctx, client, serviceId, variantName)
+}
+
+func setVariantCheckConfiguration(data *VariantCheckConfigurationResourceModel, configuration *VariantCheckConfiguration) {
+	data.OperationsChecksEnabled = types.BoolValue(configuration.OperationsChecksEnabled)
+
+	timeRange := configuration.TimeRangeConfig
+
+	if timeRange.UseGraphSettings {
+		data.TimeRangeSeconds = types.Int64Null()
+		data.OperationCountThreshold = types.Int64Null()
+		data.OperationCountThresholdPercentage = types.Float64Null()
+	} else {
+		data.TimeRangeSeconds = types.Int64PointerValue(timeRange.TimeRangeSeconds)
+		data.OperationCountThreshold = types.Int64Null()
+		data.OperationCountThresholdPercentage = types.Float64PointerValue(timeRange.OperationCountThresholdPercentage)
+
+		if timeRange.OperationCountThreshold != nil {
+			data.OperationCountThreshold = types.Int64Value(int64(*timeRange.OperationCountThreshold))
+		}
+	}
+
+	if configuration.IncludedVariantsConfig.UseGraphSettings {
+		data.IncludedVariants = types.SetNull(types.StringType)
+	} else {
+		data.IncludedVariants = setFromStrings(configuration.IncludedVariantsConfig.IncludedVariants)
+	}
+
+	clients := []ClientFilterInput{}
+
+	for _, client := range configuration.ExcludedClientsConfig.ExcludedClients {
+		clients = append(clients, ClientFilterInput{Name: client.Name, Version: client.Version})
+	}
+
+	// No excluded clients and no configured ones are the same.
+	if len(clients) > 0 || !data.ExcludedClients.IsNull() {
+		data.ExcludedClients = setFromClientFilters(clients)
+	}
+
+	data.AppendGraphExcludedClients = types.BoolValue(configuration.ExcludedClientsConfig.AppendGraphSettings)
+
+	operations := []string{}
+
+	for _, operation := range configuration.ExcludedOperationsConfig.ExcludedOperations {
+		operations = append(operations, operation.Id)
+	}
+
+	if len(operations) > 0 || !data.ExcludedOperations.IsNull() {
+		data.ExcludedOperations = setFromStrings(operations)
+	}
+
+	data.AppendGraphExcludedOperations = types.BoolValue(configuration.ExcludedOperationsConfig.AppendGraphSettings)
+
+	downgrade := configuration.DowngradeChecksConfig
+
+	if downgrade.UseGraphSettings {
+		data.DowngradeStaticChecks = types.BoolNull()
+		data.DowngradeDefaultValueChange = types.BoolNull()
+	} else {
+		data.DowngradeStaticChecks = optionalBool(data.DowngradeStaticChecks, downgrade.DowngradeStaticChecks)
+		data.DowngradeDefaultValueChange = optionalBool(data.DowngradeDefaultValueChange, downgrade.DowngradeDefaultValueChange)
+	}
+}
+
+// optionalBool returns the value read from the API, keeping a setting which
+// isn't configured as null while it is off.
+func optionalBool(current types.Bool, value *bool) types.Bool {
+	if value == nil || (!*value && current.IsNull()) {
+		return types.BoolNull()
+	}
+
+	return types.BoolValue(*value)
+}
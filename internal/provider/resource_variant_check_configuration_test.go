@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVariantCheckConfigurationResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVariantCheckConfigurationResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "id", "Test-w4a5n4:current"),
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "operations_checks_enabled", "true"),
+					resource.TestCheckNoResourceAttr("apollographql_variant_check_configuration.test", "time_range_seconds"),
+					resource.TestCheckNoResourceAttr("apollographql_variant_check_configuration.test", "included_variants"),
+					resource.TestCheckNoResourceAttr("apollographql_variant_check_configuration.test", "excluded_clients"),
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "append_graph_excluded_clients", "true"),
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "append_graph_excluded_operations", "true"),
+					resource.TestCheckNoResourceAttr("apollographql_variant_check_configuration.test", "downgrade_static_checks"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_variant_check_configuration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccVariantCheckConfigurationResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "time_range_seconds", "86400"),
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "operation_count_threshold", "5"),
+					resource.TestCheckResourceAttrSet("apollographql_variant_check_configuration.test", "operation_count_threshold_percentage"),
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "included_variants.#", "1"),
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "excluded_clients.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("apollographql_variant_check_configuration.test", "excluded_clients.*", map[string]string{"name": "web"}),
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "append_graph_excluded_clients", "false"),
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "excluded_operations.#", "1"),
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "downgrade_static_checks", "true"),
+					resource.TestCheckNoResourceAttr("apollographql_variant_check_configuration.test", "downgrade_default_value_change"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccVariantCheckConfigurationResourceDrift(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccVariantCheckConfigurationResourceConfigNonDefault(),
+			},
+			// Change outside of terraform and expect it to be reverted
+			{
+				PreConfig: func() {
+					if _, err := updateVariantCheckConfigurationIncludedVariants(context.Background(), testAccClient(), "Test-w4a5n4", "current", true, nil); err != nil {
+						t.Fatal(err)
+					}
+
+					if _, err := updateVariantCheckConfigurationEnableOperationsCheck(context.Background(), testAccClient(), "Test-w4a5n4", "current", false); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccVariantCheckConfigurationResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "included_variants.#", "1"),
+					resource.TestCheckResourceAttr("apollographql_variant_check_configuration.test", "operations_checks_enabled", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccVariantCheckConfigurationResourceThresholdWithoutTimeRange(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "apollographql_variant_check_configuration" "test" {
+  graph_id = "Test-w4a5n4"
+  variant = "current"
+  operation_count_threshold = 5
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func testAccVariantCheckConfigurationResourceConfigDefault() string {
+	return `
+resource "apollographql_variant_check_configuration" "test" {
+  graph_id = "Test-w4a5n4"
+  variant = "current"
+}
+`
+}
+
+func testAccVariantCheckConfigurationResourceConfigNonDefault() string {
+	return `
+resource "apollographql_variant_check_configuration" "test" {
+  graph_id = "Test-w4a5n4"
+  variant = "current"
+  time_range_seconds = 86400
+  operation_count_threshold = 5
+  included_variants = ["current"]
+  excluded_clients = [
+    {
+      name = "web"
+    }
+  ]
+  append_graph_excluded_clients = false
+  excluded_operations = ["a1b2c3d4e5f6"]
+  downgrade_static_checks = true
+}
+`
+}
@@ -20,6 +20,14 @@ func TestAccVariantResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("apollographql_variant.test", "name", "Staging"),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "public", "false"),
 					resource.TestCheckNoResourceAttr("apollographql_variant.test", "url"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "publicly_listed", "false"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "is_protected", "false"),
+					resource.TestCheckNoResourceAttr("apollographql_variant.test", "subscription_url"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "send_cookies", "false"),
+					resource.TestCheckNoResourceAttr("apollographql_variant.test", "preflight_script"),
+					resource.TestCheckNoResourceAttr("apollographql_variant.test", "shared_headers"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "readme", ""),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "links.#", "0"),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "graph_id", "Test-w4a5n4"),
 				),
 			},
@@ -38,6 +46,14 @@ func TestAccVariantResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("apollographql_variant.test", "name", "Staging"),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "public", "false"),
 					resource.TestCheckNoResourceAttr("apollographql_variant.test", "url"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "publicly_listed", "false"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "is_protected", "false"),
+					resource.TestCheckNoResourceAttr("apollographql_variant.test", "subscription_url"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "send_cookies", "false"),
+					resource.TestCheckNoResourceAttr("apollographql_variant.test", "preflight_script"),
+					resource.TestCheckNoResourceAttr("apollographql_variant.test", "shared_headers"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "readme", ""),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "links.#", "0"),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "graph_id", "Test-w4a5n4"),
 				),
 			},
@@ -49,6 +65,17 @@ func TestAccVariantResourceDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("apollographql_variant.test", "name", "Staging"),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "public", "true"),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "url", "https://example.com"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "publicly_listed", "true"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "is_protected", "true"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "subscription_url", "wss://example.com"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "send_cookies", "true"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "preflight_script", "explorer.environment.set(\"token\", \"secret\");"),
+					resource.TestCheckNoResourceAttr("apollographql_variant.test", "postflight_script"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "shared_headers", "{\"Authorization\":\"Bearer {{token}}\"}"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "readme", "# Staging"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "links.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("apollographql_variant.test", "links.*", map[string]string{"title": "Dashboard", "type": "OTHER", "url": "https://grafana.example.com/d/api"}),
+					resource.TestCheckTypeSetElemNestedAttrs("apollographql_variant.test", "links.*", map[string]string{"type": "REPOSITORY", "url": "https://github.com/example/api"}),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "graph_id", "Test-w4a5n4"),
 				),
 			},
@@ -77,6 +104,17 @@ func TestAccVariantResourceNonDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("apollographql_variant.test", "name", "QA"),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "public", "true"),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "url", "https://example.com"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "publicly_listed", "true"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "is_protected", "true"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "subscription_url", "wss://example.com"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "send_cookies", "true"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "preflight_script", "explorer.environment.set(\"token\", \"secret\");"),
+					resource.TestCheckNoResourceAttr("apollographql_variant.test", "postflight_script"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "shared_headers", "{\"Authorization\":\"Bearer {{token}}\"}"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "readme", "# Staging"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "links.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("apollographql_variant.test", "links.*", map[string]string{"title": "Dashboard", "type": "OTHER", "url": "https://grafana.example.com/d/api"}),
+					resource.TestCheckTypeSetElemNestedAttrs("apollographql_variant.test", "links.*", map[string]string{"type": "REPOSITORY", "url": "https://github.com/example/api"}),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "graph_id", "Test-w4a5n4"),
 				),
 			},
@@ -95,6 +133,17 @@ func TestAccVariantResourceNonDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("apollographql_variant.test", "name", "QA"),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "public", "true"),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "url", "https://example.com"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "publicly_listed", "true"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "is_protected", "true"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "subscription_url", "wss://example.com"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "send_cookies", "true"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "preflight_script", "explorer.environment.set(\"token\", \"secret\");"),
+					resource.TestCheckNoResourceAttr("apollographql_variant.test", "postflight_script"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "shared_headers", "{\"Authorization\":\"Bearer {{token}}\"}"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "readme", "# Staging"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "links.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("apollographql_variant.test", "links.*", map[string]string{"title": "Dashboard", "type": "OTHER", "url": "https://grafana.example.com/d/api"}),
+					resource.TestCheckTypeSetElemNestedAttrs("apollographql_variant.test", "links.*", map[string]string{"type": "REPOSITORY", "url": "https://github.com/example/api"}),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "graph_id", "Test-w4a5n4"),
 				),
 			},
@@ -106,6 +155,14 @@ func TestAccVariantResourceNonDefault(t *testing.T) {
 					resource.TestCheckResourceAttr("apollographql_variant.test", "name", "QA"),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "public", "false"),
 					resource.TestCheckNoResourceAttr("apollographql_variant.test", "url"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "publicly_listed", "false"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "is_protected", "false"),
+					resource.TestCheckNoResourceAttr("apollographql_variant.test", "subscription_url"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "send_cookies", "false"),
+					resource.TestCheckNoResourceAttr("apollographql_variant.test", "preflight_script"),
+					resource.TestCheckNoResourceAttr("apollographql_variant.test", "shared_headers"),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "readme", ""),
+					resource.TestCheckResourceAttr("apollographql_variant.test", "links.#", "0"),
 					resource.TestCheckResourceAttr("apollographql_variant.test", "graph_id", "Test-w4a5n4"),
 				),
 			},
@@ -135,7 +192,25 @@ func testAccVariantResourceConfigNonDefault(name string) string {
 resource "apollographql_variant" "test" {
   name = "%s"
   public = true
+  publicly_listed = true
+  is_protected = true
   url = "https://example.com"
+  subscription_url = "wss://example.com"
+  send_cookies = true
+  preflight_script = "explorer.environment.set(\"token\", \"secret\");"
+  shared_headers = jsonencode({ Authorization = "Bearer {{token}}" })
+  readme = "# Staging"
+  links = [
+    {
+      title = "Dashboard"
+      type = "OTHER"
+      url = "https://grafana.example.com/d/api"
+    },
+    {
+      type = "REPOSITORY"
+      url = "https://github.com/example/api"
+    }
+  ]
   graph_id = "Test-w4a5n4"
 }
 `, name)
@@ -0,0 +1,273 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &WebhookChannelResource{}
+var _ resource.ResourceWithImportState = &WebhookChannelResource{}
+
+func NewWebhookChannelResource() resource.Resource {
+	return &WebhookChannelResource{}
+}
+
+type WebhookChannelResource struct {
+	client *graphql.Client
+}
+
+type WebhookChannelResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	GraphId     types.String `tfsdk:"graph_id"`
+	Name        types.String `tfsdk:"name"`
+	Url         types.String `tfsdk:"url"`
+	SecretToken types.String `tfsdk:"secret_token"`
+}
+
+func (r *WebhookChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_channel"
+}
+
+func (r *WebhookChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Apollo GraphQL webhook notification channel.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the graph the channel belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the channel.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL the notifications are sent to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
+			},
+			"secret_token": schema.StringAttribute{
+				MarkdownDescription: "Token used to sign the notifications.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *WebhookChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*graphql.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *graphql.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WebhookChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *WebhookChannelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := upsertWebhookChannel(ctx, *r.client, data.GraphId.ValueString(), nil, data.Name.ValueString(), data.SecretToken.ValueStringPointer(), data.Url.ValueString())
+
+	if err == nil && response.Service.UpsertWebhookChannel == nil {
+		err = fmt.Errorf("Unable to create webhook channel for graph: %s", data.GraphId.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to create webhook channel, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created a webhook channel")
+
+	channel := response.Service.UpsertWebhookChannel.WebhookChannel
+
+	data.Id = types.StringValue(channel.Id)
+	data.Name = types.StringValue(channel.Name)
+	data.Url = types.StringValue(channel.Url)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *WebhookChannelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := readWebhookChannel(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if removeMissingChannel(ctx, resp, err, "webhook", data.GraphId.ValueString(), data.Id.ValueString()) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to read webhook channel, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(channel.Id)
+	data.Name = types.StringValue(channel.Name)
+	data.Url = types.StringValue(channel.Url)
+
+	// The secret token is only returned to some roles, so it is left as is
+	// when missing. The API may also generate one when it isn't configured,
+	// which is ignored to avoid a perpetual diff.
+	if !data.SecretToken.IsNull() && channel.SecretToken != nil {
+		data.SecretToken = types.StringValue(*channel.SecretToken)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *WebhookChannelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := upsertWebhookChannel(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueStringPointer(), data.Name.ValueString(), data.SecretToken.ValueStringPointer(), data.Url.ValueString())
+
+	if err == nil && response.Service.UpsertWebhookChannel == nil {
+		err = fmt.Errorf("Unable to update webhook channel: %s", data.Id.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to update webhook channel, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated a webhook channel")
+
+	channel := response.Service.UpsertWebhookChannel.WebhookChannel
+
+	data.Name = types.StringValue(channel.Name)
+	data.Url = types.StringValue(channel.Url)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *WebhookChannelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := deleteChannel(ctx, *r.client, data.GraphId.ValueString(), data.Id.ValueString())
+
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(errorSummary(err), fmt.Sprintf("Unable to delete webhook channel, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted a webhook channel")
+}
+
+func (r *WebhookChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importChannelState(ctx, req, resp)
+}
+
+func readWebhookChannel(ctx context.Context, client graphql.Client, serviceId string, id string) (*WebhookChannel, error) {
+	response, err := getWebhookChannel(ctx, client, serviceId, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Service == nil {
+		return nil, errNotFound
+	}
+
+	for _, item := range response.Service.Channels {
+		if channel, ok := item.(*getWebhookChannelServiceChannelsWebhookChannel); ok && channel.Id == id {
+			return &channel.WebhookChannel, nil
+		}
+	}
+
+	return nil, errNotFound
+}
+
+// importChannelState imports a notification channel by graph_id:channel_id.
+func importChannelState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: graph_id:channel_id. Got: %q", req.ID),
+		)
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("graph_id"), parts[0])...)
+}
+
+// removeMissingChannel removes a notification channel from the state when err
+// reports that it no longer exists, and returns whether it did.
+func removeMissingChannel(ctx context.Context, resp *resource.ReadResponse, err error, kind string, graphId string, id string) bool {
+	if !isNotFound(err) {
+		return false
+	}
+
+	tflog.Warn(ctx, fmt.Sprintf("%s channel not found, removing from state", kind), map[string]interface{}{"graph_id": graphId, "id": id})
+	resp.State.RemoveResource(ctx)
+
+	return true
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccWebhookChannelResourceDefault(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebhookChannelResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_webhook_channel.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_webhook_channel.test", "graph_id", "Test-w4a5n4"),
+					resource.TestCheckResourceAttr("apollographql_webhook_channel.test", "name", "Schema Changes"),
+					resource.TestCheckResourceAttr("apollographql_webhook_channel.test", "url", "https://example.com/hooks/apollo"),
+					resource.TestCheckNoResourceAttr("apollographql_webhook_channel.test", "secret_token"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "apollographql_webhook_channel.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccGraphImportStateId("apollographql_webhook_channel.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccWebhookChannelResourceConfigNonDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("apollographql_webhook_channel.test", "id"),
+					resource.TestCheckResourceAttr("apollographql_webhook_channel.test", "name", "Schema Notifications"),
+					resource.TestCheckResourceAttr("apollographql_webhook_channel.test", "url", "https://example.com/hooks/schema"),
+					resource.TestCheckResourceAttr("apollographql_webhook_channel.test", "secret_token", "s3cr3t"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccWebhookChannelResourceDrift(t *testing.T) {
+	var id string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWebhookChannelResourceConfigDefault(),
+				Check: func(s *terraform.State) error {
+					id = s.RootModule().Resources["apollographql_webhook_channel.test"].Primary.ID
+					return nil
+				},
+			},
+			// Change outside of terraform and expect it to be reverted
+			{
+				PreConfig: func() {
+					if _, err := upsertWebhookChannel(context.Background(), testAccClient(), "Test-w4a5n4", &id, "Changed", nil, "https://example.com/hooks/changed"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccWebhookChannelResourceConfigDefault(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("apollographql_webhook_channel.test", "name", "Schema Changes"),
+					resource.TestCheckResourceAttr("apollographql_webhook_channel.test", "url", "https://example.com/hooks/apollo"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccWebhookChannelResourceConfigDefault() string {
+	return `
+resource "apollographql_webhook_channel" "test" {
+  graph_id = "Test-w4a5n4"
+  name = "Schema Changes"
+  url = "https://example.com/hooks/apollo"
+}
+`
+}
+
+func testAccWebhookChannelResourceConfigNonDefault() string {
+	return `
+resource "apollographql_webhook_channel" "test" {
+  graph_id = "Test-w4a5n4"
+  name = "Schema Notifications"
+  url = "https://example.com/hooks/schema"
+  secret_token = "s3cr3t"
+}
+`
+}